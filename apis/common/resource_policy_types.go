@@ -76,6 +76,18 @@ type ResourcePolicyStatement struct {
 	// +optional
 	Resource []string `json:"resource,omitempty"`
 
+	// ResourceARNs are the ARNs resolved from ResourceARNRefs. They are
+	// added to Resource in the policy document and replaced whenever the
+	// references are resolved.
+	// +optional
+	ResourceARNs []string `json:"resourceArns,omitempty"`
+
+	// ResourceARNRefs references managed resources of this provider to
+	// retrieve their ARNs for ResourceARNs. The referenced resources must be
+	// ready and report their ARN in status.atProvider.arn.
+	// +optional
+	ResourceARNRefs []ARNReference `json:"resourceArnRefs,omitempty"`
+
 	// This will explicitly match all resource paths except the ones
 	// specified in this array
 	// +optional
@@ -87,6 +99,20 @@ type ResourcePolicyStatement struct {
 	Condition []Condition `json:"condition,omitempty"`
 }
 
+// ARNReference references a managed resource of this provider in order to
+// retrieve its ARN from status.atProvider.arn.
+type ARNReference struct {
+	// APIVersion of the referenced managed resource, e.g.
+	// sqs.aws.crossplane.io/v1beta1.
+	APIVersion string `json:"apiVersion"`
+
+	// Kind of the referenced managed resource, e.g. Queue.
+	Kind string `json:"kind"`
+
+	// Name of the referenced managed resource.
+	Name string `json:"name"`
+}
+
 // ResourcePrincipal defines the principal users affected by
 // the ResourcePolicyStatement
 // Please see the AWS resource docs for more information
@@ -25,6 +25,21 @@ import (
 	"github.com/crossplane/crossplane-runtime/apis/common/v1"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ARNReference) DeepCopyInto(out *ARNReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ARNReference.
+func (in *ARNReference) DeepCopy() *ARNReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ARNReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AWSPrincipal) DeepCopyInto(out *AWSPrincipal) {
 	*out = *in
@@ -196,6 +211,16 @@ func (in *ResourcePolicyStatement) DeepCopyInto(out *ResourcePolicyStatement) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ResourceARNs != nil {
+		in, out := &in.ResourceARNs, &out.ResourceARNs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ResourceARNRefs != nil {
+		in, out := &in.ResourceARNRefs, &out.ResourceARNRefs
+		*out = make([]ARNReference, len(*in))
+		copy(*out, *in)
+	}
 	if in.NotResource != nil {
 		in, out := &in.NotResource, &out.NotResource
 		*out = make([]string, len(*in))
@@ -21,6 +21,25 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+const (
+	// InstanceUpdateStrategyInPlace only applies changes that do not require
+	// the instance to be stopped.
+	InstanceUpdateStrategyInPlace = "InPlace"
+	// InstanceUpdateStrategyStopStart stops the instance to apply changes that
+	// require a stopped instance and starts it again afterwards.
+	InstanceUpdateStrategyStopStart = "StopStart"
+)
+
+const (
+	// InstanceDesiredStateRunning keeps the instance running.
+	InstanceDesiredStateRunning = "running"
+	// InstanceDesiredStateStopped keeps the instance stopped.
+	InstanceDesiredStateStopped = "stopped"
+	// InstanceDesiredStateHibernated hibernates a running instance and keeps
+	// it stopped.
+	InstanceDesiredStateHibernated = "hibernated"
+)
+
 // InstanceParameters define the desired state of the Instances
 type InstanceParameters struct {
 	// The block device mapping entries.
@@ -60,6 +79,14 @@ type InstanceParameters struct {
 	// +optional
 	CreditSpecification *CreditSpecificationRequest `json:"creditSpecification,omitempty"`
 
+	// DesiredState is the power state the instance is kept in. A running
+	// instance is stopped or hibernated and a stopped instance is started
+	// accordingly. Hibernation must be enabled through hibernationOptions at
+	// launch. The power state is not managed if this is not set.
+	// +optional
+	// +kubebuilder:validation:Enum=running;stopped;hibernated
+	DesiredState *string `json:"desiredState,omitempty"`
+
 	// If you set this parameter to true, you can't terminate the instance using
 	// the Amazon EC2 console, CLI, or API; otherwise, you can. To change this attribute
 	// after launch, use ModifyInstanceAttribute (https://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_ModifyInstanceAttribute.html).
@@ -165,8 +192,17 @@ type InstanceParameters struct {
 	// If you do not specify a key pair, you can't connect to the instance unless
 	// you choose an AMI that is configured to allow users another way to log in.
 	// +optional
+	// +crossplane:generate:reference:type=KeyPair
 	KeyName *string `json:"keyName,omitempty"`
 
+	// KeyNameRef references a KeyPair to retrieve its name
+	// +optional
+	KeyNameRef *xpv1.Reference `json:"keyNameRef,omitempty"`
+
+	// KeyNameSelector selects a reference to a KeyPair to retrieve its name
+	// +optional
+	KeyNameSelector *xpv1.Selector `json:"keyNameSelector,omitempty"`
+
 	// The launch template to use to launch the instances. Any parameters that you
 	// specify in RunInstances override the same parameters in the launch template.
 	// You can specify either the name or ID of a launch template, but not both.
@@ -272,6 +308,17 @@ type InstanceParameters struct {
 	// +optional
 	TagSpecifications []TagSpecification `json:"tagSpecifications,omitempty"`
 
+	// UpdateStrategy controls how changes to attributes that can only be
+	// modified while the instance is stopped (instanceType, ebsOptimized,
+	// kernelId, ramDiskId and userData) are applied. InPlace never stops the
+	// instance and only reports the pending changes in the StopStartUpdate
+	// condition. StopStart stops a running instance, applies the changes and
+	// starts it again.
+	// Default: InPlace
+	// +optional
+	// +kubebuilder:validation:Enum=InPlace;StopStart
+	UpdateStrategy *string `json:"updateStrategy,omitempty"`
+
 	// The user data to make available to the instance. For more information, see
 	// Running Commands on Your Linux Instance at Launch (https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/user-data.html)
 	// (Linux) and Adding User Data (https://docs.aws.amazon.com/AWSEC2/latest/WindowsGuide/ec2-instance-metadata.html#instancedata-add-user-data)
@@ -0,0 +1,214 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualv1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ICMPTypeCode describes the ICMP type and code.
+type ICMPTypeCode struct {
+	// The ICMP code. A value of -1 means all codes for the specified ICMP type.
+	// +optional
+	Code *int32 `json:"code,omitempty"`
+
+	// The ICMP type. A value of -1 means all types.
+	// +optional
+	Type *int32 `json:"type,omitempty"`
+}
+
+// PortRange describes a range of ports.
+type PortRange struct {
+	// The first port in the range.
+	// +optional
+	From *int32 `json:"from,omitempty"`
+
+	// The last port in the range.
+	// +optional
+	To *int32 `json:"to,omitempty"`
+}
+
+// NetworkACLRule describes an entry (a rule) in a network ACL. Entries are
+// identified by their direction and rule number.
+type NetworkACLRule struct {
+	// The rule number of the entry. Entries are processed in ascending order
+	// by rule number.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=32766
+	RuleNumber int32 `json:"ruleNumber"`
+
+	// Egress indicates whether this is an egress rule (rule is applied to
+	// traffic leaving the subnet).
+	// +optional
+	Egress bool `json:"egress,omitempty"`
+
+	// The protocol number. A value of "-1" means all protocols. If you specify
+	// "-1" or a protocol number other than "6" (TCP), "17" (UDP), or "1"
+	// (ICMP), traffic on all ports is allowed, regardless of any ports or ICMP
+	// types or codes that you specify.
+	Protocol string `json:"protocol"`
+
+	// Indicates whether to allow or deny the traffic that matches the rule.
+	// +kubebuilder:validation:Enum=allow;deny
+	RuleAction string `json:"ruleAction"`
+
+	// The IPv4 network range to allow or deny, in CIDR notation.
+	// +optional
+	CIDRBlock *string `json:"cidrBlock,omitempty"`
+
+	// The IPv6 network range to allow or deny, in CIDR notation.
+	// +optional
+	IPv6CIDRBlock *string `json:"ipv6CidrBlock,omitempty"`
+
+	// ICMP protocol: The ICMP or ICMPv6 type and code. Required if
+	// specifying protocol 1 (ICMP) or protocol 58 (ICMPv6) with an IPv6 CIDR
+	// block.
+	// +optional
+	ICMPTypeCode *ICMPTypeCode `json:"icmpTypeCode,omitempty"`
+
+	// TCP or UDP protocols: The range of ports the rule applies to. Required
+	// if specifying protocol 6 (TCP) or 17 (UDP).
+	// +optional
+	PortRange *PortRange `json:"portRange,omitempty"`
+}
+
+// NetworkACLParameters define the desired state of an AWS VPC Network ACL.
+type NetworkACLParameters struct {
+	// Region is the region you'd like your NetworkACL to be created in.
+	Region *string `json:"region"`
+
+	// VPCID is the ID of the VPC.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-aws/apis/ec2/v1beta1.VPC
+	VPCID *string `json:"vpcId,omitempty"`
+
+	// VPCIDRef references a VPC to retrieve its vpcId
+	// +optional
+	// +immutable
+	VPCIDRef *xpv1.Reference `json:"vpcIdRef,omitempty"`
+
+	// VPCIDSelector selects a reference to a VPC to retrieve its vpcId
+	// +optional
+	VPCIDSelector *xpv1.Selector `json:"vpcIdSelector,omitempty"`
+
+	// SubnetIDs are the IDs of the subnets associated with the network ACL.
+	// Associating a subnet replaces its current association, usually the one
+	// with the default network ACL of the VPC. Subnets that are removed from
+	// this list or whose network ACL is deleted are associated with the
+	// default network ACL again.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-aws/apis/ec2/v1beta1.Subnet
+	// +crossplane:generate:reference:refFieldName=SubnetIDRefs
+	// +crossplane:generate:reference:selectorFieldName=SubnetIDSelector
+	SubnetIDs []string `json:"subnetIds,omitempty"`
+
+	// SubnetIDRefs is a list of references to Subnets used to set the
+	// SubnetIDs.
+	// +optional
+	SubnetIDRefs []xpv1.Reference `json:"subnetIdRefs,omitempty"`
+
+	// SubnetIDSelector selects references to Subnets used to set the
+	// SubnetIDs.
+	// +optional
+	SubnetIDSelector *xpv1.Selector `json:"subnetIdSelector,omitempty"`
+
+	// ManageEntries indicates whether the entries of the network ACL are
+	// managed inline through Entries. Entries that are not part of Entries
+	// are removed if set. Leave it unset when the entries are managed with
+	// NetworkACLEntry resources.
+	// +optional
+	ManageEntries *bool `json:"manageEntries,omitempty"`
+
+	// Entries are the inline entries of the network ACL. They are only
+	// reconciled if ManageEntries is set. The default entries that deny all
+	// traffic that matches no other entry are not part of this list.
+	// +optional
+	Entries []NetworkACLRule `json:"entries,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A NetworkACLSpec defines the desired state of a NetworkACL.
+type NetworkACLSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       NetworkACLParameters `json:"forProvider"`
+}
+
+// NetworkACLAssociation describes an association between a network ACL and
+// a subnet.
+type NetworkACLAssociation struct {
+	// The ID of the association between a network ACL and a subnet.
+	AssociationID string `json:"associationId,omitempty"`
+
+	// The ID of the subnet.
+	SubnetID string `json:"subnetId,omitempty"`
+}
+
+// NetworkACLObservation keeps the state for the external resource
+type NetworkACLObservation struct {
+	// NetworkACLID is the ID of the NetworkACL.
+	NetworkACLID string `json:"networkAclId,omitempty"`
+
+	// IsDefault indicates whether this is the default network ACL of the VPC.
+	IsDefault bool `json:"isDefault,omitempty"`
+
+	// The ID of the AWS account that owns the network ACL.
+	OwnerID string `json:"ownerId,omitempty"`
+
+	// The subnets associated with the network ACL.
+	Associations []NetworkACLAssociation `json:"associations,omitempty"`
+
+	// All entries of the network ACL, including the default entries.
+	Entries []NetworkACLRule `json:"entries,omitempty"`
+}
+
+// A NetworkACLStatus represents the observed state of a NetworkACL.
+type NetworkACLStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          NetworkACLObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A NetworkACL is a managed resource that represents an AWS VPC Network ACL.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="VPC",type="string",JSONPath=".spec.forProvider.vpcId"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type NetworkACL struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NetworkACLSpec   `json:"spec"`
+	Status NetworkACLStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NetworkACLList contains a list of NetworkACLs
+type NetworkACLList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NetworkACL `json:"items"`
+}
@@ -25,6 +25,16 @@ type CustomLaunchTemplateParameters struct {
 	// Metadata tagging key value pairs
 	// +optional
 	Tags []Tag `json:"tags,omitempty"`
+
+	// KeyNameRef is a reference to a KeyPair used to set
+	// the LaunchTemplateData.KeyName.
+	// +optional
+	KeyNameRef *xpv1.Reference `json:"keyNameRef,omitempty"`
+
+	// KeyNameSelector selects a reference to a KeyPair used
+	// to set the LaunchTemplateData.KeyName.
+	// +optional
+	KeyNameSelector *xpv1.Selector `json:"keyNameSelector,omitempty"`
 }
 
 // CustomVPCEndpointServiceConfigurationParameters contains the additional fields
@@ -335,6 +345,21 @@ type CustomRouteParameters struct {
 	// to set the GatewayID.
 	// +optional
 	GatewayIDSelector *xpv1.Selector `json:"gatewayIdSelector,omitempty"`
+
+	// The ID of a prefix list used for the destination match.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1.ManagedPrefixList
+	DestinationPrefixListID *string `json:"destinationPrefixListID,omitempty"`
+
+	// DestinationPrefixListIDRef is a reference to an API used to set
+	// the DestinationPrefixListID.
+	// +optional
+	DestinationPrefixListIDRef *xpv1.Reference `json:"destinationPrefixListIDRef,omitempty"`
+
+	// DestinationPrefixListIDSelector selects references to API used
+	// to set the DestinationPrefixListID.
+	// +optional
+	DestinationPrefixListIDSelector *xpv1.Selector `json:"destinationPrefixListIDSelector,omitempty"`
 }
 
 // CustomVPCEndpointParameters are custom parameters for VPCEndpoint
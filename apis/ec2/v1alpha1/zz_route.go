@@ -44,8 +44,6 @@ type RouteParameters struct {
 	// The IPv6 CIDR block used for the destination match. Routing decisions are
 	// based on the most specific match.
 	DestinationIPv6CIDRBlock *string `json:"destinationIPv6CIDRBlock,omitempty"`
-	// The ID of a prefix list used for the destination match.
-	DestinationPrefixListID *string `json:"destinationPrefixListID,omitempty"`
 	// [IPv6 traffic only] The ID of an egress-only internet gateway.
 	EgressOnlyInternetGatewayID *string `json:"egressOnlyInternetGatewayID,omitempty"`
 	// The ID of the local gateway.
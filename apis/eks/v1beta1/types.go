@@ -130,6 +130,80 @@ type ClusterParameters struct {
 	// Example: 1.15
 	// +optional
 	Version *string `json:"version,omitempty"`
+
+	// KubeconfigAuthentication configures how the kubeconfig that is published
+	// to the connection secret authenticates to the cluster. By default it
+	// contains a presigned token that the controller refreshes before it
+	// expires.
+	// +optional
+	KubeconfigAuthentication *KubeconfigAuthentication `json:"kubeconfigAuthentication,omitempty"`
+}
+
+// KubeconfigAuthenticationMode is the way a published kubeconfig
+// authenticates to the cluster.
+type KubeconfigAuthenticationMode string
+
+const (
+	// KubeconfigAuthenticationModeToken embeds a presigned token in the
+	// kubeconfig. The token expires after 15 minutes, so the controller
+	// refreshes it independent of the poll interval and publishes its
+	// expiration time to the connection secret.
+	KubeconfigAuthenticationModeToken KubeconfigAuthenticationMode = "Token"
+	// KubeconfigAuthenticationModeExec configures an exec credential plugin
+	// in the kubeconfig that fetches a token whenever it is needed. The
+	// plugin must be available to the consumer of the kubeconfig.
+	KubeconfigAuthenticationModeExec KubeconfigAuthenticationMode = "Exec"
+)
+
+// Exec credential plugin commands.
+const (
+	// KubeconfigExecCommandAWSIAMAuthenticator is the aws-iam-authenticator
+	// binary.
+	KubeconfigExecCommandAWSIAMAuthenticator = "aws-iam-authenticator"
+	// KubeconfigExecCommandAWSCLI is the AWS CLI.
+	KubeconfigExecCommandAWSCLI = "aws"
+)
+
+// KubeconfigAuthentication configures how a published kubeconfig
+// authenticates to the cluster.
+type KubeconfigAuthentication struct {
+	// Mode is the way the kubeconfig authenticates to the cluster. Token
+	// embeds a presigned token that is refreshed by the controller, Exec
+	// configures an exec credential plugin.
+	// +kubebuilder:validation:Enum=Token;Exec
+	// +kubebuilder:default=Token
+	// +optional
+	Mode KubeconfigAuthenticationMode `json:"mode,omitempty"`
+
+	// Exec configures the exec credential plugin. It is only used if Mode is
+	// Exec.
+	// +optional
+	Exec *KubeconfigExecConfig `json:"exec,omitempty"`
+}
+
+// KubeconfigExecConfig configures the exec credential plugin of a published
+// kubeconfig.
+type KubeconfigExecConfig struct {
+	// Command is the exec credential plugin to use. Defaults to
+	// aws-iam-authenticator.
+	// +kubebuilder:validation:Enum=aws-iam-authenticator;aws
+	// +optional
+	Command *string `json:"command,omitempty"`
+
+	// RoleARN is the ARN of an IAM role the plugin assumes before requesting
+	// a token.
+	// +optional
+	RoleARN *string `json:"roleARN,omitempty"`
+
+	// Region is the region passed to the plugin. Defaults to the region of
+	// the cluster.
+	// +optional
+	Region *string `json:"region,omitempty"`
+
+	// Env is a set of additional environment variables to set when the
+	// plugin is executed, e.g. AWS_PROFILE.
+	// +optional
+	Env map[string]string `json:"env,omitempty"`
 }
 
 // EncryptionConfig is the encryption configuration for a cluster.
@@ -21,6 +21,17 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+const (
+	// AnnotationKeyRotateAccessKey triggers the rotation of an AccessKey when
+	// it is set, regardless of its value. It is removed by the controller
+	// once the new access key was created.
+	AnnotationKeyRotateAccessKey = "iam.aws.crossplane.io/rotate"
+
+	// AnnotationKeyPreviousAccessKey holds the ID of the access key that was
+	// replaced by the last rotation until it is deleted.
+	AnnotationKeyPreviousAccessKey = "iam.aws.crossplane.io/previous-access-key-id"
+)
+
 // AccessKeyParameters define the desired state of an AWS IAM Access Key.
 type AccessKeyParameters struct {
 	// Username contains the name of the User.
@@ -41,6 +52,29 @@ type AccessKeyParameters struct {
 	// Must be either Active or Inactive.
 	// +kubebuilder:validation:Enum=Active;Inactive
 	Status string `json:"accessKeyStatus,omitempty"`
+
+	// RotationPolicy configures the automatic rotation of the access key.
+	// A rotation can also be triggered manually by setting the
+	// iam.aws.crossplane.io/rotate annotation.
+	// +optional
+	RotationPolicy *AccessKeyRotationPolicy `json:"rotationPolicy,omitempty"`
+}
+
+// AccessKeyRotationPolicy configures how an AccessKey is rotated. A rotation
+// creates a new access key and publishes it to the connection secret. The
+// previous access key stays active for the grace period so that consumers can
+// pick up the new one. It is deactivated afterwards and deleted after another
+// grace period.
+type AccessKeyRotationPolicy struct {
+	// MaxAge is the maximum age of an access key. The access key is rotated
+	// once it is older. It is not rotated based on its age if unset.
+	// +optional
+	MaxAge *metav1.Duration `json:"maxAge,omitempty"`
+
+	// GracePeriod is the time the previous access key stays active after a
+	// rotation, and inactive before it is deleted. Defaults to 1h.
+	// +optional
+	GracePeriod *metav1.Duration `json:"gracePeriod,omitempty"`
 }
 
 // An AccessKeySpec defines the desired state of an IAM Access Key.
@@ -49,9 +83,35 @@ type AccessKeySpec struct {
 	ForProvider       AccessKeyParameters `json:"forProvider"`
 }
 
+// AccessKeyObservation keeps the state for the external resource
+type AccessKeyObservation struct {
+	// AccessKeyID is the ID of the access key that is published to the
+	// connection secret.
+	AccessKeyID string `json:"accessKeyID,omitempty"`
+
+	// CreateDate is the date when the access key was created.
+	CreateDate *metav1.Time `json:"createDate,omitempty"`
+
+	// LastUsedDate is the date when the access key was most recently used to
+	// sign a request.
+	LastUsedDate *metav1.Time `json:"lastUsedDate,omitempty"`
+
+	// LastUsedServiceName is the name of the AWS service with which the
+	// access key was most recently used.
+	LastUsedServiceName string `json:"lastUsedServiceName,omitempty"`
+
+	// PreviousAccessKeyID is the ID of the access key that was replaced by
+	// the last rotation and is not deleted yet.
+	PreviousAccessKeyID string `json:"previousAccessKeyID,omitempty"`
+
+	// PreviousAccessKeyStatus is the status of the previous access key.
+	PreviousAccessKeyStatus string `json:"previousAccessKeyStatus,omitempty"`
+}
+
 // AccessKeyStatus represents the observed state of an IAM Access Key.
 type AccessKeyStatus struct {
 	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AccessKeyObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -61,6 +121,7 @@ type AccessKeyStatus struct {
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".spec.forProvider.accessKeyStatus"
+// +kubebuilder:printcolumn:name="KEY-AGE",type="date",JSONPath=".status.atProvider.createDate",priority=1
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
 type AccessKey struct {
@@ -0,0 +1,92 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// LoginProfileParameters define the desired state of an AWS IAM Login Profile.
+type LoginProfileParameters struct {
+	// Username contains the name of the User.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=User
+	Username string `json:"userName,omitempty"`
+
+	// UsernameRef references to an User to retrieve its userName
+	// +optional
+	UsernameRef *xpv1.Reference `json:"userNameRef,omitempty"`
+
+	// UsernameSelector selects a reference to an User to retrieve its userName
+	// +optional
+	UsernameSelector *xpv1.Selector `json:"userNameSelector,omitempty"`
+
+	// PasswordResetRequired specifies whether the user is required to set a
+	// new password on next sign-in. It only applies to the generated initial
+	// password, AWS clears the flag once the user changed the password.
+	// +optional
+	// +immutable
+	PasswordResetRequired *bool `json:"passwordResetRequired,omitempty"`
+}
+
+// A LoginProfileSpec defines the desired state of an IAM Login Profile.
+type LoginProfileSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       LoginProfileParameters `json:"forProvider"`
+}
+
+// LoginProfileObservation keeps the state for the external resource
+type LoginProfileObservation struct {
+	// CreateDate is the date when the password for the user was created.
+	CreateDate *metav1.Time `json:"createDate,omitempty"`
+}
+
+// LoginProfileStatus represents the observed state of an IAM Login Profile.
+type LoginProfileStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          LoginProfileObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A LoginProfile is a managed resource that represents the password of an AWS
+// IAM User for signing in to the AWS Management Console. The generated
+// password is published to the connection secret.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="USER",type="string",JSONPath=".spec.forProvider.userName"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type LoginProfile struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LoginProfileSpec   `json:"spec"`
+	Status LoginProfileStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LoginProfileList contains a list of IAM Login Profiles
+type LoginProfileList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LoginProfile `json:"items"`
+}
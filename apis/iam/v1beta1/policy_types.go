@@ -19,6 +19,8 @@ package v1beta1
 import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-aws/apis/common"
 )
 
 // PolicyParameters define the desired state of an AWS IAM Policy.
@@ -32,7 +34,14 @@ type PolicyParameters struct {
 	Path *string `json:"path,omitempty"`
 
 	// The JSON policy document that is the content for the policy.
-	Document string `json:"document"`
+	// Either document or policyDocument must be specified.
+	// +optional
+	Document string `json:"document,omitempty"`
+
+	// PolicyDocument is the structured alternative to document. Either
+	// document or policyDocument must be specified.
+	// +optional
+	PolicyDocument *common.ResourcePolicy `json:"policyDocument,omitempty"`
 
 	// The name of the policy.
 	Name string `json:"name"`
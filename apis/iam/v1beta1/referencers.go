@@ -17,8 +17,39 @@ limitations under the License.
 package v1beta1
 
 import (
+	"context"
+	"fmt"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/fieldpath"
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-aws/apis/common"
+)
+
+const (
+	// arnFieldPath is the field path of the ARN of referenced managed
+	// resources.
+	arnFieldPath = "status.atProvider.arn"
+
+	// managedResourceGroupSuffix is the suffix of the API groups of the
+	// managed resources of this provider.
+	managedResourceGroupSuffix = ".aws.crossplane.io"
+
+	errFmtUnsupportedReference = "cannot reference %s: only managed resources of the %s API groups can be referenced"
+	errGetReferencedResource   = "cannot get referenced resource"
+	errGetReferencedStatus     = "cannot get status of referenced resource"
+	errReferencedNotReady      = "referenced resource is not ready"
+	errGetReferencedARN        = "cannot get ARN from referenced resource"
+	errFmtReferencedNotARN     = "referenced resource does not report an ARN in %s"
 )
 
 // RoleARN returns the status.atProvider.ARN of a Role.
@@ -54,3 +85,128 @@ func UserARN() reference.ExtractValueFn {
 		return r.Status.AtProvider.ARN
 	}
 }
+
+// ResolveReferences of this Policy.
+func (mg *Policy) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+	return ResolveResourcePolicyReferences(ctx, c, r, mg.Spec.ForProvider.PolicyDocument, "spec.forProvider.policyDocument")
+}
+
+// ResolveReferences of this Role.
+func (mg *Role) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.ManagedPolicyARNs,
+		Extract:       PolicyARN(),
+		References:    mg.Spec.ForProvider.ManagedPolicyARNRefs,
+		Selector:      mg.Spec.ForProvider.ManagedPolicyARNSelector,
+		To:            reference.To{Managed: &Policy{}, List: &PolicyList{}},
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.managedPolicyArns")
+	}
+	mg.Spec.ForProvider.ManagedPolicyARNs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.ManagedPolicyARNRefs = mrsp.ResolvedReferences
+
+	return ResolveResourcePolicyReferences(ctx, c, r, mg.Spec.ForProvider.AssumeRolePolicy, "spec.forProvider.assumeRolePolicy")
+}
+
+// ResolveResourcePolicyReferences resolves the IAM user and role references of
+// the principals as well as the resource ARN references in the statements of
+// the given policy. path is the field path of the policy within the managed
+// resource and is only used to report errors.
+func ResolveResourcePolicyReferences(ctx context.Context, c client.Reader, r *reference.APIResolver, p *common.ResourcePolicy, path string) error {
+	if p == nil {
+		return nil
+	}
+	for i := range p.Statements {
+		s := &p.Statements[i]
+		statementPath := fmt.Sprintf("%s.statements[%d]", path, i)
+		if err := resolveResourcePrincipal(ctx, r, s.Principal, statementPath+".principal"); err != nil {
+			return err
+		}
+		if err := resolveResourcePrincipal(ctx, r, s.NotPrincipal, statementPath+".notPrincipal"); err != nil {
+			return err
+		}
+		// The resolved ARNs are rebuilt from the references every time, so
+		// that ARNs of removed references do not remain in the policy.
+		var arns []string
+		for j := range s.ResourceARNRefs {
+			arn, err := resolveARNReference(ctx, c, s.ResourceARNRefs[j])
+			if err != nil {
+				return errors.Wrap(err, fmt.Sprintf("%s.resourceArnRefs[%d]", statementPath, j))
+			}
+			arns = append(arns, arn)
+		}
+		s.ResourceARNs = arns
+	}
+	return nil
+}
+
+func resolveResourcePrincipal(ctx context.Context, r *reference.APIResolver, principal *common.ResourcePrincipal, path string) error {
+	if principal == nil {
+		return nil
+	}
+	for i := range principal.AWSPrincipals {
+		p := &principal.AWSPrincipals[i]
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(p.UserARN),
+			Reference:    p.UserARNRef,
+			Selector:     p.UserARNSelector,
+			To:           reference.To{Managed: &User{}, List: &UserList{}},
+			Extract:      UserARN(),
+		})
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("%s.awsPrincipals[%d].iamUserArn", path, i))
+		}
+		p.UserARN = reference.ToPtrValue(rsp.ResolvedValue)
+		p.UserARNRef = rsp.ResolvedReference
+
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(p.IAMRoleARN),
+			Reference:    p.IAMRoleARNRef,
+			Selector:     p.IAMRoleARNSelector,
+			To:           reference.To{Managed: &Role{}, List: &RoleList{}},
+			Extract:      RoleARN(),
+		})
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("%s.awsPrincipals[%d].iamRoleArn", path, i))
+		}
+		p.IAMRoleARN = reference.ToPtrValue(rsp.ResolvedValue)
+		p.IAMRoleARNRef = rsp.ResolvedReference
+	}
+	return nil
+}
+
+// resolveARNReference returns the ARN of the referenced managed resource. Only
+// ready managed resources of this provider that report an ARN can be
+// referenced.
+func resolveARNReference(ctx context.Context, c client.Reader, ref common.ARNReference) (string, error) {
+	gv, err := schema.ParseGroupVersion(ref.APIVersion)
+	if err != nil || !strings.HasSuffix(gv.Group, managedResourceGroupSuffix) {
+		return "", errors.Errorf(errFmtUnsupportedReference, ref.APIVersion, "*"+managedResourceGroupSuffix)
+	}
+	u := &unstructured.Unstructured{}
+	u.SetAPIVersion(ref.APIVersion)
+	u.SetKind(ref.Kind)
+	if err := c.Get(ctx, types.NamespacedName{Name: ref.Name}, u); err != nil {
+		return "", errors.Wrap(err, errGetReferencedResource)
+	}
+	p := fieldpath.Pave(u.Object)
+	status := xpv1.ConditionedStatus{}
+	if err := p.GetValueInto("status", &status); resource.Ignore(fieldpath.IsNotFound, err) != nil {
+		return "", errors.Wrap(err, errGetReferencedStatus)
+	}
+	if status.GetCondition(xpv1.TypeReady).Status != corev1.ConditionTrue {
+		return "", errors.New(errReferencedNotReady)
+	}
+	arn, err := p.GetString(arnFieldPath)
+	if resource.Ignore(fieldpath.IsNotFound, err) != nil {
+		return "", errors.Wrap(err, errGetReferencedARN)
+	}
+	if !strings.HasPrefix(arn, "arn:") {
+		return "", errors.Errorf(errFmtReferencedNotARN, arnFieldPath)
+	}
+	return arn, nil
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-aws/apis/common"
+)
+
+func TestResolveResourcePolicyReferences(t *testing.T) {
+	queueARN := "arn:aws:sqs:us-east-1:123456789012:queue"
+	queueRef := common.ARNReference{APIVersion: "sqs.aws.crossplane.io/v1beta1", Kind: "Queue", Name: "queue"}
+	refPath := "spec.forProvider.policyDocument.statements[0].resourceArnRefs[0]"
+
+	queue := func(ready bool, arn string) test.MockGetFn {
+		return func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+			status := map[string]any{"atProvider": map[string]any{"arn": arn}}
+			if ready {
+				status["conditions"] = []any{map[string]any{
+					"type":               "Ready",
+					"status":             "True",
+					"reason":             "Available",
+					"lastTransitionTime": "2023-01-01T00:00:00Z",
+				}}
+			}
+			obj.(*unstructured.Unstructured).Object["status"] = status
+			return nil
+		}
+	}
+	statement := func(arns []string, refs ...common.ARNReference) *common.ResourcePolicy {
+		return &common.ResourcePolicy{Statements: []common.ResourcePolicyStatement{{
+			Resource:        []string{"arn:aws:sqs:us-east-1:123456789012:literal"},
+			ResourceARNs:    arns,
+			ResourceARNRefs: refs,
+		}}}
+	}
+
+	type want struct {
+		p   *common.ResourcePolicy
+		err error
+	}
+	cases := map[string]struct {
+		get  test.MockGetFn
+		p    *common.ResourcePolicy
+		want want
+	}{
+		"ResolvedARNsAreReplaced": {
+			get: queue(true, queueARN),
+			p:   statement([]string{"arn:aws:sqs:us-east-1:123456789012:removed"}, queueRef),
+			want: want{
+				p: statement([]string{queueARN}, queueRef),
+			},
+		},
+		"ResolvedARNsAreRemovedWithoutReferences": {
+			p: statement([]string{"arn:aws:sqs:us-east-1:123456789012:removed"}),
+			want: want{
+				p: statement(nil),
+			},
+		},
+		"UnsupportedGroup": {
+			p: statement(nil, common.ARNReference{APIVersion: "v1", Kind: "Secret", Name: "secret"}),
+			want: want{
+				p:   statement(nil, common.ARNReference{APIVersion: "v1", Kind: "Secret", Name: "secret"}),
+				err: errors.Wrap(errors.Errorf(errFmtUnsupportedReference, "v1", "*"+managedResourceGroupSuffix), refPath),
+			},
+		},
+		"NotReady": {
+			get: queue(false, queueARN),
+			p:   statement(nil, queueRef),
+			want: want{
+				p:   statement(nil, queueRef),
+				err: errors.Wrap(errors.New(errReferencedNotReady), refPath),
+			},
+		},
+		"NotAnARN": {
+			get: queue(true, "queue"),
+			p:   statement(nil, queueRef),
+			want: want{
+				p:   statement(nil, queueRef),
+				err: errors.Wrap(errors.Errorf(errFmtReferencedNotARN, arnFieldPath), refPath),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &test.MockClient{MockGet: tc.get}
+			r := reference.NewAPIResolver(c, &Policy{})
+			err := ResolveResourcePolicyReferences(context.Background(), c, r, tc.p, "spec.forProvider.policyDocument")
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("err: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.p, tc.p); diff != "" {
+				t.Errorf("p: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
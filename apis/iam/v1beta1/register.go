@@ -120,6 +120,38 @@ var (
 	OpenIDConnectProviderGroupVersionKind = SchemeGroupVersion.WithKind(OpenIDConnectProviderKind)
 )
 
+// LoginProfile type metadata.
+var (
+	LoginProfileKind             = reflect.TypeOf(LoginProfile{}).Name()
+	LoginProfileGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: LoginProfileKind}.String()
+	LoginProfileKindAPIVersion   = LoginProfileKind + "." + SchemeGroupVersion.String()
+	LoginProfileGroupVersionKind = SchemeGroupVersion.WithKind(LoginProfileKind)
+)
+
+// SSHPublicKey type metadata.
+var (
+	SSHPublicKeyKind             = reflect.TypeOf(SSHPublicKey{}).Name()
+	SSHPublicKeyGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: SSHPublicKeyKind}.String()
+	SSHPublicKeyKindAPIVersion   = SSHPublicKeyKind + "." + SchemeGroupVersion.String()
+	SSHPublicKeyGroupVersionKind = SchemeGroupVersion.WithKind(SSHPublicKeyKind)
+)
+
+// ServiceSpecificCredential type metadata.
+var (
+	ServiceSpecificCredentialKind             = reflect.TypeOf(ServiceSpecificCredential{}).Name()
+	ServiceSpecificCredentialGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: ServiceSpecificCredentialKind}.String()
+	ServiceSpecificCredentialKindAPIVersion   = ServiceSpecificCredentialKind + "." + SchemeGroupVersion.String()
+	ServiceSpecificCredentialGroupVersionKind = SchemeGroupVersion.WithKind(ServiceSpecificCredentialKind)
+)
+
+// SigningCertificate type metadata.
+var (
+	SigningCertificateKind             = reflect.TypeOf(SigningCertificate{}).Name()
+	SigningCertificateGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: SigningCertificateKind}.String()
+	SigningCertificateKindAPIVersion   = SigningCertificateKind + "." + SchemeGroupVersion.String()
+	SigningCertificateGroupVersionKind = SchemeGroupVersion.WithKind(SigningCertificateKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&Role{}, &RoleList{})
 	SchemeBuilder.Register(&RolePolicyAttachment{}, &RolePolicyAttachmentList{})
@@ -131,4 +163,8 @@ func init() {
 	SchemeBuilder.Register(&GroupPolicyAttachment{}, &GroupPolicyAttachmentList{})
 	SchemeBuilder.Register(&AccessKey{}, &AccessKeyList{})
 	SchemeBuilder.Register(&OpenIDConnectProvider{}, &OpenIDConnectProviderList{})
+	SchemeBuilder.Register(&LoginProfile{}, &LoginProfileList{})
+	SchemeBuilder.Register(&SSHPublicKey{}, &SSHPublicKeyList{})
+	SchemeBuilder.Register(&ServiceSpecificCredential{}, &ServiceSpecificCredentialList{})
+	SchemeBuilder.Register(&SigningCertificate{}, &SigningCertificateList{})
 }
@@ -19,6 +19,8 @@ package v1beta1
 import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-aws/apis/common"
 )
 
 // Tag represents user-provided metadata that can be associated
@@ -48,14 +50,59 @@ type Tag struct {
 type RoleParameters struct {
 
 	// AssumeRolePolicyDocument is the the trust relationship policy document
-	// that grants an entity permission to assume the role.
+	// that grants an entity permission to assume the role. Either
+	// assumeRolePolicyDocument or assumeRolePolicy must be specified.
 	// +immutable
-	AssumeRolePolicyDocument string `json:"assumeRolePolicyDocument"`
+	// +optional
+	AssumeRolePolicyDocument string `json:"assumeRolePolicyDocument,omitempty"`
+
+	// AssumeRolePolicy is the structured alternative to
+	// assumeRolePolicyDocument. Either assumeRolePolicyDocument or
+	// assumeRolePolicy must be specified.
+	// +optional
+	AssumeRolePolicy *common.ResourcePolicy `json:"assumeRolePolicy,omitempty"`
 
 	// Description is a description of the role.
 	// +optional
 	Description *string `json:"description,omitempty"`
 
+	// ManageInlinePolicies indicates whether the inline policies of the role
+	// are managed through InlinePolicies. Inline policies that are not part
+	// of InlinePolicies are deleted if set, so all inline policies are deleted
+	// if InlinePolicies is empty.
+	// +optional
+	ManageInlinePolicies *bool `json:"manageInlinePolicies,omitempty"`
+
+	// InlinePolicies maps the names of the inline policies embedded in the
+	// role to their policy documents. They are only reconciled if
+	// ManageInlinePolicies is set.
+	// +optional
+	InlinePolicies map[string]string `json:"inlinePolicies,omitempty"`
+
+	// ManageManagedPolicies indicates whether the managed policies attached to
+	// the role are managed through ManagedPolicyARNs. Managed policies that
+	// are not part of ManagedPolicyARNs are detached if set, so all managed
+	// policies are detached if ManagedPolicyARNs is empty. It should not be
+	// combined with RolePolicyAttachments of the same role.
+	// +optional
+	ManageManagedPolicies *bool `json:"manageManagedPolicies,omitempty"`
+
+	// ManagedPolicyARNs is the exclusive list of the ARNs of the managed
+	// policies attached to the role. It is only reconciled if
+	// ManageManagedPolicies is set.
+	// +optional
+	ManagedPolicyARNs []string `json:"managedPolicyArns,omitempty"`
+
+	// ManagedPolicyARNRefs references Policies to retrieve their ARNs for
+	// ManagedPolicyARNs.
+	// +optional
+	ManagedPolicyARNRefs []xpv1.Reference `json:"managedPolicyArnRefs,omitempty"`
+
+	// ManagedPolicyARNSelector selects references to Policies to retrieve
+	// their ARNs for ManagedPolicyARNs.
+	// +optional
+	ManagedPolicyARNSelector *xpv1.Selector `json:"managedPolicyArnSelector,omitempty"`
+
 	// MaxSessionDuration is the duration (in seconds) that you want to set for the specified
 	// role. The default maximum of one hour is applied. This setting can have a value from 1 hour to 12 hours.
 	// Default: 3600
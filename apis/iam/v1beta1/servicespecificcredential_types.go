@@ -0,0 +1,113 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ServiceSpecificCredentialParameters define the desired state of an AWS IAM
+// Service Specific Credential.
+type ServiceSpecificCredentialParameters struct {
+	// Username contains the name of the User.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=User
+	Username string `json:"userName,omitempty"`
+
+	// UsernameRef references to an User to retrieve its userName
+	// +optional
+	UsernameRef *xpv1.Reference `json:"userNameRef,omitempty"`
+
+	// UsernameSelector selects a reference to an User to retrieve its userName
+	// +optional
+	UsernameSelector *xpv1.Selector `json:"userNameSelector,omitempty"`
+
+	// ServiceName is the name of the AWS service that is to be associated
+	// with the credentials, e.g. codecommit.amazonaws.com.
+	// +immutable
+	ServiceName string `json:"serviceName"`
+
+	// The status of the service specific credential. Active means that the
+	// credential can be used for API calls to the associated service.
+	// Must be either Active or Inactive.
+	// +optional
+	// +kubebuilder:validation:Enum=Active;Inactive
+	Status string `json:"status,omitempty"`
+}
+
+// A ServiceSpecificCredentialSpec defines the desired state of an IAM Service
+// Specific Credential.
+type ServiceSpecificCredentialSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ServiceSpecificCredentialParameters `json:"forProvider"`
+}
+
+// ServiceSpecificCredentialObservation keeps the state for the external
+// resource
+type ServiceSpecificCredentialObservation struct {
+	// ServiceSpecificCredentialID is the unique identifier of the service
+	// specific credential.
+	ServiceSpecificCredentialID string `json:"serviceSpecificCredentialID,omitempty"`
+
+	// ServiceUserName is the generated user name that has to be used
+	// together with the generated password to access the service.
+	ServiceUserName string `json:"serviceUserName,omitempty"`
+
+	// CreateDate is the date when the service specific credential was
+	// created.
+	CreateDate *metav1.Time `json:"createDate,omitempty"`
+}
+
+// ServiceSpecificCredentialStatus represents the observed state of an IAM
+// Service Specific Credential.
+type ServiceSpecificCredentialStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ServiceSpecificCredentialObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ServiceSpecificCredential is a managed resource that represents a set of
+// credentials of an AWS IAM User for a single AWS service, e.g. the HTTPS Git
+// credentials for CodeCommit. The generated user name and password are
+// published to the connection secret.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="SERVICE",type="string",JSONPath=".spec.forProvider.serviceName"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".spec.forProvider.status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type ServiceSpecificCredential struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ServiceSpecificCredentialSpec   `json:"spec"`
+	Status ServiceSpecificCredentialStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ServiceSpecificCredentialList contains a list of IAM Service Specific
+// Credentials
+type ServiceSpecificCredentialList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServiceSpecificCredential `json:"items"`
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SigningCertificateParameters define the desired state of an AWS IAM Signing
+// Certificate.
+type SigningCertificateParameters struct {
+	// Username contains the name of the User.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=User
+	Username string `json:"userName,omitempty"`
+
+	// UsernameRef references to an User to retrieve its userName
+	// +optional
+	UsernameRef *xpv1.Reference `json:"userNameRef,omitempty"`
+
+	// UsernameSelector selects a reference to an User to retrieve its userName
+	// +optional
+	UsernameSelector *xpv1.Selector `json:"userNameSelector,omitempty"`
+
+	// CertificateBody is the contents of the PEM encoded X.509 signing
+	// certificate.
+	// +immutable
+	CertificateBody string `json:"certificateBody"`
+
+	// The status of the signing certificate. Active means that the
+	// certificate can be used for programmatic calls to AWS.
+	// Must be either Active or Inactive.
+	// +optional
+	// +kubebuilder:validation:Enum=Active;Inactive
+	Status string `json:"status,omitempty"`
+}
+
+// A SigningCertificateSpec defines the desired state of an IAM Signing
+// Certificate.
+type SigningCertificateSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       SigningCertificateParameters `json:"forProvider"`
+}
+
+// SigningCertificateObservation keeps the state for the external resource
+type SigningCertificateObservation struct {
+	// CertificateID is the unique identifier of the signing certificate.
+	CertificateID string `json:"certificateID,omitempty"`
+
+	// UploadDate is the date when the signing certificate was uploaded.
+	UploadDate *metav1.Time `json:"uploadDate,omitempty"`
+}
+
+// SigningCertificateStatus represents the observed state of an IAM Signing
+// Certificate.
+type SigningCertificateStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          SigningCertificateObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A SigningCertificate is a managed resource that represents an X.509 signing
+// certificate of an AWS IAM User.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".spec.forProvider.status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type SigningCertificate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SigningCertificateSpec   `json:"spec"`
+	Status SigningCertificateStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SigningCertificateList contains a list of IAM Signing Certificates
+type SigningCertificateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SigningCertificate `json:"items"`
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SSHPublicKeyParameters define the desired state of an AWS IAM SSH Public Key.
+type SSHPublicKeyParameters struct {
+	// Username contains the name of the User.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=User
+	Username string `json:"userName,omitempty"`
+
+	// UsernameRef references to an User to retrieve its userName
+	// +optional
+	UsernameRef *xpv1.Reference `json:"userNameRef,omitempty"`
+
+	// UsernameSelector selects a reference to an User to retrieve its userName
+	// +optional
+	UsernameSelector *xpv1.Selector `json:"userNameSelector,omitempty"`
+
+	// SSHPublicKeyBody is the SSH public key. It must be encoded in ssh-rsa
+	// format or PEM format. The minimum bit-length of the public key is 2048
+	// bits.
+	// +immutable
+	SSHPublicKeyBody string `json:"sshPublicKeyBody"`
+
+	// The status of the SSH public key. Active means that the key can be
+	// used for authentication with an CodeCommit repository.
+	// Must be either Active or Inactive.
+	// +optional
+	// +kubebuilder:validation:Enum=Active;Inactive
+	Status string `json:"status,omitempty"`
+}
+
+// A SSHPublicKeySpec defines the desired state of an IAM SSH Public Key.
+type SSHPublicKeySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       SSHPublicKeyParameters `json:"forProvider"`
+}
+
+// SSHPublicKeyObservation keeps the state for the external resource
+type SSHPublicKeyObservation struct {
+	// SSHPublicKeyID is the unique identifier of the SSH public key, which is
+	// used as SSH user name for CodeCommit.
+	SSHPublicKeyID string `json:"sshPublicKeyID,omitempty"`
+
+	// Fingerprint is the MD5 message digest of the SSH public key.
+	Fingerprint string `json:"fingerprint,omitempty"`
+
+	// UploadDate is the date when the SSH public key was uploaded.
+	UploadDate *metav1.Time `json:"uploadDate,omitempty"`
+}
+
+// SSHPublicKeyStatus represents the observed state of an IAM SSH Public Key.
+type SSHPublicKeyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          SSHPublicKeyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A SSHPublicKey is a managed resource that represents an SSH public key of
+// an AWS IAM User, which is used to authenticate against CodeCommit.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".spec.forProvider.status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type SSHPublicKey struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SSHPublicKeySpec   `json:"spec"`
+	Status SSHPublicKeyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SSHPublicKeyList contains a list of IAM SSH Public Keys
+type SSHPublicKeyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SSHPublicKey `json:"items"`
+}
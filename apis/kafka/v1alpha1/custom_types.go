@@ -44,8 +44,6 @@ type CustomClusterParameters struct {
 type CustomConfigurationInfo struct {
 	// ARN of the configuration to use.
 	// +optional
-	// +crossplane:generate:reference:type=Configuration
-	// +crossplane:generate:reference:extractor=ConfugurationARN()
 	ARN *string `json:"arn,omitempty"`
 
 	// ARNRef is a reference to a Kafka Configuration used to set ARN.
@@ -62,9 +60,6 @@ type CustomConfigurationInfo struct {
 // CustomBrokerNodeGroupInfo contains the additional fields for BrokerNodeGroupInfo.
 type CustomBrokerNodeGroupInfo struct {
 	// +optional
-	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-aws/apis/ec2/v1beta1.Subnet
-	// +crossplane:generate:reference:refFieldName=ClientSubnetRefs
-	// +crossplane:generate:reference:selectorFieldName=ClientSubnetSelector
 	ClientSubnets []*string `json:"clientSubnets,omitempty"`
 
 	// ClientSubnetRefs is a list of references to Subnets used to set
@@ -80,9 +75,6 @@ type CustomBrokerNodeGroupInfo struct {
 	InstanceType *string `json:"instanceType,omitempty"`
 
 	// +optional
-	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-aws/apis/ec2/v1beta1.SecurityGroup
-	// +crossplane:generate:reference:refFieldName=SecurityGroupRefs
-	// +crossplane:generate:reference:selectorFieldName=SecurityGroupSelector
 	SecurityGroups []*string `json:"securityGroups,omitempty"`
 
 	// SecurityGroupRefs is a list of references to SecurityGroups used to set
@@ -17,9 +17,16 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"context"
+
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ec2 "github.com/crossplane-contrib/provider-aws/apis/ec2/v1beta1"
+	iamv1beta1 "github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
 )
 
 // ConfugurationARN returns the status.atProvider.ARN of a Configuration.
@@ -33,3 +40,56 @@ func ConfugurationARN() reference.ExtractValueFn {
 
 	}
 }
+
+// ResolveReferences of this Cluster.
+func (mg *Cluster) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	if info := mg.Spec.ForProvider.CustomBrokerNodeGroupInfo; info != nil {
+		// Resolve spec.forProvider.brokerNodeGroupInfo.clientSubnets
+		mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+			CurrentValues: reference.FromPtrValues(info.ClientSubnets),
+			References:    info.ClientSubnetRefs,
+			Selector:      info.ClientSubnetSelector,
+			To:            reference.To{Managed: &ec2.Subnet{}, List: &ec2.SubnetList{}},
+			Extract:       reference.ExternalName(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.brokerNodeGroupInfo.clientSubnets")
+		}
+		info.ClientSubnets = reference.ToPtrValues(mrsp.ResolvedValues)
+		info.ClientSubnetRefs = mrsp.ResolvedReferences
+
+		// Resolve spec.forProvider.brokerNodeGroupInfo.securityGroups
+		mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+			CurrentValues: reference.FromPtrValues(info.SecurityGroups),
+			References:    info.SecurityGroupRefs,
+			Selector:      info.SecurityGroupSelector,
+			To:            reference.To{Managed: &ec2.SecurityGroup{}, List: &ec2.SecurityGroupList{}},
+			Extract:       reference.ExternalName(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.brokerNodeGroupInfo.securityGroups")
+		}
+		info.SecurityGroups = reference.ToPtrValues(mrsp.ResolvedValues)
+		info.SecurityGroupRefs = mrsp.ResolvedReferences
+	}
+
+	if info := mg.Spec.ForProvider.CustomConfigurationInfo; info != nil {
+		// Resolve spec.forProvider.configurationInfo.arn
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(info.ARN),
+			Reference:    info.ARNRef,
+			Selector:     info.ARNSelector,
+			To:           reference.To{Managed: &Configuration{}, List: &ConfigurationList{}},
+			Extract:      ConfugurationARN(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.configurationInfo.arn")
+		}
+		info.ARN = reference.ToPtrValue(rsp.ResolvedValue)
+		info.ARNRef = rsp.ResolvedReference
+	}
+
+	return iamv1beta1.ResolveResourcePolicyReferences(ctx, c, r, mg.Spec.ForProvider.ClusterPolicy, "spec.forProvider.clusterPolicy")
+}
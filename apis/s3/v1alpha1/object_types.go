@@ -0,0 +1,167 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ObjectParameters define the desired state of an AWS S3 Object.
+type ObjectParameters struct {
+	// Region is where the Bucket holding this Object resides.
+	// +immutable
+	Region string `json:"region"`
+
+	// Bucket is the name of the Bucket the Object is stored in.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-aws/apis/s3/v1beta1.Bucket
+	Bucket *string `json:"bucket,omitempty"`
+
+	// BucketRef references a Bucket to retrieve its name.
+	// +optional
+	BucketRef *xpv1.Reference `json:"bucketRef,omitempty"`
+
+	// BucketSelector selects a reference to a Bucket to retrieve its name.
+	// +optional
+	BucketSelector *xpv1.Selector `json:"bucketSelector,omitempty"`
+
+	// Key is the object key the content is stored under.
+	// +immutable
+	Key string `json:"key"`
+
+	// Content is the source of the object body. Exactly one of its fields
+	// must be set.
+	Content ObjectContentSource `json:"content"`
+
+	// ContentType is a standard MIME type describing the format of the
+	// object data.
+	// +optional
+	ContentType *string `json:"contentType,omitempty"`
+
+	// Metadata is a map of user-defined metadata to store with the object.
+	// Keys are stored in lower case.
+	// +optional
+	Metadata map[string]string `json:"metadata,omitempty"`
+
+	// ServerSideEncryption is the server-side encryption algorithm used
+	// when storing this object.
+	// +kubebuilder:validation:Enum=AES256;aws:kms
+	// +optional
+	ServerSideEncryption *string `json:"serverSideEncryption,omitempty"`
+
+	// SSEKMSKeyID is the ID or ARN of the AWS KMS key used to encrypt the
+	// object. Aliases are not supported since S3 reports the key ARN. Only
+	// valid when ServerSideEncryption is aws:kms.
+	// +optional
+	SSEKMSKeyID *string `json:"sseKMSKeyID,omitempty"`
+
+	// Tags is the set of tags assigned to the object.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+
+	// DeleteAllVersions removes every version and delete marker of the
+	// object when this resource is deleted instead of only adding a delete
+	// marker on versioned buckets.
+	// +optional
+	DeleteAllVersions *bool `json:"deleteAllVersions,omitempty"`
+}
+
+// ObjectContentSource is the source of the body of an Object.
+type ObjectContentSource struct {
+	// Inline is the object body as a plain string.
+	// +optional
+	Inline *string `json:"inline,omitempty"`
+
+	// Base64 is the object body as base64 encoded data.
+	// +optional
+	Base64 *string `json:"base64,omitempty"`
+
+	// SecretKeyRef reads the object body from a key of a Secret.
+	// +optional
+	SecretKeyRef *xpv1.SecretKeySelector `json:"secretKeyRef,omitempty"`
+
+	// ConfigMapKeyRef reads the object body from a key of a ConfigMap.
+	// +optional
+	ConfigMapKeyRef *ConfigMapKeySelector `json:"configMapKeyRef,omitempty"`
+}
+
+// ConfigMapKeySelector selects a key of a ConfigMap.
+type ConfigMapKeySelector struct {
+	// Name of the ConfigMap.
+	Name string `json:"name"`
+
+	// Namespace of the ConfigMap.
+	Namespace string `json:"namespace"`
+
+	// Key whose value is selected. Both data and binaryData are searched.
+	Key string `json:"key"`
+}
+
+// ObjectObservation keeps the state for the external resource.
+type ObjectObservation struct {
+	// ETag is the entity tag of the stored object.
+	ETag string `json:"eTag,omitempty"`
+
+	// VersionID is the version of the stored object on versioned buckets.
+	VersionID string `json:"versionID,omitempty"`
+
+	// ContentSHA256 is the hex encoded SHA-256 checksum of the uploaded
+	// content.
+	ContentSHA256 string `json:"contentSHA256,omitempty"`
+}
+
+// An ObjectSpec defines the desired state of an Object.
+type ObjectSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ObjectParameters `json:"forProvider"`
+}
+
+// An ObjectStatus represents the observed state of an Object.
+type ObjectStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ObjectObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Object is a managed resource that represents a small object stored in
+// an AWS S3 Bucket.
+// +kubebuilder:printcolumn:name="BUCKET",type="string",JSONPath=".spec.forProvider.bucket"
+// +kubebuilder:printcolumn:name="KEY",type="string",JSONPath=".spec.forProvider.key"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Object struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ObjectSpec   `json:"spec"`
+	Status ObjectStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ObjectList contains a list of Objects
+type ObjectList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Object `json:"items"`
+}
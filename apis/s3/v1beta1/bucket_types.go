@@ -67,6 +67,13 @@ type BucketParameters struct {
 	// +optional
 	ObjectLockEnabledForBucket *bool `json:"objectLockEnabledForBucket,omitempty"`
 
+	// ObjectLockConfiguration manages the default retention of the bucket.
+	// Applying it enables Object Lock on the bucket, which requires
+	// versioning to be enabled. Removing it leaves the Object Lock
+	// configuration of the bucket unchanged.
+	// +optional
+	ObjectLockConfiguration *ObjectLockConfiguration `json:"objectLockConfiguration,omitempty"`
+
 	// The container element for object ownership for a bucket's ownership controls.
 	// BucketOwnerPreferred - Objects uploaded to the bucket change ownership to the
 	// bucket owner if the objects are uploaded with the bucket-owner-full-control
@@ -161,6 +168,39 @@ type BucketParameters struct {
 	// S3 bucket.
 	PublicAccessBlockConfiguration *PublicAccessBlockConfiguration `json:"publicAccessBlockConfiguration,omitempty"`
 
+	// IntelligentTieringConfigurations of the bucket, identified by their ID.
+	// Configurations that exist on the bucket but are not listed here are
+	// deleted.
+	// For more information, see Storage class for automatically optimizing
+	// frequently and infrequently accessed objects
+	// (https://docs.aws.amazon.com/AmazonS3/latest/dev/storage-class-intro.html#sc-dynamic-data-access).
+	// +optional
+	IntelligentTieringConfigurations []IntelligentTieringConfiguration `json:"intelligentTieringConfigurations,omitempty"`
+
+	// InventoryConfigurations of the bucket, identified by their ID.
+	// Configurations that exist on the bucket but are not listed here are
+	// deleted.
+	// For more information, see Amazon S3 Inventory
+	// (https://docs.aws.amazon.com/AmazonS3/latest/dev/storage-inventory.html).
+	// +optional
+	InventoryConfigurations []InventoryConfiguration `json:"inventoryConfigurations,omitempty"`
+
+	// AnalyticsConfigurations of the bucket, identified by their ID.
+	// Configurations that exist on the bucket but are not listed here are
+	// deleted.
+	// For more information, see Amazon S3 Analytics - Storage Class Analysis
+	// (https://docs.aws.amazon.com/AmazonS3/latest/dev/analytics-storage-class.html).
+	// +optional
+	AnalyticsConfigurations []AnalyticsConfiguration `json:"analyticsConfigurations,omitempty"`
+
+	// MetricsConfigurations of the bucket, identified by their ID.
+	// Configurations that exist on the bucket but are not listed here are
+	// deleted.
+	// For more information, see Monitoring metrics with Amazon CloudWatch
+	// (https://docs.aws.amazon.com/AmazonS3/latest/dev/cloudwatch-monitoring.html).
+	// +optional
+	MetricsConfigurations []MetricsConfiguration `json:"metricsConfigurations,omitempty"`
+
 	// Policy is a well defined type which can be parsed into an JSON S3 Bucket
 	// Policy.
 	//
@@ -173,6 +213,22 @@ type BucketParameters struct {
 
 	// PolicyUpdatePolicy specifies the update behaviour of `policy`.
 	PolicyUpdatePolicy *BucketPolicyUpdatePolicy `json:"policyUpdatePolicy,omitempty"`
+
+	// ForceDestroy empties the bucket before it is deleted, so that buckets
+	// that are not empty can be deleted. All object versions and delete
+	// markers are deleted and in-progress multipart uploads are aborted.
+	// Large buckets are emptied over multiple reconciles; the progress is
+	// reported in the message of the Ready condition.
+	// WARNING: The objects cannot be recovered.
+	// +optional
+	ForceDestroy *bool `json:"forceDestroy,omitempty"`
+
+	// BypassGovernanceRetention deletes objects that are locked in governance
+	// mode when the bucket is force destroyed. It requires the
+	// s3:BypassGovernanceRetention permission. Objects that are locked in
+	// compliance mode or under legal hold cannot be deleted.
+	// +optional
+	BypassGovernanceRetention *bool `json:"bypassGovernanceRetention,omitempty"`
 }
 
 // BucketPolicyUpdatePolicy specifies the update behaviour of a bucket policy.
@@ -0,0 +1,100 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+// IntelligentTieringConfiguration specifies the S3 Intelligent-Tiering
+// configuration for an Amazon S3 bucket. For more information, see Storage
+// class for automatically optimizing frequently and infrequently accessed
+// objects (https://docs.aws.amazon.com/AmazonS3/latest/dev/storage-class-intro.html#sc-dynamic-data-access).
+type IntelligentTieringConfiguration struct {
+	// The ID used to identify the S3 Intelligent-Tiering configuration.
+	// ID is a required field
+	ID string `json:"id"`
+
+	// Specifies a bucket filter. The configuration only includes objects that
+	// meet the filter's criteria.
+	// +optional
+	Filter *IntelligentTieringFilter `json:"filter,omitempty"`
+
+	// Specifies the status of the configuration.
+	// Valid values are "Enabled", "Disabled"
+	// Status is a required field
+	// +kubebuilder:validation:Enum=Enabled;Disabled
+	Status string `json:"status"`
+
+	// Specifies the S3 Intelligent-Tiering storage class tier of the
+	// configuration.
+	// Tierings is a required field
+	Tierings []Tiering `json:"tierings"`
+}
+
+// IntelligentTieringFilter specifies the Amazon S3 object key name to filter on
+// and whether to filter on the object's tags. Only one of prefix, tag or and
+// may be specified.
+type IntelligentTieringFilter struct {
+	// A conjunction (logical AND) of predicates, which is used in evaluating
+	// a metrics filter. The operator must have at least two predicates, and an
+	// object must match all of the predicates in order for the filter to apply.
+	// +optional
+	And *IntelligentTieringAndOperator `json:"and,omitempty"`
+
+	// An object key name prefix that identifies the subset of objects to which
+	// the rule applies.
+	// +optional
+	Prefix *string `json:"prefix,omitempty"`
+
+	// A container of a key value name pair.
+	// +optional
+	Tag *Tag `json:"tag,omitempty"`
+}
+
+// IntelligentTieringAndOperator is a container for specifying S3
+// Intelligent-Tiering filters. The filters determine the subset of objects to
+// which the rule applies.
+type IntelligentTieringAndOperator struct {
+	// An object key name prefix that identifies the subset of objects to which
+	// the configuration applies.
+	// +optional
+	Prefix *string `json:"prefix,omitempty"`
+
+	// All of these tags must exist in the object's tag set in order for the
+	// configuration to apply.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// Tiering is the S3 Intelligent-Tiering storage class that is designed to
+// optimize storage costs by automatically moving data to the most
+// cost-effective storage access tier, without additional operational overhead.
+type Tiering struct {
+	// S3 Intelligent-Tiering access tier. See Storage class for automatically
+	// optimizing frequently and infrequently accessed objects
+	// (https://docs.aws.amazon.com/AmazonS3/latest/dev/storage-class-intro.html#sc-dynamic-data-access)
+	// for a list of access tiers in the S3 Intelligent-Tiering storage class.
+	// Valid values are "ARCHIVE_ACCESS", "DEEP_ARCHIVE_ACCESS"
+	// AccessTier is a required field
+	// +kubebuilder:validation:Enum=ARCHIVE_ACCESS;DEEP_ARCHIVE_ACCESS
+	AccessTier string `json:"accessTier"`
+
+	// The number of consecutive days of no access after which an object will
+	// be eligible to be transitioned to the corresponding tier. The minimum
+	// number of days specified for Archive Access tier must be at least 90
+	// days and Deep Archive Access tier must be at least 180 days. The maximum
+	// can be up to 2 years (730 days).
+	// Days is a required field
+	Days int32 `json:"days"`
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+// ObjectLockConfiguration is the container element for Object Lock
+// configuration parameters. For more information, see Using S3 Object Lock
+// (https://docs.aws.amazon.com/AmazonS3/latest/dev/object-lock.html).
+type ObjectLockConfiguration struct {
+	// Specifies the Object Lock rule for the bucket. Omit it to remove the
+	// default retention of the bucket. Default retention in COMPLIANCE mode
+	// cannot be removed.
+	// +optional
+	Rule *ObjectLockRule `json:"rule,omitempty"`
+}
+
+// ObjectLockRule is the container element for an Object Lock rule.
+type ObjectLockRule struct {
+	// The default Object Lock retention mode and period that you want to apply
+	// to new objects placed in the bucket.
+	// DefaultRetention is a required field
+	DefaultRetention DefaultRetention `json:"defaultRetention"`
+}
+
+// DefaultRetention is the container element for specifying the default
+// Object Lock retention settings for new objects placed in the bucket.
+// Exactly one of days or years must be specified.
+//
+// A retention in COMPLIANCE mode can only be extended: changing the mode to
+// GOVERNANCE or shortening the retention period is rejected and reported in
+// the ObjectLockRetention condition of the Bucket.
+type DefaultRetention struct {
+	// The number of days that you want to specify for the default retention
+	// period.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Days *int32 `json:"days,omitempty"`
+
+	// The default Object Lock retention mode you want to apply to new objects
+	// placed in the bucket.
+	// Valid values are "GOVERNANCE", "COMPLIANCE"
+	// Mode is a required field
+	// +kubebuilder:validation:Enum=GOVERNANCE;COMPLIANCE
+	Mode string `json:"mode"`
+
+	// The number of years that you want to specify for the default retention
+	// period.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Years *int32 `json:"years,omitempty"`
+}
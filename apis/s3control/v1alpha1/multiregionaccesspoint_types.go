@@ -0,0 +1,161 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/crossplane-contrib/provider-aws/apis/s3/common"
+)
+
+// AnnotationKeyRequestToken holds the request token ARN of the asynchronous
+// operation that is in progress for a MultiRegionAccessPoint, if any. It is
+// recorded in an annotation rather than only in the status because the status
+// set while creating a managed resource is not persisted.
+const AnnotationKeyRequestToken = "s3control.aws.crossplane.io/request-token-arn"
+
+// MultiRegionAccessPointParameters defines the desired state of MultiRegionAccessPoint
+type MultiRegionAccessPointParameters struct {
+	// The Amazon Web Services account ID for the owner of the Multi-Region Access
+	// Point.
+	// +kubebuilder:validation:Required
+	AccountID *string `json:"accountID"`
+
+	// The buckets in different Regions that are associated with the Multi-Region
+	// Access Point.
+	// +immutable
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=20
+	Regions []MultiRegionAccessPointRegion `json:"regions"`
+
+	// The PublicAccessBlock configuration that you want to apply to this Multi-Region
+	// Access Point.
+	// +immutable
+	// +optional
+	PublicAccessBlockConfiguration *PublicAccessBlockConfiguration `json:"publicAccessBlockConfiguration,omitempty"`
+
+	// The policy that you want to apply to the Multi-Region Access Point. The
+	// policy cannot be removed once it was set, since Amazon S3 has no API to
+	// delete the policy of a Multi-Region Access Point.
+	// +optional
+	Policy *common.BucketPolicyBody `json:"policy,omitempty"`
+}
+
+// MultiRegionAccessPointRegion is a bucket associated with a Multi-Region
+// Access Point.
+type MultiRegionAccessPointRegion struct {
+	// The name of the associated bucket for the Region.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-aws/apis/s3/v1beta1.Bucket
+	Bucket *string `json:"bucket,omitempty"`
+
+	// BucketRef is a reference to a Bucket used to set the Bucket
+	// +optional
+	BucketRef *xpv1.Reference `json:"bucketRef,omitempty"`
+
+	// BucketSelector selects a reference to a Bucket used to set the Bucket
+	// +optional
+	BucketSelector *xpv1.Selector `json:"bucketSelector,omitempty"`
+
+	// The Amazon Web Services account ID that owns the Amazon S3 bucket that's
+	// associated with this Multi-Region Access Point.
+	// +optional
+	BucketAccountID *string `json:"bucketAccountID,omitempty"`
+}
+
+// MultiRegionAccessPointSpec defines the desired state of MultiRegionAccessPoint
+type MultiRegionAccessPointSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       MultiRegionAccessPointParameters `json:"forProvider"`
+}
+
+// MultiRegionAccessPointObservation defines the observed state of MultiRegionAccessPoint
+type MultiRegionAccessPointObservation struct {
+	// The alias for the Multi-Region Access Point.
+	Alias *string `json:"alias,omitempty"`
+
+	// The ARN of the Multi-Region Access Point.
+	ARN *string `json:"arn,omitempty"`
+
+	// The current status of the Multi-Region Access Point.
+	Status *string `json:"status,omitempty"`
+
+	// The Regions the buckets of the Multi-Region Access Point reside in.
+	Regions []MultiRegionAccessPointRegionObservation `json:"regions,omitempty"`
+
+	// The request token ARN of the asynchronous operation that is in progress
+	// for the Multi-Region Access Point, if any.
+	RequestTokenARN *string `json:"requestTokenARN,omitempty"`
+}
+
+// MultiRegionAccessPointRegionObservation is the observed state of a bucket
+// associated with a Multi-Region Access Point.
+type MultiRegionAccessPointRegionObservation struct {
+	// The name of the bucket.
+	Bucket *string `json:"bucket,omitempty"`
+
+	// The name of the Region the bucket resides in.
+	Region *string `json:"region,omitempty"`
+}
+
+// MultiRegionAccessPointStatus defines the observed state of MultiRegionAccessPoint.
+type MultiRegionAccessPointStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          MultiRegionAccessPointObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MultiRegionAccessPoint is a managed resource that represents an Amazon S3
+// Multi-Region Access Point. All requests for it are sent to us-west-2, which
+// hosts the control plane of Multi-Region Access Points.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type MultiRegionAccessPoint struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              MultiRegionAccessPointSpec   `json:"spec"`
+	Status            MultiRegionAccessPointStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MultiRegionAccessPointList contains a list of MultiRegionAccessPoints
+type MultiRegionAccessPointList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MultiRegionAccessPoint `json:"items"`
+}
+
+// MultiRegionAccessPoint type metadata.
+var (
+	MultiRegionAccessPointKind             = "MultiRegionAccessPoint"
+	MultiRegionAccessPointGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: MultiRegionAccessPointKind}.String()
+	MultiRegionAccessPointKindAPIVersion   = MultiRegionAccessPointKind + "." + GroupVersion.String()
+	MultiRegionAccessPointGroupVersionKind = GroupVersion.WithKind(MultiRegionAccessPointKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&MultiRegionAccessPoint{}, &MultiRegionAccessPointList{})
+}
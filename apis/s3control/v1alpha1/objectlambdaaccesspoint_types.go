@@ -0,0 +1,180 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/crossplane-contrib/provider-aws/apis/s3/common"
+)
+
+// ObjectLambdaAccessPointParameters defines the desired state of ObjectLambdaAccessPoint
+type ObjectLambdaAccessPointParameters struct {
+	// Region is which region the ObjectLambdaAccessPoint will be created.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// The Amazon Web Services account ID for the owner of the Object Lambda
+	// Access Point.
+	// +kubebuilder:validation:Required
+	AccountID *string `json:"accountID"`
+
+	// The configuration of the Object Lambda Access Point.
+	Configuration ObjectLambdaConfiguration `json:"configuration"`
+
+	// The policy that you want to apply to the Object Lambda Access Point.
+	// +optional
+	Policy *common.BucketPolicyBody `json:"policy,omitempty"`
+}
+
+// ObjectLambdaConfiguration is the configuration used when creating an
+// Object Lambda Access Point.
+type ObjectLambdaConfiguration struct {
+	// A container for allowed features.
+	// +optional
+	AllowedFeatures []string `json:"allowedFeatures,omitempty"`
+
+	// A container for whether the CloudWatch metrics configuration is enabled.
+	// +optional
+	CloudWatchMetricsEnabled *bool `json:"cloudWatchMetricsEnabled,omitempty"`
+
+	// Standard access point associated with the Object Lambda Access Point.
+	// +optional
+	// +crossplane:generate:reference:type=AccessPoint
+	// +crossplane:generate:reference:extractor=AccessPointARN()
+	SupportingAccessPoint *string `json:"supportingAccessPoint,omitempty"`
+
+	// SupportingAccessPointRef is a reference to an AccessPoint used to set
+	// the SupportingAccessPoint
+	// +optional
+	SupportingAccessPointRef *xpv1.Reference `json:"supportingAccessPointRef,omitempty"`
+
+	// SupportingAccessPointSelector selects a reference to an AccessPoint used
+	// to set the SupportingAccessPoint
+	// +optional
+	SupportingAccessPointSelector *xpv1.Selector `json:"supportingAccessPointSelector,omitempty"`
+
+	// A container for transformation configurations for an Object Lambda Access
+	// Point.
+	// +kubebuilder:validation:MinItems=1
+	TransformationConfigurations []ObjectLambdaTransformationConfiguration `json:"transformationConfigurations"`
+}
+
+// ObjectLambdaTransformationConfiguration is a configuration used when
+// creating an Object Lambda Access Point transformation.
+type ObjectLambdaTransformationConfiguration struct {
+	// A container for the action of an Object Lambda Access Point configuration.
+	// Valid inputs are GetObject, ListObjects, HeadObject, and ListObjectsV2.
+	// +kubebuilder:validation:MinItems=1
+	Actions []string `json:"actions"`
+
+	// A container for the content transformation of an Object Lambda Access
+	// Point configuration.
+	ContentTransformation ObjectLambdaContentTransformation `json:"contentTransformation"`
+}
+
+// ObjectLambdaContentTransformation is a container for AWS Lambda content
+// transformations.
+type ObjectLambdaContentTransformation struct {
+	// A container for an Lambda function.
+	AWSLambda AWSLambdaTransformation `json:"awsLambda"`
+}
+
+// AWSLambdaTransformation is the Lambda function used to transform objects
+// through an Object Lambda Access Point.
+type AWSLambdaTransformation struct {
+	// The Amazon Resource Name (ARN) of the Lambda function.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-aws/apis/lambda/v1beta1.Function
+	// +crossplane:generate:reference:extractor=github.com/crossplane-contrib/provider-aws/apis/lambda/v1beta1.FunctionARN()
+	FunctionARN *string `json:"functionARN,omitempty"`
+
+	// FunctionARNRef is a reference to a Function used to set the FunctionARN
+	// +optional
+	FunctionARNRef *xpv1.Reference `json:"functionARNRef,omitempty"`
+
+	// FunctionARNSelector selects a reference to a Function used to set the
+	// FunctionARN
+	// +optional
+	FunctionARNSelector *xpv1.Selector `json:"functionARNSelector,omitempty"`
+
+	// Additional JSON that provides supplemental data to the Lambda function
+	// used to transform objects.
+	// +optional
+	FunctionPayload *string `json:"functionPayload,omitempty"`
+}
+
+// ObjectLambdaAccessPointSpec defines the desired state of ObjectLambdaAccessPoint
+type ObjectLambdaAccessPointSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ObjectLambdaAccessPointParameters `json:"forProvider"`
+}
+
+// ObjectLambdaAccessPointObservation defines the observed state of ObjectLambdaAccessPoint
+type ObjectLambdaAccessPointObservation struct {
+	// The ARN of the Object Lambda Access Point.
+	ARN *string `json:"arn,omitempty"`
+
+	// The date and time when the Object Lambda Access Point was created.
+	CreationDate *metav1.Time `json:"creationDate,omitempty"`
+}
+
+// ObjectLambdaAccessPointStatus defines the observed state of ObjectLambdaAccessPoint.
+type ObjectLambdaAccessPointStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ObjectLambdaAccessPointObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ObjectLambdaAccessPoint is a managed resource that represents an Amazon S3
+// Object Lambda Access Point.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type ObjectLambdaAccessPoint struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              ObjectLambdaAccessPointSpec   `json:"spec"`
+	Status            ObjectLambdaAccessPointStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ObjectLambdaAccessPointList contains a list of ObjectLambdaAccessPoints
+type ObjectLambdaAccessPointList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ObjectLambdaAccessPoint `json:"items"`
+}
+
+// ObjectLambdaAccessPoint type metadata.
+var (
+	ObjectLambdaAccessPointKind             = "ObjectLambdaAccessPoint"
+	ObjectLambdaAccessPointGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: ObjectLambdaAccessPointKind}.String()
+	ObjectLambdaAccessPointKindAPIVersion   = ObjectLambdaAccessPointKind + "." + GroupVersion.String()
+	ObjectLambdaAccessPointGroupVersionKind = GroupVersion.WithKind(ObjectLambdaAccessPointKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ObjectLambdaAccessPoint{}, &ObjectLambdaAccessPointList{})
+}
@@ -27,6 +27,41 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSLambdaTransformation) DeepCopyInto(out *AWSLambdaTransformation) {
+	*out = *in
+	if in.FunctionARN != nil {
+		in, out := &in.FunctionARN, &out.FunctionARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.FunctionARNRef != nil {
+		in, out := &in.FunctionARNRef, &out.FunctionARNRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FunctionARNSelector != nil {
+		in, out := &in.FunctionARNSelector, &out.FunctionARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FunctionPayload != nil {
+		in, out := &in.FunctionPayload, &out.FunctionPayload
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSLambdaTransformation.
+func (in *AWSLambdaTransformation) DeepCopy() *AWSLambdaTransformation {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSLambdaTransformation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AccessPoint) DeepCopyInto(out *AccessPoint) {
 	*out = *in
@@ -326,6 +361,204 @@ func (in *JobManifestLocation) DeepCopy() *JobManifestLocation {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MultiRegionAccessPoint) DeepCopyInto(out *MultiRegionAccessPoint) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiRegionAccessPoint.
+func (in *MultiRegionAccessPoint) DeepCopy() *MultiRegionAccessPoint {
+	if in == nil {
+		return nil
+	}
+	out := new(MultiRegionAccessPoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MultiRegionAccessPoint) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MultiRegionAccessPointList) DeepCopyInto(out *MultiRegionAccessPointList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MultiRegionAccessPoint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiRegionAccessPointList.
+func (in *MultiRegionAccessPointList) DeepCopy() *MultiRegionAccessPointList {
+	if in == nil {
+		return nil
+	}
+	out := new(MultiRegionAccessPointList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MultiRegionAccessPointList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MultiRegionAccessPointObservation) DeepCopyInto(out *MultiRegionAccessPointObservation) {
+	*out = *in
+	if in.Alias != nil {
+		in, out := &in.Alias, &out.Alias
+		*out = new(string)
+		**out = **in
+	}
+	if in.ARN != nil {
+		in, out := &in.ARN, &out.ARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+	if in.Regions != nil {
+		in, out := &in.Regions, &out.Regions
+		*out = make([]MultiRegionAccessPointRegionObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RequestTokenARN != nil {
+		in, out := &in.RequestTokenARN, &out.RequestTokenARN
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiRegionAccessPointObservation.
+func (in *MultiRegionAccessPointObservation) DeepCopy() *MultiRegionAccessPointObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(MultiRegionAccessPointObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MultiRegionAccessPointParameters) DeepCopyInto(out *MultiRegionAccessPointParameters) {
+	*out = *in
+	if in.AccountID != nil {
+		in, out := &in.AccountID, &out.AccountID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Regions != nil {
+		in, out := &in.Regions, &out.Regions
+		*out = make([]MultiRegionAccessPointRegion, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PublicAccessBlockConfiguration != nil {
+		in, out := &in.PublicAccessBlockConfiguration, &out.PublicAccessBlockConfiguration
+		*out = new(PublicAccessBlockConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Policy != nil {
+		in, out := &in.Policy, &out.Policy
+		*out = new(common.BucketPolicyBody)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiRegionAccessPointParameters.
+func (in *MultiRegionAccessPointParameters) DeepCopy() *MultiRegionAccessPointParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(MultiRegionAccessPointParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MultiRegionAccessPointRegion) DeepCopyInto(out *MultiRegionAccessPointRegion) {
+	*out = *in
+	if in.Bucket != nil {
+		in, out := &in.Bucket, &out.Bucket
+		*out = new(string)
+		**out = **in
+	}
+	if in.BucketRef != nil {
+		in, out := &in.BucketRef, &out.BucketRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BucketSelector != nil {
+		in, out := &in.BucketSelector, &out.BucketSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BucketAccountID != nil {
+		in, out := &in.BucketAccountID, &out.BucketAccountID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiRegionAccessPointRegion.
+func (in *MultiRegionAccessPointRegion) DeepCopy() *MultiRegionAccessPointRegion {
+	if in == nil {
+		return nil
+	}
+	out := new(MultiRegionAccessPointRegion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MultiRegionAccessPointRegionObservation) DeepCopyInto(out *MultiRegionAccessPointRegionObservation) {
+	*out = *in
+	if in.Bucket != nil {
+		in, out := &in.Bucket, &out.Bucket
+		*out = new(string)
+		**out = **in
+	}
+	if in.Region != nil {
+		in, out := &in.Region, &out.Region
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiRegionAccessPointRegionObservation.
+func (in *MultiRegionAccessPointRegionObservation) DeepCopy() *MultiRegionAccessPointRegionObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(MultiRegionAccessPointRegionObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MultiRegionAccessPointReport) DeepCopyInto(out *MultiRegionAccessPointReport) {
 	*out = *in
@@ -366,6 +599,267 @@ func (in *MultiRegionAccessPointRoute) DeepCopy() *MultiRegionAccessPointRoute {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MultiRegionAccessPointSpec) DeepCopyInto(out *MultiRegionAccessPointSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiRegionAccessPointSpec.
+func (in *MultiRegionAccessPointSpec) DeepCopy() *MultiRegionAccessPointSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MultiRegionAccessPointSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MultiRegionAccessPointStatus) DeepCopyInto(out *MultiRegionAccessPointStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiRegionAccessPointStatus.
+func (in *MultiRegionAccessPointStatus) DeepCopy() *MultiRegionAccessPointStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MultiRegionAccessPointStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectLambdaAccessPoint) DeepCopyInto(out *ObjectLambdaAccessPoint) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectLambdaAccessPoint.
+func (in *ObjectLambdaAccessPoint) DeepCopy() *ObjectLambdaAccessPoint {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectLambdaAccessPoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ObjectLambdaAccessPoint) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectLambdaAccessPointList) DeepCopyInto(out *ObjectLambdaAccessPointList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ObjectLambdaAccessPoint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectLambdaAccessPointList.
+func (in *ObjectLambdaAccessPointList) DeepCopy() *ObjectLambdaAccessPointList {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectLambdaAccessPointList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ObjectLambdaAccessPointList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectLambdaAccessPointObservation) DeepCopyInto(out *ObjectLambdaAccessPointObservation) {
+	*out = *in
+	if in.ARN != nil {
+		in, out := &in.ARN, &out.ARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.CreationDate != nil {
+		in, out := &in.CreationDate, &out.CreationDate
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectLambdaAccessPointObservation.
+func (in *ObjectLambdaAccessPointObservation) DeepCopy() *ObjectLambdaAccessPointObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectLambdaAccessPointObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectLambdaAccessPointParameters) DeepCopyInto(out *ObjectLambdaAccessPointParameters) {
+	*out = *in
+	if in.AccountID != nil {
+		in, out := &in.AccountID, &out.AccountID
+		*out = new(string)
+		**out = **in
+	}
+	in.Configuration.DeepCopyInto(&out.Configuration)
+	if in.Policy != nil {
+		in, out := &in.Policy, &out.Policy
+		*out = new(common.BucketPolicyBody)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectLambdaAccessPointParameters.
+func (in *ObjectLambdaAccessPointParameters) DeepCopy() *ObjectLambdaAccessPointParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectLambdaAccessPointParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectLambdaAccessPointSpec) DeepCopyInto(out *ObjectLambdaAccessPointSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectLambdaAccessPointSpec.
+func (in *ObjectLambdaAccessPointSpec) DeepCopy() *ObjectLambdaAccessPointSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectLambdaAccessPointSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectLambdaAccessPointStatus) DeepCopyInto(out *ObjectLambdaAccessPointStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectLambdaAccessPointStatus.
+func (in *ObjectLambdaAccessPointStatus) DeepCopy() *ObjectLambdaAccessPointStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectLambdaAccessPointStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectLambdaConfiguration) DeepCopyInto(out *ObjectLambdaConfiguration) {
+	*out = *in
+	if in.AllowedFeatures != nil {
+		in, out := &in.AllowedFeatures, &out.AllowedFeatures
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CloudWatchMetricsEnabled != nil {
+		in, out := &in.CloudWatchMetricsEnabled, &out.CloudWatchMetricsEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SupportingAccessPoint != nil {
+		in, out := &in.SupportingAccessPoint, &out.SupportingAccessPoint
+		*out = new(string)
+		**out = **in
+	}
+	if in.SupportingAccessPointRef != nil {
+		in, out := &in.SupportingAccessPointRef, &out.SupportingAccessPointRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SupportingAccessPointSelector != nil {
+		in, out := &in.SupportingAccessPointSelector, &out.SupportingAccessPointSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TransformationConfigurations != nil {
+		in, out := &in.TransformationConfigurations, &out.TransformationConfigurations
+		*out = make([]ObjectLambdaTransformationConfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectLambdaConfiguration.
+func (in *ObjectLambdaConfiguration) DeepCopy() *ObjectLambdaConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectLambdaConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectLambdaContentTransformation) DeepCopyInto(out *ObjectLambdaContentTransformation) {
+	*out = *in
+	in.AWSLambda.DeepCopyInto(&out.AWSLambda)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectLambdaContentTransformation.
+func (in *ObjectLambdaContentTransformation) DeepCopy() *ObjectLambdaContentTransformation {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectLambdaContentTransformation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectLambdaTransformationConfiguration) DeepCopyInto(out *ObjectLambdaTransformationConfiguration) {
+	*out = *in
+	if in.Actions != nil {
+		in, out := &in.Actions, &out.Actions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.ContentTransformation.DeepCopyInto(&out.ContentTransformation)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectLambdaTransformationConfiguration.
+func (in *ObjectLambdaTransformationConfiguration) DeepCopy() *ObjectLambdaTransformationConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectLambdaTransformationConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PublicAccessBlockConfiguration) DeepCopyInto(out *PublicAccessBlockConfiguration) {
 	*out = *in
@@ -16,7 +16,11 @@ limitations under the License.
 
 package v1beta1
 
-import xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane-contrib/provider-aws/apis/common"
+)
 
 // CustomSecretParameters contains the additional fields for SecretParameters.
 type CustomSecretParameters struct {
@@ -77,6 +81,11 @@ type CustomSecretParameters struct {
 	// ResourcePolicy is a required field
 	// +optional
 	ResourcePolicy *string `json:"resourcePolicy,omitempty"`
+
+	// PolicyDocument is the structured alternative to resourcePolicy. Only
+	// one of resourcePolicy and policyDocument may be specified.
+	// +optional
+	PolicyDocument *common.ResourcePolicy `json:"policyDocument,omitempty"`
 }
 
 // A SecretReference is a reference to a secret in an arbitrary namespace.
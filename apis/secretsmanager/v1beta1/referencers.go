@@ -23,6 +23,7 @@ import (
 	"github.com/pkg/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	iamv1beta1 "github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
 	kms "github.com/crossplane-contrib/provider-aws/apis/kms/v1alpha1"
 )
 
@@ -44,5 +45,6 @@ func (mg *Secret) ResolveReferences(ctx context.Context, c client.Reader) error
 
 	mg.Spec.ForProvider.KMSKeyID = reference.ToPtrValue(rsp.ResolvedValue)
 	mg.Spec.ForProvider.KMSKeyIDRef = rsp.ResolvedReference
-	return nil
+
+	return iamv1beta1.ResolveResourcePolicyReferences(ctx, c, r, mg.Spec.ForProvider.PolicyDocument, "spec.forProvider.policyDocument")
 }
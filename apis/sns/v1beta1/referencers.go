@@ -23,6 +23,8 @@ import (
 	resource "github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/pkg/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	iamv1beta1 "github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
 )
 
 // SNSTopicARN returns a function that returns the ARN of the given SNS Topic.
@@ -57,3 +59,9 @@ func (mg *Subscription) ResolveReferences(ctx context.Context, c client.Reader)
 
 	return nil
 }
+
+// ResolveReferences for SNS Topic managed type
+func (mg *Topic) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+	return iamv1beta1.ResolveResourcePolicyReferences(ctx, c, r, mg.Spec.ForProvider.PolicyDocument, "spec.forProvider.policyDocument")
+}
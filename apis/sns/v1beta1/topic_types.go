@@ -19,6 +19,8 @@ package v1beta1
 import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-aws/apis/common"
 )
 
 // Tag represent a user-provided metadata that can be associated with a
@@ -70,6 +72,11 @@ type TopicParameters struct {
 	// +optional
 	Policy *string `json:"policy,omitempty"`
 
+	// PolicyDocument is the structured alternative to policy. Only one of
+	// policy and policyDocument may be specified.
+	// +optional
+	PolicyDocument *common.ResourcePolicy `json:"policyDocument,omitempty"`
+
 	// DeliveryRetryPolicy - the JSON serialization of the effective
 	// delivery policy, taking system defaults into account
 	// +optional
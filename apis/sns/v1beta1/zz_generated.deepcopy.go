@@ -22,6 +22,7 @@ limitations under the License.
 package v1beta1
 
 import (
+	"github.com/crossplane-contrib/provider-aws/apis/common"
 	"github.com/crossplane/crossplane-runtime/apis/common/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -331,6 +332,11 @@ func (in *TopicParameters) DeepCopyInto(out *TopicParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.PolicyDocument != nil {
+		in, out := &in.PolicyDocument, &out.PolicyDocument
+		*out = new(common.ResourcePolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.DeliveryPolicy != nil {
 		in, out := &in.DeliveryPolicy, &out.DeliveryPolicy
 		*out = new(string)
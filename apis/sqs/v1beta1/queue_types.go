@@ -19,6 +19,8 @@ package v1beta1
 import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-aws/apis/common"
 )
 
 // Enum values for Queue attribute names
@@ -48,8 +50,6 @@ const (
 type RedrivePolicy struct {
 	// The Amazon Resource Name (ARN) of the dead-letter queue to which Amazon
 	// SQS moves messages after the value of maxReceiveCount is exceeded.
-	// +crossplane:generate:reference:type=Queue
-	// +crossplane:generate:reference:extractor=QueueARN()
 	DeadLetterTargetARN *string `json:"deadLetterTargetArn,omitempty"`
 
 	// DeadLetterTargetARNRef reference a Queue to retrieve its ARN.
@@ -94,6 +94,11 @@ type QueueParameters struct {
 	// +optional
 	Policy *string `json:"policy,omitempty"`
 
+	// PolicyDocument is the structured alternative to policy. Only one of
+	// policy and policyDocument may be specified.
+	// +optional
+	PolicyDocument *common.ResourcePolicy `json:"policyDocument,omitempty"`
+
 	// ReceiveMessageWaitTimeSeconds - The length of time, in seconds, for
 	// which a ReceiveMessage action waits for a message to arrive. Valid values:
 	// an integer from 0 to 20 (seconds). Default: 0.
@@ -124,7 +129,6 @@ type QueueParameters struct {
 	// in the AWS Key Management Service API Reference.
 	// Applies only to server-side-encryption (https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-server-side-encryption.html):
 	// +optional
-	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-aws/apis/kms/v1alpha1.Key
 	KMSMasterKeyID *string `json:"kmsMasterKeyId,omitempty"`
 
 	KMSMasterKeyIDRef *xpv1.Reference `json:"kmsMasterKeyIdRef,omitempty"`
@@ -1,8 +1,15 @@
 package v1beta1
 
 import (
+	"context"
+
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	iamv1beta1 "github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
+	kms "github.com/crossplane-contrib/provider-aws/apis/kms/v1alpha1"
 )
 
 // QueueARN returns ARN of the Queue resource.
@@ -15,3 +22,40 @@ func QueueARN() reference.ExtractValueFn {
 		return cr.Status.AtProvider.ARN
 	}
 }
+
+// ResolveReferences of this Queue.
+func (mg *Queue) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.redrivePolicy.deadLetterTargetArn
+	if mg.Spec.ForProvider.RedrivePolicy != nil {
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.RedrivePolicy.DeadLetterTargetARN),
+			Reference:    mg.Spec.ForProvider.RedrivePolicy.DeadLetterTargetARNRef,
+			Selector:     mg.Spec.ForProvider.RedrivePolicy.DeadLetterTargetARNSelector,
+			To:           reference.To{Managed: &Queue{}, List: &QueueList{}},
+			Extract:      QueueARN(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.redrivePolicy.deadLetterTargetArn")
+		}
+		mg.Spec.ForProvider.RedrivePolicy.DeadLetterTargetARN = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.RedrivePolicy.DeadLetterTargetARNRef = rsp.ResolvedReference
+	}
+
+	// Resolve spec.forProvider.kmsMasterKeyId
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.KMSMasterKeyID),
+		Reference:    mg.Spec.ForProvider.KMSMasterKeyIDRef,
+		Selector:     mg.Spec.ForProvider.KMSMasterKeyIDSelector,
+		To:           reference.To{Managed: &kms.Key{}, List: &kms.KeyList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.kmsMasterKeyId")
+	}
+	mg.Spec.ForProvider.KMSMasterKeyID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.KMSMasterKeyIDRef = rsp.ResolvedReference
+
+	return iamv1beta1.ResolveResourcePolicyReferences(ctx, c, r, mg.Spec.ForProvider.PolicyDocument, "spec.forProvider.policyDocument")
+}
@@ -1,71 +0,0 @@
-/*
-Copyright 2021 The Crossplane Authors.
-
-Licensed under the Apache License, Version 2.0 (the "License");
-you may not use this file except in compliance with the License.
-You may obtain a copy of the License at
-
-    http://www.apache.org/licenses/LICENSE-2.0
-
-Unless required by applicable law or agreed to in writing, software
-distributed under the License is distributed on an "AS IS" BASIS,
-WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
-See the License for the specific language governing permissions and
-limitations under the License.
-*/
-
-// Code generated by angryjet. DO NOT EDIT.
-
-package v1beta1
-
-import (
-	"context"
-	v1alpha1 "github.com/crossplane-contrib/provider-aws/apis/kms/v1alpha1"
-	reference "github.com/crossplane/crossplane-runtime/pkg/reference"
-	errors "github.com/pkg/errors"
-	client "sigs.k8s.io/controller-runtime/pkg/client"
-)
-
-// ResolveReferences of this Queue.
-func (mg *Queue) ResolveReferences(ctx context.Context, c client.Reader) error {
-	r := reference.NewAPIResolver(c, mg)
-
-	var rsp reference.ResolutionResponse
-	var err error
-
-	if mg.Spec.ForProvider.RedrivePolicy != nil {
-		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
-			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.RedrivePolicy.DeadLetterTargetARN),
-			Extract:      QueueARN(),
-			Reference:    mg.Spec.ForProvider.RedrivePolicy.DeadLetterTargetARNRef,
-			Selector:     mg.Spec.ForProvider.RedrivePolicy.DeadLetterTargetARNSelector,
-			To: reference.To{
-				List:    &QueueList{},
-				Managed: &Queue{},
-			},
-		})
-		if err != nil {
-			return errors.Wrap(err, "mg.Spec.ForProvider.RedrivePolicy.DeadLetterTargetARN")
-		}
-		mg.Spec.ForProvider.RedrivePolicy.DeadLetterTargetARN = reference.ToPtrValue(rsp.ResolvedValue)
-		mg.Spec.ForProvider.RedrivePolicy.DeadLetterTargetARNRef = rsp.ResolvedReference
-
-	}
-	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
-		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.KMSMasterKeyID),
-		Extract:      reference.ExternalName(),
-		Reference:    mg.Spec.ForProvider.KMSMasterKeyIDRef,
-		Selector:     mg.Spec.ForProvider.KMSMasterKeyIDSelector,
-		To: reference.To{
-			List:    &v1alpha1.KeyList{},
-			Managed: &v1alpha1.Key{},
-		},
-	})
-	if err != nil {
-		return errors.Wrap(err, "mg.Spec.ForProvider.KMSMasterKeyID")
-	}
-	mg.Spec.ForProvider.KMSMasterKeyID = reference.ToPtrValue(rsp.ResolvedValue)
-	mg.Spec.ForProvider.KMSMasterKeyIDRef = rsp.ResolvedReference
-
-	return nil
-}
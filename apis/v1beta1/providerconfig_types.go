@@ -32,6 +32,14 @@ type ProviderConfigSpec struct {
 	// AssumeRoleWithWebIdentity defines the options for assuming an IAM role with a Web Identity
 	AssumeRoleWithWebIdentity *AssumeRoleWithWebIdentityOptions `json:"assumeRoleWithWebIdentity,omitempty"`
 
+	// AssumeRoleChain is an ordered list of IAM roles that are assumed once
+	// the credentials, including any role assumed through assumeRole or
+	// assumeRoleWithWebIdentity, are resolved. Each role is assumed with the
+	// credentials of the previous one, and the credentials of the last role
+	// are used to make requests to the AWS API.
+	// +optional
+	AssumeRoleChain []AssumeRoleChainLink `json:"assumeRoleChain,omitempty"`
+
 	// AssumeRoleARN to assume with provider credentials
 	// This setting will be deprecated. Use the roleARN field under assumeRole instead.
 	// +optional
@@ -46,14 +54,132 @@ type ProviderConfigSpec struct {
 	// of AWS calls made by the provider.
 	// +optional
 	Endpoint *EndpointConfig `json:"endpoint,omitempty"`
+
+	// DefaultRegion is the region used by managed resources that reference
+	// this ProviderConfig but do not specify a region themselves. Note that
+	// most kinds require their region to be set, so the default region only
+	// applies to the kinds whose region is optional: EC2 InternetGateway,
+	// SecurityGroup, Subnet and VPC, DBSubnetGroup, RDSInstance and
+	// ReplicationGroup.
+	// +optional
+	DefaultRegion *string `json:"defaultRegion,omitempty"`
+
+	// DefaultTags are added to the tags of the managed resources that
+	// reference this ProviderConfig. Tags set on the managed resource take
+	// precedence over the default tags with the same key. The applied default
+	// tags are recorded in the aws.crossplane.io/default-tags annotation, so
+	// that changing or removing a default tag updates the resources it was
+	// applied to. Default tags are supported by the EC2 Address, Instance,
+	// LaunchTemplate, Subnet, TransitGateway, TransitGatewayRouteTable,
+	// TransitGatewayVPCAttachment, VPC, VPCEndpoint,
+	// VPCEndpointServiceConfiguration and VPCPeeringConnection kinds, the RDS
+	// DBCluster, DBClusterParameterGroup, DBInstance, DBParameterGroup and
+	// OptionGroup kinds, and the EFS AccessPoint and FileSystem kinds.
+	// +optional
+	DefaultTags map[string]string `json:"defaultTags,omitempty"`
+
+	// Retry configures how requests to the AWS API that fail with retryable
+	// errors, such as throttling errors, are retried, and how fast requests
+	// are sent to individual services.
+	// +optional
+	Retry *RetryConfig `json:"retry,omitempty"`
 }
 
+// Retry modes.
+const (
+	// RetryModeStandard retries failed requests with an exponential backoff.
+	RetryModeStandard RetryMode = "Standard"
+
+	// RetryModeAdaptive additionally rate limits requests on the client side
+	// once throttling errors are returned by the AWS API.
+	RetryModeAdaptive RetryMode = "Adaptive"
+)
+
+// RetryMode is the strategy used to retry failed requests to the AWS API.
+type RetryMode string
+
+// RetryConfig configures how requests to the AWS API are retried.
+type RetryConfig struct {
+	// MaxAttempts is the maximum number of attempts that are made for a
+	// request, including the initial one. Defaults to 3.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxAttempts *int `json:"maxAttempts,omitempty"`
+
+	// MaxBackoff is the maximum duration that is waited between two attempts
+	// of a request. Defaults to 20s.
+	// +optional
+	MaxBackoff *metav1.Duration `json:"maxBackoff,omitempty"`
+
+	// Mode is the retry mode. Standard retries failed requests with an
+	// exponential backoff. Adaptive additionally rate limits requests on the
+	// client side once the AWS API starts throttling them. Defaults to
+	// Standard.
+	// Note that Adaptive is effective only for resources that use AWS SDK v2,
+	// resources that use AWS SDK v1 fall back to Standard.
+	// +optional
+	// +kubebuilder:validation:Enum=Standard;Adaptive
+	Mode *RetryMode `json:"mode,omitempty"`
+
+	// RateLimits limits the rate of requests that are sent to individual AWS
+	// services by all managed resources referencing this ProviderConfig.
+	// +optional
+	RateLimits []ServiceRateLimit `json:"rateLimits,omitempty"`
+}
+
+// ServiceRateLimit limits the rate of requests to an AWS service.
+type ServiceRateLimit struct {
+	// Service is the ID of the AWS service, e.g. EC2, IAM or Route 53.
+	Service string `json:"service"`
+
+	// RequestsPerSecond is the number of requests per second that may be sent
+	// to the service.
+	// +kubebuilder:validation:Minimum=1
+	RequestsPerSecond int `json:"requestsPerSecond"`
+
+	// Burst is the number of requests that may be sent to the service at once.
+	// Defaults to RequestsPerSecond.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Burst *int `json:"burst,omitempty"`
+}
+
+// Credentials sources supported in addition to the ones of crossplane-runtime.
+const (
+	// CredentialsSourceIMDS reads the credentials of the instance profile of
+	// the EC2 instance the provider runs on from the instance metadata
+	// service, using IMDSv2.
+	CredentialsSourceIMDS xpv1.CredentialsSource = "IMDS"
+
+	// CredentialsSourceContainer reads credentials from the container
+	// credentials endpoint of ECS tasks and of pods using EKS Pod Identity.
+	// The endpoint and its authorization token are read from the
+	// AWS_CONTAINER_CREDENTIALS_FULL_URI or
+	// AWS_CONTAINER_CREDENTIALS_RELATIVE_URI and
+	// AWS_CONTAINER_AUTHORIZATION_TOKEN_FILE or
+	// AWS_CONTAINER_AUTHORIZATION_TOKEN environment variables.
+	CredentialsSourceContainer xpv1.CredentialsSource = "Container"
+
+	// CredentialsSourceProfile resolves a profile of an AWS shared config or
+	// credentials file read from a Secret or the filesystem. In addition to
+	// static keys, the profile may use credential_process, SSO or assume a
+	// role with role_arn and source_profile or credential_source.
+	CredentialsSourceProfile xpv1.CredentialsSource = "Profile"
+)
+
 // ProviderCredentials required to authenticate.
 type ProviderCredentials struct {
 	// Source of the provider credentials.
-	// +kubebuilder:validation:Enum=None;Secret;InjectedIdentity;Environment;Filesystem
+	// +kubebuilder:validation:Enum=None;Secret;InjectedIdentity;Environment;Filesystem;IMDS;Container;Profile
 	Source xpv1.CredentialsSource `json:"source"`
 
+	// Profile is the name of the profile that is resolved if the source is
+	// Profile. The profile file is read from the Secret referenced by
+	// secretRef, or from the filesystem path of fs if no Secret is
+	// referenced. Defaults to default.
+	// +optional
+	Profile *string `json:"profile,omitempty"`
+
 	xpv1.CommonCredentialSelectors `json:",inline"`
 }
 
@@ -91,6 +217,73 @@ type AssumeRoleOptions struct {
 	// (https://docs.aws.amazon.com/IAM/latest/UserGuide/id_session-tags.html#id_session-tags_role-chaining).
 	// +optional
 	TransitiveTagKeys []string `json:"transitiveTagKeys,omitempty"`
+
+	// RoleSessionName is the session name of the assumed role, e.g. to
+	// attribute requests in CloudTrail. It is a Go template that is rendered
+	// with the .Kind and .Name of the managed resource the session is used
+	// for and the name of the .ProviderConfig, for example
+	// "crossplane-{{ .Kind }}-{{ .Name }}". Characters that are not allowed in
+	// session names are replaced with dashes and the name is truncated to 64
+	// characters. Note that managed resources only share sessions with the
+	// same name.
+	// +optional
+	RoleSessionName *string `json:"roleSessionName,omitempty"`
+
+	// SessionOptions of the assumed role.
+	SessionOptions `json:",inline"`
+}
+
+// SessionOptions configure the session of an assumed IAM role.
+type SessionOptions struct {
+	// Duration of the role session. Defaults to 15m.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+
+	// Policy is an IAM policy in JSON format that further restricts the
+	// permissions of the role session.
+	// +optional
+	Policy *string `json:"policy,omitempty"`
+
+	// PolicyARNs are the ARNs of IAM managed policies that further restrict
+	// the permissions of the role session.
+	// +optional
+	PolicyARNs []string `json:"policyARNs,omitempty"`
+}
+
+// AssumeRoleChainLink is an IAM role that is assumed as part of a role
+// chain.
+type AssumeRoleChainLink struct {
+	// RoleARN of the IAM role to assume.
+	RoleARN string `json:"roleARN"`
+
+	// ExternalID is the external ID used when assuming the role.
+	// +optional
+	ExternalID *string `json:"externalID,omitempty"`
+
+	// RoleSessionName is the session name, if you wish to uniquely identify
+	// this session. It is a Go template that is rendered like the
+	// roleSessionName of assumeRole.
+	// +optional
+	RoleSessionName *string `json:"roleSessionName,omitempty"`
+
+	// Tags is list of session tags that you want to pass. Each session tag consists of a key
+	// name and an associated value. For more information about session tags, see
+	// Tagging STS Sessions
+	// (https://docs.aws.amazon.com/IAM/latest/UserGuide/id_session-tags.html).
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+
+	// TransitiveTagKeys is a list of keys for session tags that you want to set as transitive. If you set a
+	// tag key as transitive, the corresponding key and value passes to subsequent
+	// sessions in a role chain. For more information, see Chaining Roles with Session Tags
+	// (https://docs.aws.amazon.com/IAM/latest/UserGuide/id_session-tags.html#id_session-tags_role-chaining).
+	// +optional
+	TransitiveTagKeys []string `json:"transitiveTagKeys,omitempty"`
+
+	// Duration of the role session. Note that AWS limits sessions of chained
+	// roles to one hour. Defaults to 15m.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
 }
 
 // AssumeRoleWithWebIdentityOptions define the options for assuming an IAM Role
@@ -100,14 +293,40 @@ type AssumeRoleWithWebIdentityOptions struct {
 	RoleARN *string `json:"roleARN,omitempty"`
 
 	// RoleSessionName is the session name, if you wish to uniquely identify this session.
+	// It is a Go template that is rendered like the roleSessionName of
+	// assumeRole.
 	// +optional
 	RoleSessionName string `json:"roleSessionName,omitempty"`
+
+	// SessionOptions of the assumed role.
+	SessionOptions `json:",inline"`
 }
 
 // EndpointConfig is used to configure the AWS client for a custom endpoint.
 type EndpointConfig struct {
-	// URL lets you configure the endpoint URL to be used in SDK calls.
-	URL URLConfig `json:"url"`
+	// URL lets you configure the endpoint URL to be used in SDK calls. The
+	// endpoints of the AWS SDK are used if it is not set.
+	// +optional
+	URL *URLConfig `json:"url,omitempty"`
+
+	// Services overrides the endpoints of individual AWS services, taking
+	// precedence over url. Keys are AWS service IDs, e.g. EC2, S3 or Route 53,
+	// and are matched case-insensitively and ignoring spaces. Resources that
+	// use AWS SDK v1 are matched by the endpoints ID of their service, which
+	// differs from the service ID for a few services, e.g. elasticfilesystem
+	// for EFS.
+	// +optional
+	Services map[string]ServiceEndpointConfig `json:"services,omitempty"`
+
+	// UseFIPSEndpoint makes the AWS SDK resolve FIPS endpoints for the
+	// services whose endpoint is not overridden.
+	// +optional
+	UseFIPSEndpoint *bool `json:"useFIPSEndpoint,omitempty"`
+
+	// UseDualStackEndpoint makes the AWS SDK resolve dual-stack endpoints
+	// for the services whose endpoint is not overridden.
+	// +optional
+	UseDualStackEndpoint *bool `json:"useDualStackEndpoint,omitempty"`
 
 	// Specifies if the endpoint's hostname can be modified by the SDK's API
 	// client.
@@ -168,6 +387,24 @@ type EndpointConfig struct {
 	Source *string `json:"source,omitempty"`
 }
 
+// ServiceEndpointConfig overrides the endpoint of an AWS service.
+type ServiceEndpointConfig struct {
+	// URL of the endpoint, e.g. the URL of a VPC endpoint of the service.
+	URL string `json:"url"`
+
+	// SigningRegion is the region requests to the endpoint are signed for.
+	// Defaults to the region of the managed resource, or to us-east-1 for
+	// services that do not have a region.
+	// +optional
+	SigningRegion *string `json:"signingRegion,omitempty"`
+
+	// HostnameImmutable prevents the AWS SDK from modifying the hostname of
+	// the URL, e.g. to prefix it with the name of an S3 bucket.
+	// Note that this is effective only for resources that use AWS SDK v2.
+	// +optional
+	HostnameImmutable *bool `json:"hostnameImmutable,omitempty"`
+}
+
 // URLConfig lets users configure the URL of the AWS SDK calls.
 type URLConfig struct {
 	// You can provide a static URL that will be used regardless of the service
@@ -206,12 +443,24 @@ type DynamicURLConfig struct {
 // A ProviderConfigStatus represents the status of a ProviderConfig.
 type ProviderConfigStatus struct {
 	xpv1.ProviderConfigStatus `json:",inline"`
+
+	// AccountID is the ID of the AWS account the credentials of this
+	// ProviderConfig were last validated against.
+	// +optional
+	AccountID string `json:"accountID,omitempty"`
+
+	// ARN of the identity the credentials of this ProviderConfig resolved to
+	// when they were last validated, e.g. the assumed role.
+	// +optional
+	ARN string `json:"arn,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 
 // A ProviderConfig configures how AWS controllers will connect to AWS API.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="ACCOUNT",type="string",JSONPath=".status.accountID",priority=1
 // +kubebuilder:printcolumn:name="SECRET-NAME",type="string",JSONPath=".spec.credentialsSecretRef.name",priority=1
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,provider,aws}
 // +kubebuilder:subresource:status
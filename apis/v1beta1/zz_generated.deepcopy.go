@@ -22,9 +22,52 @@ limitations under the License.
 package v1beta1
 
 import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AssumeRoleChainLink) DeepCopyInto(out *AssumeRoleChainLink) {
+	*out = *in
+	if in.ExternalID != nil {
+		in, out := &in.ExternalID, &out.ExternalID
+		*out = new(string)
+		**out = **in
+	}
+	if in.RoleSessionName != nil {
+		in, out := &in.RoleSessionName, &out.RoleSessionName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TransitiveTagKeys != nil {
+		in, out := &in.TransitiveTagKeys, &out.TransitiveTagKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AssumeRoleChainLink.
+func (in *AssumeRoleChainLink) DeepCopy() *AssumeRoleChainLink {
+	if in == nil {
+		return nil
+	}
+	out := new(AssumeRoleChainLink)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AssumeRoleOptions) DeepCopyInto(out *AssumeRoleOptions) {
 	*out = *in
@@ -50,6 +93,12 @@ func (in *AssumeRoleOptions) DeepCopyInto(out *AssumeRoleOptions) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.RoleSessionName != nil {
+		in, out := &in.RoleSessionName, &out.RoleSessionName
+		*out = new(string)
+		**out = **in
+	}
+	in.SessionOptions.DeepCopyInto(&out.SessionOptions)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AssumeRoleOptions.
@@ -70,6 +119,7 @@ func (in *AssumeRoleWithWebIdentityOptions) DeepCopyInto(out *AssumeRoleWithWebI
 		*out = new(string)
 		**out = **in
 	}
+	in.SessionOptions.DeepCopyInto(&out.SessionOptions)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AssumeRoleWithWebIdentityOptions.
@@ -100,7 +150,28 @@ func (in *DynamicURLConfig) DeepCopy() *DynamicURLConfig {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EndpointConfig) DeepCopyInto(out *EndpointConfig) {
 	*out = *in
-	in.URL.DeepCopyInto(&out.URL)
+	if in.URL != nil {
+		in, out := &in.URL, &out.URL
+		*out = new(URLConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Services != nil {
+		in, out := &in.Services, &out.Services
+		*out = make(map[string]ServiceEndpointConfig, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.UseFIPSEndpoint != nil {
+		in, out := &in.UseFIPSEndpoint, &out.UseFIPSEndpoint
+		*out = new(bool)
+		**out = **in
+	}
+	if in.UseDualStackEndpoint != nil {
+		in, out := &in.UseDualStackEndpoint, &out.UseDualStackEndpoint
+		*out = new(bool)
+		**out = **in
+	}
 	if in.HostnameImmutable != nil {
 		in, out := &in.HostnameImmutable, &out.HostnameImmutable
 		*out = new(bool)
@@ -216,6 +287,13 @@ func (in *ProviderConfigSpec) DeepCopyInto(out *ProviderConfigSpec) {
 		*out = new(AssumeRoleWithWebIdentityOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AssumeRoleChain != nil {
+		in, out := &in.AssumeRoleChain, &out.AssumeRoleChain
+		*out = make([]AssumeRoleChainLink, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.AssumeRoleARN != nil {
 		in, out := &in.AssumeRoleARN, &out.AssumeRoleARN
 		*out = new(string)
@@ -231,6 +309,23 @@ func (in *ProviderConfigSpec) DeepCopyInto(out *ProviderConfigSpec) {
 		*out = new(EndpointConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DefaultRegion != nil {
+		in, out := &in.DefaultRegion, &out.DefaultRegion
+		*out = new(string)
+		**out = **in
+	}
+	if in.DefaultTags != nil {
+		in, out := &in.DefaultTags, &out.DefaultTags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Retry != nil {
+		in, out := &in.Retry, &out.Retry
+		*out = new(RetryConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderConfigSpec.
@@ -320,6 +415,11 @@ func (in *ProviderConfigUsageList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProviderCredentials) DeepCopyInto(out *ProviderCredentials) {
 	*out = *in
+	if in.Profile != nil {
+		in, out := &in.Profile, &out.Profile
+		*out = new(string)
+		**out = **in
+	}
 	in.CommonCredentialSelectors.DeepCopyInto(&out.CommonCredentialSelectors)
 }
 
@@ -333,6 +433,118 @@ func (in *ProviderCredentials) DeepCopy() *ProviderCredentials {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryConfig) DeepCopyInto(out *RetryConfig) {
+	*out = *in
+	if in.MaxAttempts != nil {
+		in, out := &in.MaxAttempts, &out.MaxAttempts
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxBackoff != nil {
+		in, out := &in.MaxBackoff, &out.MaxBackoff
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(RetryMode)
+		**out = **in
+	}
+	if in.RateLimits != nil {
+		in, out := &in.RateLimits, &out.RateLimits
+		*out = make([]ServiceRateLimit, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryConfig.
+func (in *RetryConfig) DeepCopy() *RetryConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceEndpointConfig) DeepCopyInto(out *ServiceEndpointConfig) {
+	*out = *in
+	if in.SigningRegion != nil {
+		in, out := &in.SigningRegion, &out.SigningRegion
+		*out = new(string)
+		**out = **in
+	}
+	if in.HostnameImmutable != nil {
+		in, out := &in.HostnameImmutable, &out.HostnameImmutable
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceEndpointConfig.
+func (in *ServiceEndpointConfig) DeepCopy() *ServiceEndpointConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceEndpointConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceRateLimit) DeepCopyInto(out *ServiceRateLimit) {
+	*out = *in
+	if in.Burst != nil {
+		in, out := &in.Burst, &out.Burst
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceRateLimit.
+func (in *ServiceRateLimit) DeepCopy() *ServiceRateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SessionOptions) DeepCopyInto(out *SessionOptions) {
+	*out = *in
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Policy != nil {
+		in, out := &in.Policy, &out.Policy
+		*out = new(string)
+		**out = **in
+	}
+	if in.PolicyARNs != nil {
+		in, out := &in.PolicyARNs, &out.PolicyARNs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SessionOptions.
+func (in *SessionOptions) DeepCopy() *SessionOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(SessionOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Tag) DeepCopyInto(out *Tag) {
 	*out = *in
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
@@ -35,6 +36,7 @@ import (
 	requestv1 "github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/go-ini/ini"
@@ -54,6 +56,10 @@ const DefaultSection = "DEFAULT"
 // of region.
 const GlobalRegion = "aws-global"
 
+// callerIdentityRegion is the region credentials are validated in if their
+// ProviderConfig has no default region.
+const callerIdentityRegion = "us-east-1"
+
 // Endpoint URL configuration types.
 const (
 	URLConfigTypeStatic  = "Static"
@@ -84,9 +90,62 @@ var middlewareV2 = config.WithAPIOptions([]func(*middleware.Stack) error{
 // recordRequestMetrics records Prometheus metrics for requests to the AWS APIs
 var recordRequestMetrics = middleware.FinalizeMiddlewareFunc("recordRequestMetrics", func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
 	metrics.IncAWSAPICall(awsmiddleware.GetServiceID(ctx), awsmiddleware.GetOperationName(ctx), "2")
-	return next.HandleFinalize(ctx, in)
+	out, md, err := next.HandleFinalize(ctx, in)
+	if IsErrorThrottled(err) {
+		metrics.IncAWSAPIThrottle(awsmiddleware.GetServiceID(ctx), awsmiddleware.GetOperationName(ctx), "2")
+	}
+	return out, md, err
 })
 
+// recordRequestDuration returns an AWS SDK v2 API option that records the
+// duration and result of each attempt of requests made with the supplied
+// ProviderConfig.
+func recordRequestDuration(providerConfig string) func(*middleware.Stack) error {
+	return func(s *middleware.Stack) error {
+		return s.Finalize.Add(middleware.FinalizeMiddlewareFunc("recordRequestDuration", func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+			start := time.Now()
+			out, md, err := next.HandleFinalize(ctx, in)
+			r := metrics.AWSAPIRequest{
+				Service:        awsmiddleware.GetServiceID(ctx),
+				Operation:      awsmiddleware.GetOperationName(ctx),
+				APIVersion:     "2",
+				ProviderConfig: providerConfig,
+				Region:         awsmiddleware.GetRegion(ctx),
+				Duration:       time.Since(start),
+				ErrorCode:      ErrorCode(err),
+			}
+			if rsp, ok := awsmiddleware.GetRawResponse(md).(*smithyhttp.Response); ok {
+				r.HTTPStatus = rsp.StatusCode
+			}
+			metrics.ObserveAWSAPIRequest(r)
+			return out, md, err
+		}), middleware.After)
+	}
+}
+
+// recordRequestDurationV1 is the AWS SDK v1 equivalent of
+// recordRequestDuration.
+func recordRequestDurationV1(providerConfig string) requestv1.NamedHandler {
+	return requestv1.NamedHandler{
+		Name: "crossplane.RequestDurationHandler",
+		Fn: func(r *requestv1.Request) {
+			m := metrics.AWSAPIRequest{
+				Service:        r.ClientInfo.ServiceName,
+				Operation:      r.Operation.Name,
+				APIVersion:     "1",
+				ProviderConfig: providerConfig,
+				Region:         awsv1.StringValue(r.Config.Region),
+				Duration:       time.Since(r.AttemptTime),
+				ErrorCode:      ErrorCode(r.Error),
+			}
+			if r.HTTPResponse != nil {
+				m.HTTPStatus = r.HTTPResponse.StatusCode
+			}
+			metrics.ObserveAWSAPIRequest(m)
+		},
+	}
+}
+
 // userAgentV1 constructs the Crossplane user agent for AWS v1 clients
 var userAgentV1 = requestv1.NamedHandler{
 	Name: "crossplane.UserAgentHandler",
@@ -100,7 +159,7 @@ func GetConfig(ctx context.Context, c client.Client, mg resource.Managed, region
 }
 
 // UseProviderConfig to produce a config that can be used to authenticate to AWS.
-func UseProviderConfig(ctx context.Context, c client.Client, mg resource.Managed, region string) (*aws.Config, error) { //nolint:gocyclo
+func UseProviderConfig(ctx context.Context, c client.Client, mg resource.Managed, region string) (*aws.Config, error) {
 	pc := &v1beta1.ProviderConfig{}
 	if err := c.Get(ctx, types.NamespacedName{Name: mg.GetProviderConfigReference().Name}, pc); err != nil {
 		return nil, errors.Wrap(err, "cannot get referenced Provider")
@@ -110,46 +169,113 @@ func UseProviderConfig(ctx context.Context, c client.Client, mg resource.Managed
 	if err := t.Track(ctx, mg); err != nil {
 		return nil, errors.Wrap(err, "cannot track ProviderConfig usage")
 	}
+	cfg, err := configForProviderConfig(withRoleSessionNameData(ctx, mg, pc), c, pc, region)
+	if err != nil {
+		return nil, err
+	}
+	cfg.APIOptions = append(cfg.APIOptions, func(s *middleware.Stack) error {
+		return s.Initialize.Add(recordThrottled, middleware.Before)
+	})
+	return cfg, nil
+}
 
-	switch s := pc.Spec.Credentials.Source; s { //nolint:exhaustive
-	case xpv1.CredentialsSourceInjectedIdentity:
-		if pc.Spec.AssumeRole != nil || pc.Spec.AssumeRoleARN != nil {
-			cfg, err := UsePodServiceAccountAssumeRole(ctx, []byte{}, DefaultSection, region, pc)
-			if err != nil {
-				return nil, err
-			}
-			return SetResolver(pc, cfg), nil
-		}
-		if pc.Spec.AssumeRoleWithWebIdentity != nil && pc.Spec.AssumeRoleWithWebIdentity.RoleARN != nil {
-			cfg, err := UsePodServiceAccountAssumeRoleWithWebIdentity(ctx, []byte{}, DefaultSection, region, pc)
-			if err != nil {
-				return nil, err
-			}
-			return SetResolver(pc, cfg), nil
-		}
-		cfg, err := UsePodServiceAccount(ctx, []byte{}, DefaultSection, region)
+// configForProviderConfig produces a config that uses the credentials,
+// endpoint and retry settings of the supplied ProviderConfig.
+func configForProviderConfig(ctx context.Context, c client.Client, pc *v1beta1.ProviderConfig, region string) (*aws.Config, error) {
+	if region == "" {
+		region = StringValue(pc.Spec.DefaultRegion)
+	}
+	cfg, err := useCredentials(ctx, c, pc, region)
+	if err != nil {
+		return nil, err
+	}
+	cfg = SetRetryer(pc, SetResolver(pc, cfg))
+	cfg.APIOptions = append(cfg.APIOptions, recordRequestDuration(pc.GetName()))
+	return cfg, nil
+}
+
+// GetCallerIdentity returns the identity the credentials of the supplied
+// ProviderConfig resolve to. The config is produced the same way
+// UseProviderConfig produces it, so that credentials that cannot be used by
+// managed resources cannot be validated either. Requests are made to the
+// default region of the ProviderConfig, or to the global STS endpoint if it
+// has none.
+func GetCallerIdentity(ctx context.Context, c client.Client, pc *v1beta1.ProviderConfig) (*sts.GetCallerIdentityOutput, error) {
+	region := StringValue(pc.Spec.DefaultRegion)
+	if region == "" {
+		region = callerIdentityRegion
+	}
+	cfg, err := configForProviderConfig(withRoleSessionNameData(ctx, nil, pc), c, pc, region)
+	if err != nil {
+		return nil, err
+	}
+	return sts.NewFromConfig(*cfg).GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+}
+
+// useCredentials produces a config that uses the credentials of the supplied
+// ProviderConfig, including the roles of its role chain.
+func useCredentials(ctx context.Context, c client.Client, pc *v1beta1.ProviderConfig, region string) (*aws.Config, error) {
+	data, err := extractCredentials(ctx, c, pc)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get credentials")
+	}
+	ctx = withCredentialsCache(ctx, pc, region, data)
+	cfg, err := useSourceCredentials(ctx, pc, data, region)
+	if err != nil {
+		return nil, err
+	}
+	return useAssumeRoleChain(ctx, pc, cfg)
+}
+
+// useSourceCredentials produces a config that uses the credentials of the
+// supplied ProviderConfig, which are read from the supplied data unless they
+// are injected or resolved by a credentials provider.
+func useSourceCredentials(ctx context.Context, pc *v1beta1.ProviderConfig, data []byte, region string) (*aws.Config, error) {
+	if isProviderSource(pc.Spec.Credentials.Source) {
+		p, err := sourceCredentialsProvider(ctx, pc, data, region)
 		if err != nil {
 			return nil, err
 		}
-		return SetResolver(pc, cfg), nil
-	default:
-		data, err := resource.CommonCredentialExtractor(ctx, s, c, pc.Spec.Credentials.CommonCredentialSelectors)
-		if err != nil {
-			return nil, errors.Wrap(err, "cannot get credentials")
-		}
+		return useCredentialsProvider(ctx, p, region, pc)
+	}
+	if pc.Spec.Credentials.Source == xpv1.CredentialsSourceInjectedIdentity {
 		if pc.Spec.AssumeRole != nil || pc.Spec.AssumeRoleARN != nil {
-			cfg, err := UseProviderSecretAssumeRole(ctx, data, DefaultSection, region, pc)
-			if err != nil {
-				return nil, err
-			}
-			return SetResolver(pc, cfg), nil
+			return UsePodServiceAccountAssumeRole(ctx, []byte{}, DefaultSection, region, pc)
+		}
+		if pc.Spec.AssumeRoleWithWebIdentity != nil && pc.Spec.AssumeRoleWithWebIdentity.RoleARN != nil {
+			return UsePodServiceAccountAssumeRoleWithWebIdentity(ctx, []byte{}, DefaultSection, region, pc)
 		}
-		cfg, err := UseProviderSecret(ctx, data, DefaultSection, region)
+		return UsePodServiceAccount(ctx, []byte{}, DefaultSection, region)
+	}
+	if pc.Spec.AssumeRole != nil || pc.Spec.AssumeRoleARN != nil {
+		return UseProviderSecretAssumeRole(ctx, data, DefaultSection, region, pc)
+	}
+	return UseProviderSecret(ctx, data, DefaultSection, region)
+}
+
+// useAssumeRoleChain returns the supplied config with the credentials of the
+// last role of the role chain of the supplied ProviderConfig, if it has one.
+// Each role is assumed with the credentials of the previous one, starting
+// with the credentials of the supplied config.
+func useAssumeRoleChain(ctx context.Context, pc *v1beta1.ProviderConfig, cfg *aws.Config) (*aws.Config, error) {
+	for i := range pc.Spec.AssumeRoleChain {
+		link := pc.Spec.AssumeRoleChain[i]
+		sessionName, err := renderRoleSessionName(ctx, StringValue(link.RoleSessionName))
 		if err != nil {
 			return nil, err
 		}
-		return SetResolver(pc, cfg), nil
+		stsclient := sts.NewFromConfig(*cfg, stsRetryOptions(pc))
+		kind := sessionCredentialsKind(fmt.Sprintf("%s/%d", credentialsKindAssumeRoleChain, i), sessionName)
+		cfg.Credentials = cachedCredentials(ctx, kind, func() aws.CredentialsProvider {
+			return stscreds.NewAssumeRoleProvider(
+				stsclient,
+				link.RoleARN,
+				SetAssumeRoleChainLinkOptions(link),
+				withAssumeRoleSessionName(sessionName),
+			)
+		})
 	}
+	return cfg, nil
 }
 
 type awsEndpointResolverAdaptorWithOptions func(service, region string, options interface{}) (aws.Endpoint, error)
@@ -164,7 +290,21 @@ func SetResolver(pc *v1beta1.ProviderConfig, cfg *aws.Config) *aws.Config { //no
 	if pc.Spec.Endpoint == nil {
 		return cfg
 	}
+	cfg.ConfigSources = append([]interface{}{endpointStateSource{endpoint: pc.Spec.Endpoint}}, cfg.ConfigSources...)
 	cfg.EndpointResolverWithOptions = awsEndpointResolverAdaptorWithOptions(func(service, region string, options interface{}) (aws.Endpoint, error) {
+		if s, ok := serviceEndpoint(pc.Spec.Endpoint, service); ok {
+			return aws.Endpoint{
+				URL:               s.URL,
+				HostnameImmutable: BoolValue(s.HostnameImmutable),
+				SigningRegion:     serviceSigningRegion(s, region),
+				Source:            aws.EndpointSourceCustom,
+			}, nil
+		}
+		// Fall back to the endpoints of the AWS SDK, which honour the FIPS
+		// and dual-stack settings.
+		if pc.Spec.Endpoint.URL == nil {
+			return aws.Endpoint{}, &aws.EndpointNotFoundError{}
+		}
 		fullURL := ""
 		switch pc.Spec.Endpoint.URL.Type {
 		case URLConfigTypeStatic:
@@ -280,35 +420,48 @@ func UseProviderSecretAssumeRole(ctx context.Context, data []byte, profile, regi
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot parse credentials secret")
 	}
+	return useCredentialsProvider(ctx, credentials.StaticCredentialsProvider{Value: creds}, region, pc)
+}
 
+// useCredentialsProvider produces a config that uses the supplied
+// credentials, or the role they assume if the supplied ProviderConfig
+// configures one.
+func useCredentialsProvider(ctx context.Context, p aws.CredentialsProvider, region string, pc *v1beta1.ProviderConfig) (*aws.Config, error) {
 	config, err := config.LoadDefaultConfig(
 		ctx,
 		middlewareV2,
 		config.WithRegion(region),
-		config.WithCredentialsProvider(credentials.StaticCredentialsProvider{
-			Value: creds,
-		}),
+		config.WithCredentialsProvider(p),
 	)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to load default AWS config")
 	}
+	if pc.Spec.AssumeRole == nil && pc.Spec.AssumeRoleARN == nil {
+		return &config, nil
+	}
 
 	roleArn, err := GetAssumeRoleARN(pc.Spec.DeepCopy())
 	if err != nil {
 		return nil, err
 	}
 
-	stsSvc := sts.NewFromConfig(config)
+	stsSvc := sts.NewFromConfig(config, stsRetryOptions(pc))
 
+	sessionName, err := renderRoleSessionName(ctx, assumeRoleSessionName(pc))
+	if err != nil {
+		return nil, err
+	}
 	stsAssumeRoleOptions := SetAssumeRoleOptions(pc)
-	stsAssume := stscreds.NewAssumeRoleProvider(
-		stsSvc,
-		StringValue(roleArn),
-		stsAssumeRoleOptions,
-	)
-	config.Credentials = aws.NewCredentialsCache(stsAssume)
+	config.Credentials = cachedCredentials(ctx, sessionCredentialsKind(credentialsKindAssumeRole, sessionName), func() aws.CredentialsProvider {
+		return stscreds.NewAssumeRoleProvider(
+			stsSvc,
+			StringValue(roleArn),
+			stsAssumeRoleOptions,
+			withAssumeRoleSessionName(sessionName),
+		)
+	})
 
-	return &config, err
+	return &config, nil
 }
 
 // UsePodServiceAccountAssumeRole assumes an IAM role configured via a ServiceAccount
@@ -323,19 +476,24 @@ func UsePodServiceAccountAssumeRole(ctx context.Context, _ []byte, _, region str
 	if err != nil {
 		return nil, err
 	}
-	stsclient := sts.NewFromConfig(*cfg)
+	stsclient := sts.NewFromConfig(*cfg, stsRetryOptions(pc))
+	sessionName, err := renderRoleSessionName(ctx, assumeRoleSessionName(pc))
+	if err != nil {
+		return nil, err
+	}
 	stsAssumeRoleOptions := SetAssumeRoleOptions(pc)
 	cnf, err := config.LoadDefaultConfig(
 		ctx,
 		middlewareV2,
 		config.WithRegion(cfg.Region),
-		config.WithCredentialsProvider(aws.NewCredentialsCache(
-			stscreds.NewAssumeRoleProvider(
+		config.WithCredentialsProvider(cachedCredentials(ctx, sessionCredentialsKind(credentialsKindAssumeRole, sessionName), func() aws.CredentialsProvider {
+			return stscreds.NewAssumeRoleProvider(
 				stsclient,
 				StringValue(roleArn),
 				stsAssumeRoleOptions,
-			)),
-		),
+				withAssumeRoleSessionName(sessionName),
+			)
+		})),
 	)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to load assumed role AWS config")
@@ -357,21 +515,26 @@ func UsePodServiceAccountAssumeRoleWithWebIdentity(ctx context.Context, _ []byte
 		return nil, err
 	}
 
-	stsclient := sts.NewFromConfig(cfg)
+	stsclient := sts.NewFromConfig(cfg, stsRetryOptions(pc))
+	sessionName, err := renderRoleSessionName(ctx, pc.Spec.AssumeRoleWithWebIdentity.RoleSessionName)
+	if err != nil {
+		return nil, err
+	}
 	webIdentityRoleOptions := SetWebIdentityRoleOptions(pc)
 
 	cnf, err := config.LoadDefaultConfig(
 		ctx,
 		middlewareV2,
 		config.WithRegion(region),
-		config.WithCredentialsProvider(aws.NewCredentialsCache(
-			stscreds.NewWebIdentityRoleProvider(
+		config.WithCredentialsProvider(cachedCredentials(ctx, sessionCredentialsKind(credentialsKindWebIdentity, sessionName), func() aws.CredentialsProvider {
+			return stscreds.NewWebIdentityRoleProvider(
 				stsclient,
 				StringValue(roleArn),
 				stscreds.IdentityTokenFile(getWebidentityTokenFilePath()),
 				webIdentityRoleOptions,
-			)),
-		),
+				withWebIdentityRoleSessionName(sessionName),
+			)
+		})),
 	)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to load assumed role AWS config")
@@ -396,7 +559,11 @@ func UsePodServiceAccount(ctx context.Context, _ []byte, _, region string) (*aws
 			ctx,
 			middlewareV2,
 		)
-		return &cfg, errors.Wrap(err, "failed to load default AWS config")
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to load default AWS config")
+		}
+		cfg.Credentials = cachedInjectedIdentity(ctx, cfg.Credentials)
+		return &cfg, nil
 	}
 	cfg, err := config.LoadDefaultConfig(
 		ctx,
@@ -406,15 +573,25 @@ func UsePodServiceAccount(ctx context.Context, _ []byte, _, region string) (*aws
 	if err != nil {
 		return nil, errors.Wrap(err, fmt.Sprintf("failed to load default AWS config with region %s", region))
 	}
+	cfg.Credentials = cachedInjectedIdentity(ctx, cfg.Credentials)
 	return &cfg, err
 }
 
+// cachedInjectedIdentity shares the credentials resolved by the default
+// credentials chain among all managed resources using the same ProviderConfig.
+func cachedInjectedIdentity(ctx context.Context, p aws.CredentialsProvider) aws.CredentialsProvider {
+	if p == nil {
+		return nil
+	}
+	return cachedCredentials(ctx, credentialsKindInjectedIdentity, func() aws.CredentialsProvider { return p })
+}
+
 // NOTE(muvaf): ACK-generated controllers use aws/aws-sdk-go instead of
 // aws/aws-sdk-go-v2. These functions are implemented to be used by those controllers.
 
 // GetConfigV1 constructs an *awsv1.Config that can be used to authenticate to AWS
 // API by the AWSv1 clients.
-func GetConfigV1(ctx context.Context, c client.Client, mg resource.Managed, region string) (*session.Session, error) { //nolint:gocyclo
+func GetConfigV1(ctx context.Context, c client.Client, mg resource.Managed, region string) (*session.Session, error) {
 	if mg.GetProviderConfigReference() == nil {
 		return nil, errors.New("providerConfigRef cannot be empty")
 	}
@@ -427,46 +604,92 @@ func GetConfigV1(ctx context.Context, c client.Client, mg resource.Managed, regi
 	if err := t.Track(ctx, mg); err != nil {
 		return nil, errors.Wrap(err, "cannot track ProviderConfig usage")
 	}
+	if region == "" {
+		region = StringValue(pc.Spec.DefaultRegion)
+	}
+
+	cfg, err := useCredentialsV1(withRoleSessionNameData(ctx, mg, pc), c, pc, region)
+	if err != nil {
+		return nil, err
+	}
+	sess, err := GetSessionV1(cfg)
+	if err != nil {
+		return nil, err
+	}
+	sess = SetRetryerV1(pc, sess)
+	sess.Handlers.CompleteAttempt.PushBackNamed(recordRequestDurationV1(pc.GetName()))
+	sess.Handlers.Complete.PushBackNamed(recordThrottledV1)
+	return sess, nil
+}
+
+// useCredentialsV1 produces an AWS SDK v1 config that uses the credentials of
+// the supplied ProviderConfig.
+func useCredentialsV1(ctx context.Context, c client.Client, pc *v1beta1.ProviderConfig, region string) (*awsv1.Config, error) {
+	if len(pc.Spec.AssumeRoleChain) > 0 || isProviderSource(pc.Spec.Credentials.Source) {
+		return useCredentialsFromV2(ctx, c, pc, region)
+	}
 	switch s := pc.Spec.Credentials.Source; s { //nolint:exhaustive
 	case xpv1.CredentialsSourceInjectedIdentity:
+		ctx = withCredentialsCache(ctx, pc, region, nil)
 		if pc.Spec.AssumeRoleARN != nil || pc.Spec.AssumeRole != nil {
 			cfg, err := UsePodServiceAccountV1AssumeRole(ctx, []byte{}, pc, DefaultSection, region)
 			if err != nil {
 				return nil, errors.Wrap(err, "cannot use pod service account to assume role")
 			}
-			return GetSessionV1(cfg)
+			return cfg, nil
 		}
 		if pc.Spec.AssumeRoleWithWebIdentity != nil && pc.Spec.AssumeRoleWithWebIdentity.RoleARN != nil {
 			cfg, err := UsePodServiceAccountV1AssumeRoleWithWebIdentity(ctx, []byte{}, pc, DefaultSection, region)
 			if err != nil {
 				return nil, err
 			}
-			return GetSessionV1(cfg)
+			return cfg, nil
 		}
 		cfg, err := UsePodServiceAccountV1(ctx, []byte{}, pc, DefaultSection, region)
 		if err != nil {
 			return nil, errors.Wrap(err, "cannot use pod service account")
 		}
-		return GetSessionV1(cfg)
+		return cfg, nil
 	default:
 		data, err := resource.CommonCredentialExtractor(ctx, s, c, pc.Spec.Credentials.CommonCredentialSelectors)
 		if err != nil {
 			return nil, errors.Wrap(err, "cannot get credentials")
 		}
+		ctx = withCredentialsCache(ctx, pc, region, data)
 
 		if pc.Spec.AssumeRole != nil || pc.Spec.AssumeRoleARN != nil {
 			cfg, err := UseProviderSecretV1AssumeRole(ctx, data, pc, DefaultSection, region)
 			if err != nil {
 				return nil, errors.Wrap(err, "cannot use secret")
 			}
-			return GetSessionV1(cfg)
+			return cfg, nil
 		}
 		cfg, err := UseProviderSecretV1(ctx, data, pc, DefaultSection, region)
 		if err != nil {
 			return nil, errors.Wrap(err, "cannot use secret")
 		}
-		return GetSessionV1(cfg)
+		return cfg, nil
+	}
+}
+
+// useCredentialsFromV2 produces an AWS SDK v1 config that uses the
+// credentials of the supplied ProviderConfig resolved with AWS SDK v2. It is
+// used for role chains and for the sources resolved by credentials providers,
+// which are only implemented with AWS SDK v2.
+func useCredentialsFromV2(ctx context.Context, c client.Client, pc *v1beta1.ProviderConfig, region string) (*awsv1.Config, error) {
+	cfg, err := useCredentials(ctx, c, pc, region)
+	if err != nil {
+		return nil, err
 	}
+	v2creds, err := cfg.Credentials.Retrieve(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to retrieve credentials")
+	}
+	v1creds := credentialsv1.NewStaticCredentials(
+		v2creds.AccessKeyID,
+		v2creds.SecretAccessKey,
+		v2creds.SessionToken)
+	return SetResolverV1(pc, awsv1.NewConfig().WithCredentials(v1creds).WithRegion(region)), nil
 }
 
 // GetSessionV1 constructs an AWS V1 client session, with common configuration like the user agent handler
@@ -479,6 +702,11 @@ func GetSessionV1(cfg *awsv1.Config) (*session.Session, error) {
 	session.Handlers.Send.PushFront(func(r *requestv1.Request) {
 		metrics.IncAWSAPICall(r.ClientInfo.ServiceName, r.Operation.Name, "1")
 	})
+	session.Handlers.Retry.PushFront(func(r *requestv1.Request) {
+		if IsErrorThrottled(r.Error) {
+			metrics.IncAWSAPIThrottle(r.ClientInfo.ServiceName, r.Operation.Name, "1")
+		}
+	})
 	return session, nil
 }
 
@@ -507,14 +735,20 @@ func UseProviderSecretV1AssumeRole(ctx context.Context, data []byte, pc *v1beta1
 		return nil, errors.Wrap(err, "failed to assume IAM Role")
 	}
 
-	stsSvc := sts.NewFromConfig(config)
+	stsSvc := sts.NewFromConfig(config, stsRetryOptions(pc))
+	sessionName, err := renderRoleSessionName(ctx, assumeRoleSessionName(pc))
+	if err != nil {
+		return nil, err
+	}
 	stsAssumeRoleOptions := SetAssumeRoleOptions(pc)
-	stsAssume := stscreds.NewAssumeRoleProvider(
-		stsSvc,
-		StringValue(roleArn),
-		stsAssumeRoleOptions,
-	)
-	config.Credentials = aws.NewCredentialsCache(stsAssume)
+	config.Credentials = cachedCredentials(ctx, sessionCredentialsKind(credentialsKindAssumeRole, sessionName), func() aws.CredentialsProvider {
+		return stscreds.NewAssumeRoleProvider(
+			stsSvc,
+			StringValue(roleArn),
+			stsAssumeRoleOptions,
+			withAssumeRoleSessionName(sessionName),
+		)
+	})
 
 	v2creds, err := config.Credentials.Retrieve(ctx)
 	if err != nil {
@@ -574,7 +808,11 @@ func UsePodServiceAccountV1AssumeRole(ctx context.Context, _ []byte, pc *v1beta1
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to assume IAM Role")
 	}
-	stsclient := sts.NewFromConfig(cfg)
+	stsclient := sts.NewFromConfig(cfg, stsRetryOptions(pc))
+	sessionName, err := renderRoleSessionName(ctx, assumeRoleSessionName(pc))
+	if err != nil {
+		return nil, err
+	}
 	stsAssumeRoleOptions := SetAssumeRoleOptions(pc)
 	if region == GlobalRegion {
 		region = cfg.Region
@@ -583,13 +821,14 @@ func UsePodServiceAccountV1AssumeRole(ctx context.Context, _ []byte, pc *v1beta1
 		ctx,
 		middlewareV2,
 		config.WithRegion(region),
-		config.WithCredentialsProvider(aws.NewCredentialsCache(
-			stscreds.NewAssumeRoleProvider(
+		config.WithCredentialsProvider(cachedCredentials(ctx, sessionCredentialsKind(credentialsKindAssumeRole, sessionName), func() aws.CredentialsProvider {
+			return stscreds.NewAssumeRoleProvider(
 				stsclient,
 				StringValue(roleArn),
 				stsAssumeRoleOptions,
-			)),
-		),
+				withAssumeRoleSessionName(sessionName),
+			)
+		})),
 	)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to load assumed role AWS config")
@@ -619,21 +858,26 @@ func UsePodServiceAccountV1AssumeRoleWithWebIdentity(ctx context.Context, _ []by
 		return nil, errors.Wrap(err, "failed to get role arn for assume role with web identity")
 	}
 
-	stsclient := sts.NewFromConfig(cfg)
+	stsclient := sts.NewFromConfig(cfg, stsRetryOptions(pc))
+	sessionName, err := renderRoleSessionName(ctx, pc.Spec.AssumeRoleWithWebIdentity.RoleSessionName)
+	if err != nil {
+		return nil, err
+	}
 	webIdentityRoleOptions := SetWebIdentityRoleOptions(pc)
 
 	cnf, err := config.LoadDefaultConfig(
 		ctx,
 		middlewareV2,
 		config.WithRegion(region),
-		config.WithCredentialsProvider(aws.NewCredentialsCache(
-			stscreds.NewWebIdentityRoleProvider(
+		config.WithCredentialsProvider(cachedCredentials(ctx, sessionCredentialsKind(credentialsKindWebIdentity, sessionName), func() aws.CredentialsProvider {
+			return stscreds.NewWebIdentityRoleProvider(
 				stsclient,
 				StringValue(roleArn),
 				stscreds.IdentityTokenFile("/var/run/secrets/eks.amazonaws.com/serviceaccount/token"),
 				webIdentityRoleOptions,
-			)),
-		),
+				withWebIdentityRoleSessionName(sessionName),
+			)
+		})),
 	)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to load assumed role AWS config")
@@ -659,7 +903,7 @@ func UsePodServiceAccountV1(ctx context.Context, _ []byte, pc *v1beta1.ProviderC
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to load default AWS config")
 	}
-	v2creds, err := cfg.Credentials.Retrieve(ctx)
+	v2creds, err := cachedInjectedIdentity(ctx, cfg.Credentials).Retrieve(ctx)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to retrieve credentials")
 	}
@@ -675,11 +919,26 @@ func UsePodServiceAccountV1(ctx context.Context, _ []byte, pc *v1beta1.ProviderC
 
 // SetResolverV1 parses annotations from the managed resource
 // and returns a V1 configuration accordingly.
-func SetResolverV1(pc *v1beta1.ProviderConfig, cfg *awsv1.Config) *awsv1.Config {
+func SetResolverV1(pc *v1beta1.ProviderConfig, cfg *awsv1.Config) *awsv1.Config { //nolint:gocyclo
 	if pc.Spec.Endpoint == nil {
 		return cfg
 	}
+	if pc.Spec.Endpoint.UseFIPSEndpoint != nil {
+		cfg.UseFIPSEndpoint = fipsEndpointStateV1(*pc.Spec.Endpoint.UseFIPSEndpoint)
+	}
+	if pc.Spec.Endpoint.UseDualStackEndpoint != nil {
+		cfg.UseDualStackEndpoint = dualStackEndpointStateV1(*pc.Spec.Endpoint.UseDualStackEndpoint)
+	}
 	cfg.EndpointResolver = endpointsv1.ResolverFunc(func(service, region string, optFns ...func(*endpointsv1.Options)) (endpointsv1.ResolvedEndpoint, error) {
+		if s, ok := serviceEndpoint(pc.Spec.Endpoint, service); ok {
+			return endpointsv1.ResolvedEndpoint{
+				URL:           s.URL,
+				SigningRegion: serviceSigningRegion(s, region),
+			}, nil
+		}
+		if pc.Spec.Endpoint.URL == nil {
+			return endpointsv1.DefaultResolver().EndpointFor(service, region, optFns...)
+		}
 		fullURL := ""
 		switch pc.Spec.Endpoint.URL.Type {
 		case URLConfigTypeStatic:
@@ -767,6 +1026,8 @@ func SetAssumeRoleOptions(pc *v1beta1.ProviderConfig) func(*stscreds.AssumeRoleO
 			if pc.Spec.AssumeRole.TransitiveTagKeys != nil && len(pc.Spec.AssumeRole.TransitiveTagKeys) > 0 {
 				opt.TransitiveTagKeys = pc.Spec.AssumeRole.TransitiveTagKeys
 			}
+
+			setSessionOptions(pc.Spec.AssumeRole.SessionOptions, &opt.Duration, &opt.Policy, &opt.PolicyARNs)
 		}
 	}
 
@@ -778,6 +1039,21 @@ func SetAssumeRoleOptions(pc *v1beta1.ProviderConfig) func(*stscreds.AssumeRoleO
 	return func(opt *stscreds.AssumeRoleOptions) {}
 }
 
+// SetAssumeRoleChainLinkOptions sets options when assuming an IAM Role as
+// part of a role chain
+func SetAssumeRoleChainLinkOptions(link v1beta1.AssumeRoleChainLink) func(*stscreds.AssumeRoleOptions) {
+	return func(opt *stscreds.AssumeRoleOptions) {
+		opt.ExternalID = link.ExternalID
+		for _, t := range link.Tags {
+			opt.Tags = append(opt.Tags, stscredstypesv2.Tag{Key: t.Key, Value: t.Value})
+		}
+		opt.TransitiveTagKeys = link.TransitiveTagKeys
+		if link.Duration != nil {
+			opt.Duration = link.Duration.Duration
+		}
+	}
+}
+
 // SetWebIdentityRoleOptions sets options when exchanging a WebIdentity Token for a Role
 func SetWebIdentityRoleOptions(pc *v1beta1.ProviderConfig) func(*stscreds.WebIdentityRoleOptions) {
 	if pc.Spec.AssumeRoleWithWebIdentity != nil {
@@ -785,8 +1061,32 @@ func SetWebIdentityRoleOptions(pc *v1beta1.ProviderConfig) func(*stscreds.WebIde
 			if pc.Spec.AssumeRoleWithWebIdentity.RoleSessionName != "" {
 				opt.RoleSessionName = pc.Spec.AssumeRoleWithWebIdentity.RoleSessionName
 			}
+
+			setSessionOptions(pc.Spec.AssumeRoleWithWebIdentity.SessionOptions, &opt.Duration, &opt.Policy, &opt.PolicyARNs)
 		}
 	}
 
 	return func(opt *stscreds.WebIdentityRoleOptions) {}
 }
+
+// setSessionOptions sets the supplied role session options.
+func setSessionOptions(o v1beta1.SessionOptions, duration *time.Duration, policy **string, policyARNs *[]stscredstypesv2.PolicyDescriptorType) {
+	if o.Duration != nil {
+		*duration = o.Duration.Duration
+	}
+	if o.Policy != nil {
+		*policy = o.Policy
+	}
+	if len(o.PolicyARNs) > 0 {
+		*policyARNs = policyDescriptors(o.PolicyARNs)
+	}
+}
+
+// assumeRoleSessionName returns the role session name template of the role
+// assumed by the supplied ProviderConfig, if any.
+func assumeRoleSessionName(pc *v1beta1.ProviderConfig) string {
+	if pc.Spec.AssumeRole == nil {
+		return ""
+	}
+	return StringValue(pc.Spec.AssumeRole.RoleSessionName)
+}
@@ -21,10 +21,13 @@ import (
 	"fmt"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	stscreds "github.com/aws/aws-sdk-go-v2/credentials/stscreds"
 	stscredstypesv2 "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	endpointsv1 "github.com/aws/aws-sdk-go/aws/endpoints"
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
@@ -253,6 +256,9 @@ func TestSetAssumeroleOptions(t *testing.T) {
 	key1 := "key1"
 	value1 := "value1"
 
+	policy := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*"}]}`
+	policyARN := "arn:aws:iam::aws:policy/ReadOnlyAccess"
+
 	type args struct {
 		pc v1beta1.ProviderConfig
 	}
@@ -325,6 +331,28 @@ func TestSetAssumeroleOptions(t *testing.T) {
 				},
 			},
 		},
+		"SessionOptions": {
+			args: args{
+				pc: v1beta1.ProviderConfig{
+					Spec: v1beta1.ProviderConfigSpec{
+						AssumeRole: &v1beta1.AssumeRoleOptions{
+							SessionOptions: v1beta1.SessionOptions{
+								Duration:   &v1.Duration{Duration: time.Hour},
+								Policy:     &policy,
+								PolicyARNs: []string{policyARN},
+							},
+						},
+					},
+				},
+			},
+			want: want{
+				aro: stscreds.AssumeRoleOptions{
+					Duration:   time.Hour,
+					Policy:     &policy,
+					PolicyARNs: []stscredstypesv2.PolicyDescriptorType{{Arn: &policyARN}},
+				},
+			},
+		},
 		"ZeroLengthTags": {
 			args: args{
 				pc: v1beta1.ProviderConfig{
@@ -349,7 +377,7 @@ func TestSetAssumeroleOptions(t *testing.T) {
 			f := SetAssumeRoleOptions(&tc.args.pc)
 			f(&aro)
 
-			if diff := cmp.Diff(tc.want.aro, aro, cmpopts.IgnoreUnexported(stscredstypesv2.Tag{})); diff != "" {
+			if diff := cmp.Diff(tc.want.aro, aro, cmpopts.IgnoreUnexported(stscredstypesv2.Tag{}, stscredstypesv2.PolicyDescriptorType{})); diff != "" {
 				t.Errorf("Wrap: -want, +got:\n%s", diff)
 			}
 		})
@@ -358,6 +386,7 @@ func TestSetAssumeroleOptions(t *testing.T) {
 
 func TestSetWebIdentityRoleOptions(t *testing.T) {
 	sessionName := "test-id"
+	policyARN := "arn:aws:iam::aws:policy/ReadOnlyAccess"
 
 	type args struct {
 		pc v1beta1.ProviderConfig
@@ -395,6 +424,26 @@ func TestSetWebIdentityRoleOptions(t *testing.T) {
 				},
 			},
 		},
+		"SessionOptions": {
+			args: args{
+				pc: v1beta1.ProviderConfig{
+					Spec: v1beta1.ProviderConfigSpec{
+						AssumeRoleWithWebIdentity: &v1beta1.AssumeRoleWithWebIdentityOptions{
+							SessionOptions: v1beta1.SessionOptions{
+								Duration:   &v1.Duration{Duration: time.Hour},
+								PolicyARNs: []string{policyARN},
+							},
+						},
+					},
+				},
+			},
+			want: want{
+				aro: stscreds.WebIdentityRoleOptions{
+					Duration:   time.Hour,
+					PolicyARNs: []stscredstypesv2.PolicyDescriptorType{{Arn: &policyARN}},
+				},
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -404,13 +453,55 @@ func TestSetWebIdentityRoleOptions(t *testing.T) {
 			f := SetWebIdentityRoleOptions(&tc.args.pc)
 			f(&aro)
 
-			if diff := cmp.Diff(tc.want.aro, aro, cmpopts.IgnoreUnexported(stscredstypesv2.Tag{})); diff != "" {
+			if diff := cmp.Diff(tc.want.aro, aro, cmpopts.IgnoreUnexported(stscredstypesv2.Tag{}, stscredstypesv2.PolicyDescriptorType{})); diff != "" {
 				t.Errorf("Wrap: -want, +got:\n%s", diff)
 			}
 		})
 	}
 }
 
+func TestSetAssumeRoleChainLinkOptions(t *testing.T) {
+	externalID := "test-id"
+	key1 := "key1"
+	value1 := "value1"
+
+	cases := map[string]struct {
+		link v1beta1.AssumeRoleChainLink
+		want stscreds.AssumeRoleOptions
+	}{
+		"NoOptionsSet": {
+			link: v1beta1.AssumeRoleChainLink{RoleARN: "arn:aws:iam::123456789012:role/hub"},
+			want: stscreds.AssumeRoleOptions{},
+		},
+		"AllOptionsSet": {
+			link: v1beta1.AssumeRoleChainLink{
+				RoleARN:           "arn:aws:iam::123456789012:role/hub",
+				ExternalID:        &externalID,
+				Tags:              []v1beta1.Tag{{Key: &key1, Value: &value1}},
+				TransitiveTagKeys: []string{key1},
+				Duration:          &v1.Duration{Duration: time.Hour},
+			},
+			want: stscreds.AssumeRoleOptions{
+				ExternalID:        &externalID,
+				Tags:              []stscredstypesv2.Tag{{Key: &key1, Value: &value1}},
+				TransitiveTagKeys: []string{key1},
+				Duration:          time.Hour,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			aro := stscreds.AssumeRoleOptions{}
+			SetAssumeRoleChainLinkOptions(tc.link)(&aro)
+
+			if diff := cmp.Diff(tc.want, aro, cmpopts.IgnoreUnexported(stscredstypesv2.Tag{}, stscredstypesv2.PolicyDescriptorType{})); diff != "" {
+				t.Errorf("SetAssumeRoleChainLinkOptions(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestUseProviderConfigResolveEndpoint(t *testing.T) {
 	providerConfigReferenceName := "ProviderConfigReference"
 
@@ -441,7 +532,7 @@ func TestUseProviderConfigResolveEndpoint(t *testing.T) {
 			args: args{
 				region:  "aws-global",
 				service: "iam",
-				endpointConfig: &v1beta1.EndpointConfig{URL: v1beta1.URLConfig{
+				endpointConfig: &v1beta1.EndpointConfig{URL: &v1beta1.URLConfig{
 					Type: "Dynamic",
 					Dynamic: &v1beta1.DynamicURLConfig{
 						Protocol: "https",
@@ -460,7 +551,7 @@ func TestUseProviderConfigResolveEndpoint(t *testing.T) {
 				service: "iam",
 				endpointConfig: &v1beta1.EndpointConfig{
 					HostnameImmutable: aws.Bool(true),
-					URL: v1beta1.URLConfig{
+					URL: &v1beta1.URLConfig{
 						Type:   "Static",
 						Static: aws.String("http://localstack:4566"),
 					},
@@ -470,6 +561,39 @@ func TestUseProviderConfigResolveEndpoint(t *testing.T) {
 				url: "http://localstack:4566",
 			},
 		},
+		"ServiceEndpointConfig": {
+			args: args{
+				region:  "us-east-1",
+				service: "Route 53",
+				endpointConfig: &v1beta1.EndpointConfig{
+					URL: &v1beta1.URLConfig{
+						Type:   "Static",
+						Static: aws.String("http://localstack:4566"),
+					},
+					Services: map[string]v1beta1.ServiceEndpointConfig{
+						"route53": {URL: "https://vpce-route53.example.com"},
+					},
+				},
+			},
+			want: want{
+				url: "https://vpce-route53.example.com",
+			},
+		},
+		"NoServiceEndpointConfig": {
+			args: args{
+				region:  "us-east-1",
+				service: "EC2",
+				endpointConfig: &v1beta1.EndpointConfig{
+					UseFIPSEndpoint: aws.Bool(true),
+					Services: map[string]v1beta1.ServiceEndpointConfig{
+						"S3": {URL: "http://localstack:4566"},
+					},
+				},
+			},
+			want: want{
+				error: &aws.EndpointNotFoundError{},
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -522,6 +646,62 @@ func TestUseProviderConfigResolveEndpoint(t *testing.T) {
 	}
 }
 
+func TestSetResolverV1(t *testing.T) {
+	type want struct {
+		url           string
+		signingRegion string
+	}
+	cases := map[string]struct {
+		endpoint *v1beta1.EndpointConfig
+		service  string
+		region   string
+		want     want
+	}{
+		"ServiceEndpointConfig": {
+			endpoint: &v1beta1.EndpointConfig{
+				URL: &v1beta1.URLConfig{Type: "Static", Static: aws.String("http://localstack:4566")},
+				Services: map[string]v1beta1.ServiceEndpointConfig{
+					"IAM": {URL: "https://vpce-iam.example.com"},
+				},
+			},
+			service: "iam",
+			region:  GlobalRegion,
+			want:    want{url: "https://vpce-iam.example.com", signingRegion: "us-east-1"},
+		},
+		"ServiceSigningRegion": {
+			endpoint: &v1beta1.EndpointConfig{
+				Services: map[string]v1beta1.ServiceEndpointConfig{
+					"ec2": {URL: "https://vpce-ec2.example.com", SigningRegion: aws.String("eu-west-1")},
+				},
+			},
+			service: "ec2",
+			region:  "eu-central-1",
+			want:    want{url: "https://vpce-ec2.example.com", signingRegion: "eu-west-1"},
+		},
+		"DefaultFIPSEndpoint": {
+			endpoint: &v1beta1.EndpointConfig{UseFIPSEndpoint: aws.Bool(true)},
+			service:  "ec2",
+			region:   "us-east-1",
+			want:     want{url: "https://ec2-fips.us-east-1.amazonaws.com", signingRegion: "us-east-1"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			pc := &v1beta1.ProviderConfig{Spec: v1beta1.ProviderConfigSpec{Endpoint: tc.endpoint}}
+			cfg := SetResolverV1(pc, awsv1.NewConfig())
+			e, err := cfg.EndpointResolver.EndpointFor(tc.service, tc.region, func(o *endpointsv1.Options) {
+				o.UseFIPSEndpoint = cfg.UseFIPSEndpoint
+			})
+			if err != nil {
+				t.Fatalf("EndpointFor(...): %v", err)
+			}
+			if diff := cmp.Diff(tc.want, want{url: e.URL, signingRegion: e.SigningRegion}, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("EndpointFor(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestUsePodServiceAccount(t *testing.T) {
 	awsRegion := "eu-somewhere-1"
 	err := os.Setenv("AWS_REGION", awsRegion)
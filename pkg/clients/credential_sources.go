@@ -0,0 +1,330 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/credentials/ec2rolecreds"
+	"github.com/aws/aws-sdk-go-v2/credentials/endpointcreds"
+	"github.com/aws/aws-sdk-go-v2/credentials/processcreds"
+	"github.com/aws/aws-sdk-go-v2/credentials/ssocreds"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sso"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/go-ini/ini"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-aws/apis/v1beta1"
+)
+
+const (
+	errParseProfileFile                = "cannot parse profile file"
+	errGetProfileFmt                   = "cannot get profile %s"
+	errProfileCycleFmt                 = "profile %s is part of a source_profile cycle"
+	errNoProfileCredentialsFmt         = "profile %s has no credentials"
+	errUnknownCredentialSourceFmt      = "unknown credential_source %s in profile %s"
+	errNoContainerCredentialsEndpoint  = "neither AWS_CONTAINER_CREDENTIALS_FULL_URI nor AWS_CONTAINER_CREDENTIALS_RELATIVE_URI is set"
+	errReadContainerAuthorizationToken = "cannot read container authorization token"
+	errUnsupportedCredentialsSourceFmt = "credentials source %s is not a source of credentials providers"
+)
+
+// Kinds of credentials of the sources that are resolved to credentials
+// providers.
+const (
+	credentialsKindIMDS      = "IMDS"
+	credentialsKindContainer = "Container"
+	credentialsKindProfile   = "Profile"
+)
+
+// defaultProfile is the profile resolved by the Profile source if the
+// ProviderConfig names none.
+const defaultProfile = "default"
+
+// Environment variables configuring the container credentials endpoint.
+const (
+	envContainerCredentialsFullURI     = "AWS_CONTAINER_CREDENTIALS_FULL_URI"
+	envContainerCredentialsRelativeURI = "AWS_CONTAINER_CREDENTIALS_RELATIVE_URI"
+	envContainerAuthorizationToken     = "AWS_CONTAINER_AUTHORIZATION_TOKEN"
+	envContainerAuthorizationTokenFile = "AWS_CONTAINER_AUTHORIZATION_TOKEN_FILE"
+)
+
+// containerCredentialsHost is the host relative container credentials URIs
+// are resolved against.
+const containerCredentialsHost = "http://169.254.170.2"
+
+// Keys of the profiles of AWS shared config and credentials files.
+const (
+	profileKeyAccessKeyID       = "aws_access_key_id"
+	profileKeySecretAccessKey   = "aws_secret_access_key"
+	profileKeySessionToken      = "aws_session_token"
+	profileKeyCredentialProcess = "credential_process"
+	profileKeyRoleARN           = "role_arn"
+	profileKeySourceProfile     = "source_profile"
+	profileKeyCredentialSource  = "credential_source"
+	profileKeyExternalID        = "external_id"
+	profileKeyRoleSessionName   = "role_session_name"
+	profileKeyDurationSeconds   = "duration_seconds"
+	profileKeySSOStartURL       = "sso_start_url"
+	profileKeySSORegion         = "sso_region"
+	profileKeySSOAccountID      = "sso_account_id"
+	profileKeySSORoleName       = "sso_role_name"
+)
+
+// Values of the credential_source key of profiles.
+const (
+	credentialSourceEnvironment = "Environment"
+	credentialSourceIMDS        = "Ec2InstanceMetadata"
+	credentialSourceContainer   = "EcsContainer"
+)
+
+// isProviderSource returns true if the supplied credentials source is resolved
+// to a credentials provider rather than to static or injected credentials.
+func isProviderSource(s xpv1.CredentialsSource) bool {
+	switch s { //nolint:exhaustive
+	case v1beta1.CredentialsSourceIMDS, v1beta1.CredentialsSourceContainer, v1beta1.CredentialsSourceProfile:
+		return true
+	}
+	return false
+}
+
+// extractCredentials returns the credentials data of the supplied
+// ProviderConfig. Sources that are not read as data, like injected identities
+// or the instance metadata service, have none.
+func extractCredentials(ctx context.Context, c client.Client, pc *v1beta1.ProviderConfig) ([]byte, error) {
+	sel := pc.Spec.Credentials.CommonCredentialSelectors
+	switch s := pc.Spec.Credentials.Source; s { //nolint:exhaustive
+	case xpv1.CredentialsSourceInjectedIdentity, v1beta1.CredentialsSourceIMDS, v1beta1.CredentialsSourceContainer:
+		return nil, nil
+	case v1beta1.CredentialsSourceProfile:
+		if sel.SecretRef != nil {
+			return resource.CommonCredentialExtractor(ctx, xpv1.CredentialsSourceSecret, c, sel)
+		}
+		return resource.CommonCredentialExtractor(ctx, xpv1.CredentialsSourceFilesystem, c, sel)
+	default:
+		return resource.CommonCredentialExtractor(ctx, s, c, sel)
+	}
+}
+
+// sourceCredentialsProvider returns the cached credentials provider of the
+// IMDS, Container or Profile source of the supplied ProviderConfig. The
+// profile file of the Profile source is read from the supplied data.
+func sourceCredentialsProvider(ctx context.Context, pc *v1beta1.ProviderConfig, data []byte, region string) (aws.CredentialsProvider, error) {
+	var (
+		kind string
+		p    aws.CredentialsProvider
+		err  error
+	)
+	switch s := pc.Spec.Credentials.Source; s { //nolint:exhaustive
+	case v1beta1.CredentialsSourceIMDS:
+		kind, p = credentialsKindIMDS, ec2rolecreds.New()
+	case v1beta1.CredentialsSourceContainer:
+		kind = credentialsKindContainer
+		p, err = newContainerCredentialsProvider()
+	case v1beta1.CredentialsSourceProfile:
+		kind = credentialsKindProfile
+		p, err = ProfileCredentialsProvider(ctx, data, profileName(pc), region, pc)
+	default:
+		return nil, errors.Errorf(errUnsupportedCredentialsSourceFmt, s)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return cachedCredentials(ctx, kind, func() aws.CredentialsProvider { return p }), nil
+}
+
+// profileName returns the name of the profile resolved by the Profile source
+// of the supplied ProviderConfig.
+func profileName(pc *v1beta1.ProviderConfig) string {
+	if n := StringValue(pc.Spec.Credentials.Profile); n != "" {
+		return n
+	}
+	return defaultProfile
+}
+
+// ProfileCredentialsProvider returns a provider of the credentials of the
+// named profile of the supplied AWS shared config or credentials file. Static
+// keys, credential_process, SSO and roles assumed with role_arn and either
+// source_profile or credential_source are supported. Roles are assumed with
+// STS clients for the supplied region that use the retry settings of the
+// supplied ProviderConfig.
+func ProfileCredentialsProvider(ctx context.Context, data []byte, name, region string, pc *v1beta1.ProviderConfig) (aws.CredentialsProvider, error) {
+	f, err := ini.InsensitiveLoad(data)
+	if err != nil {
+		return nil, errors.Wrap(err, errParseProfileFile)
+	}
+	r := &profileResolver{file: f, region: region, pc: pc, visited: map[string]bool{}}
+	return r.resolve(ctx, name)
+}
+
+type profileResolver struct {
+	file    *ini.File
+	region  string
+	pc      *v1beta1.ProviderConfig
+	visited map[string]bool
+}
+
+func (r *profileResolver) resolve(ctx context.Context, name string) (aws.CredentialsProvider, error) {
+	if r.visited[name] {
+		return nil, errors.Errorf(errProfileCycleFmt, name)
+	}
+	r.visited[name] = true
+	p, err := r.profile(name)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case p.HasKey(profileKeyRoleARN):
+		src, err := r.roleSource(ctx, name, p)
+		if err != nil {
+			return nil, err
+		}
+		return r.assumeRole(ctx, p, src)
+	case p.HasKey(profileKeyCredentialProcess):
+		return processcreds.NewProvider(p.Key(profileKeyCredentialProcess).String()), nil
+	case p.HasKey(profileKeySSOStartURL):
+		return ssocreds.New(
+			sso.New(sso.Options{Region: p.Key(profileKeySSORegion).String()}),
+			p.Key(profileKeySSOAccountID).String(),
+			p.Key(profileKeySSORoleName).String(),
+			p.Key(profileKeySSOStartURL).String(),
+		), nil
+	case p.HasKey(profileKeyAccessKeyID):
+		return staticProfileCredentials(p), nil
+	}
+	return nil, errors.Errorf(errNoProfileCredentialsFmt, name)
+}
+
+// profile returns the named profile. Profiles are named after their section
+// in credentials files, and prefixed with "profile " in config files.
+func (r *profileResolver) profile(name string) (*ini.Section, error) {
+	if p, err := r.file.GetSection("profile " + name); err == nil {
+		return p, nil
+	}
+	p, err := r.file.GetSection(name)
+	return p, errors.Wrapf(err, errGetProfileFmt, name)
+}
+
+// roleSource returns the credentials the role of the supplied profile is
+// assumed with.
+func (r *profileResolver) roleSource(ctx context.Context, name string, p *ini.Section) (aws.CredentialsProvider, error) {
+	if src := p.Key(profileKeySourceProfile).String(); src != "" {
+		// A profile may be its own source, in which case its static keys
+		// are used to assume its role.
+		if src == name {
+			return staticProfileCredentials(p), nil
+		}
+		return r.resolve(ctx, src)
+	}
+	switch s := p.Key(profileKeyCredentialSource).String(); s {
+	case credentialSourceEnvironment:
+		return credentials.NewStaticCredentialsProvider(os.Getenv("AWS_ACCESS_KEY_ID"), os.Getenv("AWS_SECRET_ACCESS_KEY"), os.Getenv("AWS_SESSION_TOKEN")), nil
+	case credentialSourceIMDS:
+		return ec2rolecreds.New(), nil
+	case credentialSourceContainer:
+		return newContainerCredentialsProvider()
+	default:
+		return nil, errors.Errorf(errUnknownCredentialSourceFmt, s, name)
+	}
+}
+
+// assumeRole returns a provider of the credentials of the role of the
+// supplied profile, assumed with the supplied credentials.
+func (r *profileResolver) assumeRole(ctx context.Context, p *ini.Section, src aws.CredentialsProvider) (aws.CredentialsProvider, error) {
+	cfg, err := config.LoadDefaultConfig(
+		ctx,
+		middlewareV2,
+		config.WithRegion(r.region),
+		config.WithCredentialsProvider(aws.NewCredentialsCache(src)),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load default AWS config")
+	}
+	return stscreds.NewAssumeRoleProvider(
+		sts.NewFromConfig(cfg, stsRetryOptions(r.pc)),
+		p.Key(profileKeyRoleARN).String(),
+		func(o *stscreds.AssumeRoleOptions) {
+			if id := p.Key(profileKeyExternalID).String(); id != "" {
+				o.ExternalID = &id
+			}
+			if n := p.Key(profileKeyRoleSessionName).String(); n != "" {
+				o.RoleSessionName = n
+			}
+			if d, err := p.Key(profileKeyDurationSeconds).Int(); err == nil && d > 0 {
+				o.Duration = time.Duration(d) * time.Second
+			}
+		},
+	), nil
+}
+
+func staticProfileCredentials(p *ini.Section) aws.CredentialsProvider {
+	return credentials.NewStaticCredentialsProvider(
+		p.Key(profileKeyAccessKeyID).String(),
+		p.Key(profileKeySecretAccessKey).String(),
+		p.Key(profileKeySessionToken).String(),
+	)
+}
+
+// containerCredentialsProvider retrieves credentials from the container
+// credentials endpoint of ECS tasks and EKS Pod Identity. The authorization
+// token file is read on every retrieval because EKS Pod Identity rotates it.
+type containerCredentialsProvider struct {
+	endpoint  string
+	token     string
+	tokenFile string
+}
+
+func newContainerCredentialsProvider() (*containerCredentialsProvider, error) {
+	endpoint := os.Getenv(envContainerCredentialsFullURI)
+	if endpoint == "" {
+		if uri := os.Getenv(envContainerCredentialsRelativeURI); uri != "" {
+			endpoint = containerCredentialsHost + uri
+		}
+	}
+	if endpoint == "" {
+		return nil, errors.New(errNoContainerCredentialsEndpoint)
+	}
+	return &containerCredentialsProvider{
+		endpoint:  endpoint,
+		token:     os.Getenv(envContainerAuthorizationToken),
+		tokenFile: os.Getenv(envContainerAuthorizationTokenFile),
+	}, nil
+}
+
+// Retrieve the credentials from the container credentials endpoint.
+func (p *containerCredentialsProvider) Retrieve(ctx context.Context) (aws.Credentials, error) {
+	token := p.token
+	if p.tokenFile != "" {
+		b, err := os.ReadFile(p.tokenFile)
+		if err != nil {
+			return aws.Credentials{}, errors.Wrap(err, errReadContainerAuthorizationToken)
+		}
+		token = strings.TrimSpace(string(b))
+	}
+	return endpointcreds.New(p.endpoint, func(o *endpointcreds.Options) {
+		o.AuthorizationToken = token
+	}).Retrieve(ctx)
+}
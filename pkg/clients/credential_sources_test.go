@@ -0,0 +1,243 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/credentials/processcreds"
+	"github.com/aws/aws-sdk-go-v2/credentials/ssocreds"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane-contrib/provider-aws/apis/v1beta1"
+)
+
+func TestProfileCredentialsProvider(t *testing.T) {
+	file := `
+[default]
+aws_access_key_id = AKIDDEFAULT
+aws_secret_access_key = secret
+
+[profile process]
+credential_process = /bin/creds
+
+[profile sso]
+sso_start_url = https://example.awsapps.com/start
+sso_region = eu-west-1
+sso_account_id = 123456789012
+sso_role_name = Admin
+
+[profile role]
+role_arn = arn:aws:iam::123456789012:role/a
+source_profile = process
+
+[profile self]
+aws_access_key_id = AKIDSELF
+aws_secret_access_key = secret
+role_arn = arn:aws:iam::123456789012:role/b
+source_profile = self
+
+[profile instance]
+role_arn = arn:aws:iam::123456789012:role/c
+credential_source = Ec2InstanceMetadata
+
+[profile unknown]
+role_arn = arn:aws:iam::123456789012:role/d
+credential_source = Somewhere
+
+[profile cycle-a]
+role_arn = arn:aws:iam::123456789012:role/e
+source_profile = cycle-b
+
+[profile cycle-b]
+role_arn = arn:aws:iam::123456789012:role/f
+source_profile = cycle-a
+
+[profile empty]
+region = us-east-1
+`
+	type want struct {
+		provider string
+		err      bool
+	}
+	cases := map[string]struct {
+		name string
+		want want
+	}{
+		"Static": {
+			name: "default",
+			want: want{provider: fmt.Sprintf("%T", credentials.StaticCredentialsProvider{})},
+		},
+		"CredentialProcess": {
+			name: "process",
+			want: want{provider: fmt.Sprintf("%T", &processcreds.Provider{})},
+		},
+		"SSO": {
+			name: "sso",
+			want: want{provider: fmt.Sprintf("%T", &ssocreds.Provider{})},
+		},
+		"SourceProfile": {
+			name: "role",
+			want: want{provider: fmt.Sprintf("%T", &stscreds.AssumeRoleProvider{})},
+		},
+		"OwnSourceProfile": {
+			name: "self",
+			want: want{provider: fmt.Sprintf("%T", &stscreds.AssumeRoleProvider{})},
+		},
+		"CredentialSource": {
+			name: "instance",
+			want: want{provider: fmt.Sprintf("%T", &stscreds.AssumeRoleProvider{})},
+		},
+		"UnknownCredentialSource": {
+			name: "unknown",
+			want: want{err: true},
+		},
+		"Cycle": {
+			name: "cycle-a",
+			want: want{err: true},
+		},
+		"NoCredentials": {
+			name: "empty",
+			want: want{err: true},
+		},
+		"NotFound": {
+			name: "missing",
+			want: want{err: true},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			p, err := ProfileCredentialsProvider(context.Background(), []byte(file), tc.name, "us-east-1", &v1beta1.ProviderConfig{})
+			if diff := cmp.Diff(tc.want.err, err != nil); diff != "" {
+				t.Fatalf("ProfileCredentialsProvider(...): -want error, +got error: %v\n%s", err, diff)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tc.want.provider, fmt.Sprintf("%T", p)); diff != "" {
+				t.Errorf("ProfileCredentialsProvider(...): -want provider, +got provider:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestProfileCredentialsProviderStatic(t *testing.T) {
+	file := "[profile dev]\naws_access_key_id = AKID\naws_secret_access_key = secret\naws_session_token = token\n"
+	p, err := ProfileCredentialsProvider(context.Background(), []byte(file), "dev", "us-east-1", &v1beta1.ProviderConfig{})
+	if err != nil {
+		t.Fatalf("ProfileCredentialsProvider(...): %v", err)
+	}
+	got, err := p.Retrieve(context.Background())
+	if err != nil {
+		t.Fatalf("Retrieve(...): %v", err)
+	}
+	want := aws.Credentials{AccessKeyID: "AKID", SecretAccessKey: "secret", SessionToken: "token", Source: credentials.StaticCredentialsName}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Retrieve(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestSourceCredentialsProvider(t *testing.T) {
+	data := []byte("[profile dev]\naws_access_key_id = AKID\naws_secret_access_key = secret\n")
+	cases := map[string]struct {
+		creds v1beta1.ProviderCredentials
+		want  string
+		err   bool
+	}{
+		"Profile": {
+			creds: v1beta1.ProviderCredentials{Source: v1beta1.CredentialsSourceProfile, Profile: aws.String("dev")},
+			want:  "AKID",
+		},
+		"DefaultProfile": {
+			creds: v1beta1.ProviderCredentials{Source: v1beta1.CredentialsSourceProfile},
+			err:   true,
+		},
+		"NotAProviderSource": {
+			creds: v1beta1.ProviderCredentials{Source: xpv1.CredentialsSourceSecret},
+			err:   true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			pc := &v1beta1.ProviderConfig{Spec: v1beta1.ProviderConfigSpec{Credentials: tc.creds}}
+			p, err := sourceCredentialsProvider(context.Background(), pc, data, "us-east-1")
+			if diff := cmp.Diff(tc.err, err != nil); diff != "" {
+				t.Fatalf("sourceCredentialsProvider(...): -want error, +got error: %v\n%s", err, diff)
+			}
+			if err != nil {
+				return
+			}
+			creds, err := p.Retrieve(context.Background())
+			if err != nil {
+				t.Fatalf("Retrieve(...): %v", err)
+			}
+			if diff := cmp.Diff(tc.want, creds.AccessKeyID); diff != "" {
+				t.Errorf("Retrieve(...): -want access key ID, +got access key ID:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestContainerCredentialsProvider(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenFile, []byte("rotated\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	var gotToken string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotToken = r.Header.Get("Authorization")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"AccessKeyId":"AKID","SecretAccessKey":"secret","Token":"token"}`))
+	}))
+	defer srv.Close()
+
+	t.Setenv(envContainerCredentialsFullURI, srv.URL)
+	t.Setenv(envContainerAuthorizationToken, "static")
+	t.Setenv(envContainerAuthorizationTokenFile, tokenFile)
+	p, err := newContainerCredentialsProvider()
+	if err != nil {
+		t.Fatalf("newContainerCredentialsProvider(): %v", err)
+	}
+	creds, err := p.Retrieve(context.Background())
+	if err != nil {
+		t.Fatalf("Retrieve(...): %v", err)
+	}
+	if diff := cmp.Diff("rotated", gotToken); diff != "" {
+		t.Errorf("Retrieve(...): -want token, +got token:\n%s", diff)
+	}
+	if diff := cmp.Diff("AKID", creds.AccessKeyID); diff != "" {
+		t.Errorf("Retrieve(...): -want access key ID, +got access key ID:\n%s", diff)
+	}
+}
+
+func TestNewContainerCredentialsProviderNoEndpoint(t *testing.T) {
+	t.Setenv(envContainerCredentialsFullURI, "")
+	t.Setenv(envContainerCredentialsRelativeURI, "")
+	if _, err := newContainerCredentialsProvider(); err == nil {
+		t.Errorf("newContainerCredentialsProvider(): expected an error without an endpoint")
+	}
+}
@@ -0,0 +1,192 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+
+	"github.com/crossplane-contrib/provider-aws/apis/v1beta1"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/metrics"
+)
+
+// Kinds of credentials that are cached per ProviderConfig.
+const (
+	credentialsKindInjectedIdentity = "InjectedIdentity"
+	credentialsKindAssumeRole       = "AssumeRole"
+	credentialsKindWebIdentity      = "AssumeRoleWithWebIdentity"
+	credentialsKindAssumeRoleChain  = "AssumeRoleChain"
+)
+
+// credentialsCacheIdleTTL is how long cached credentials that are not used are
+// kept. Role sessions may be named after the managed resource they are used
+// for, in which case every managed resource has its own entry, so entries of
+// deleted managed resources must expire.
+const credentialsCacheIdleTTL = time.Hour
+
+// credentialsCache is shared by all controllers so that managed resources
+// referencing the same ProviderConfig reuse their credentials instead of
+// calling STS on every reconcile.
+var credentialsCache = NewCredentialsProviderCache()
+
+// A CredentialsProviderCache caches the credentials providers built for
+// ProviderConfigs. Entries are keyed by the ProviderConfig name, the region and
+// the kind of credentials, and are only served as long as the version of the
+// ProviderConfig and the credentials they were built from is unchanged. The
+// region is part of the key because the STS clients used by the cached
+// providers are bound to the region of the managed resource that built them.
+// Entries that were not used for longer than the idle TTL are evicted.
+// It is safe for concurrent use.
+type CredentialsProviderCache struct {
+	mu        sync.Mutex
+	entries   map[credentialsCacheKey]credentialsCacheEntry
+	idleTTL   time.Duration
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+type credentialsCacheKey struct {
+	providerConfig string
+	region         string
+	kind           string
+}
+
+type credentialsCacheEntry struct {
+	version  string
+	provider *aws.CredentialsCache
+	lastUsed time.Time
+}
+
+// NewCredentialsProviderCache returns an empty CredentialsProviderCache.
+func NewCredentialsProviderCache() *CredentialsProviderCache {
+	return &CredentialsProviderCache{
+		entries: map[credentialsCacheKey]credentialsCacheEntry{},
+		idleTTL: credentialsCacheIdleTTL,
+		now:     time.Now,
+	}
+}
+
+// GetOrCreate returns the cached credentials of the given kind for the
+// supplied ProviderConfig and region if they were built for the given version.
+// Otherwise a new aws.CredentialsCache is built around the provider returned
+// by fn and replaces any stale entry.
+func (c *CredentialsProviderCache) GetOrCreate(providerConfig, region, version, kind string, fn func() aws.CredentialsProvider) *aws.CredentialsCache {
+	k := credentialsCacheKey{providerConfig: providerConfig, region: region, kind: kind}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.now()
+	c.sweep(now)
+	if e, ok := c.entries[k]; ok && e.version == version {
+		metrics.IncCredentialsCacheHit(providerConfig)
+		e.lastUsed = now
+		c.entries[k] = e
+		return e.provider
+	}
+	metrics.IncCredentialsCacheMiss(providerConfig)
+	p := aws.NewCredentialsCache(fn())
+	c.entries[k] = credentialsCacheEntry{version: version, provider: p, lastUsed: now}
+	return p
+}
+
+// sweep evicts the entries that were not used for longer than the idle TTL.
+// The entries are walked at most once per idle TTL. The caller must hold the
+// lock.
+func (c *CredentialsProviderCache) sweep(now time.Time) {
+	if now.Sub(c.lastSweep) < c.idleTTL {
+		return
+	}
+	c.lastSweep = now
+	for k, e := range c.entries {
+		if now.Sub(e.lastUsed) > c.idleTTL {
+			delete(c.entries, k)
+		}
+	}
+}
+
+// Invalidate removes all cached credentials of the supplied ProviderConfig.
+func (c *CredentialsProviderCache) Invalidate(providerConfig string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for k := range c.entries {
+		if k.providerConfig == providerConfig {
+			delete(c.entries, k)
+		}
+	}
+}
+
+// InvalidateCredentials removes all cached credentials of the supplied
+// ProviderConfig from the shared cache.
+func InvalidateCredentials(providerConfig string) {
+	credentialsCache.Invalidate(providerConfig)
+}
+
+// ReleaseProviderConfig releases the cached credentials and the client-side
+// rate limiters held for the supplied ProviderConfig. It is called once a
+// ProviderConfig is deleted so that neither grows without bound.
+func ReleaseProviderConfig(providerConfig string) {
+	InvalidateCredentials(providerConfig)
+	rateLimiters.Evict(providerConfig)
+}
+
+type credentialsCacheRefKey struct{}
+
+type credentialsCacheRef struct {
+	providerConfig string
+	region         string
+	version        string
+}
+
+// withCredentialsCache returns a copy of the supplied context that causes the
+// credentials built for the supplied ProviderConfig and region to be served
+// from the shared cache. The supplied credentials data, if any, must be the
+// data the credentials are built from so that the cached entry is replaced
+// as soon as the data changes, e.g. when a secret or file is rotated.
+// ProviderConfigs without a UID, i.e. ones that were never persisted, are not
+// cached.
+func withCredentialsCache(ctx context.Context, pc *v1beta1.ProviderConfig, region string, data []byte) context.Context {
+	if pc.GetUID() == "" {
+		return ctx
+	}
+	digest := ""
+	if len(data) > 0 {
+		sum := sha256.Sum256(data)
+		digest = hex.EncodeToString(sum[:])
+	}
+	return context.WithValue(ctx, credentialsCacheRefKey{}, credentialsCacheRef{
+		providerConfig: pc.GetName(),
+		region:         region,
+		version:        fmt.Sprintf("%s/%d/%s", pc.GetUID(), pc.GetGeneration(), digest),
+	})
+}
+
+// cachedCredentials returns the credentials of the given kind from the shared
+// cache if the context carries a reference to it. Otherwise the provider
+// returned by fn is wrapped in a new aws.CredentialsCache.
+func cachedCredentials(ctx context.Context, kind string, fn func() aws.CredentialsProvider) *aws.CredentialsCache {
+	ref, ok := ctx.Value(credentialsCacheRefKey{}).(credentialsCacheRef)
+	if !ok {
+		return aws.NewCredentialsCache(fn())
+	}
+	return credentialsCache.GetOrCreate(ref.providerConfig, ref.region, ref.version, kind, fn)
+}
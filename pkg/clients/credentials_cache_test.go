@@ -0,0 +1,201 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/google/go-cmp/cmp"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-aws/apis/v1beta1"
+)
+
+func TestCredentialsProviderCacheGetOrCreate(t *testing.T) {
+	static := func(id string) func() aws.CredentialsProvider {
+		return func() aws.CredentialsProvider {
+			return credentials.NewStaticCredentialsProvider(id, "secret", "")
+		}
+	}
+
+	type call struct {
+		providerConfig string
+		region         string
+		version        string
+		kind           string
+		id             string
+	}
+
+	cases := map[string]struct {
+		calls []call
+		want  string
+	}{
+		"FirstCallCreates": {
+			calls: []call{
+				{providerConfig: "pc", version: "1", kind: credentialsKindAssumeRole, id: "first"},
+			},
+			want: "first",
+		},
+		"SameVersionIsReused": {
+			calls: []call{
+				{providerConfig: "pc", version: "1", kind: credentialsKindAssumeRole, id: "first"},
+				{providerConfig: "pc", version: "1", kind: credentialsKindAssumeRole, id: "second"},
+			},
+			want: "first",
+		},
+		"NewVersionInvalidates": {
+			calls: []call{
+				{providerConfig: "pc", version: "1", kind: credentialsKindAssumeRole, id: "first"},
+				{providerConfig: "pc", version: "2", kind: credentialsKindAssumeRole, id: "second"},
+			},
+			want: "second",
+		},
+		"KindsAreCachedSeparately": {
+			calls: []call{
+				{providerConfig: "pc", version: "1", kind: credentialsKindInjectedIdentity, id: "first"},
+				{providerConfig: "pc", version: "1", kind: credentialsKindAssumeRole, id: "second"},
+			},
+			want: "second",
+		},
+		"RegionsAreCachedSeparately": {
+			calls: []call{
+				{providerConfig: "pc", region: "us-east-1", version: "1", kind: credentialsKindAssumeRole, id: "first"},
+				{providerConfig: "pc", region: "eu-west-1", version: "1", kind: credentialsKindAssumeRole, id: "second"},
+			},
+			want: "second",
+		},
+		"ProviderConfigsAreCachedSeparately": {
+			calls: []call{
+				{providerConfig: "pc", version: "1", kind: credentialsKindAssumeRole, id: "first"},
+				{providerConfig: "other", version: "1", kind: credentialsKindAssumeRole, id: "second"},
+			},
+			want: "second",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := NewCredentialsProviderCache()
+			var p *aws.CredentialsCache
+			for _, cl := range tc.calls {
+				p = c.GetOrCreate(cl.providerConfig, cl.region, cl.version, cl.kind, static(cl.id))
+			}
+			creds, err := p.Retrieve(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, creds.AccessKeyID); diff != "" {
+				t.Errorf("GetOrCreate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCredentialsProviderCacheInvalidate(t *testing.T) {
+	c := NewCredentialsProviderCache()
+	first := c.GetOrCreate("pc", "us-east-1", "1", credentialsKindAssumeRole, func() aws.CredentialsProvider {
+		return credentials.NewStaticCredentialsProvider("first", "secret", "")
+	})
+	c.Invalidate("pc")
+	second := c.GetOrCreate("pc", "us-east-1", "1", credentialsKindAssumeRole, func() aws.CredentialsProvider {
+		return credentials.NewStaticCredentialsProvider("second", "secret", "")
+	})
+	if first == second {
+		t.Errorf("Invalidate(...): expected a new provider to be created")
+	}
+}
+
+func TestCredentialsProviderCacheEvictsIdleEntries(t *testing.T) {
+	now := time.Now()
+	c := NewCredentialsProviderCache()
+	c.now = func() time.Time { return now }
+
+	c.GetOrCreate("pc", "us-east-1", "1", credentialsKindAssumeRole+"/Bucket-deleted", func() aws.CredentialsProvider {
+		return credentials.NewStaticCredentialsProvider("deleted", "secret", "")
+	})
+	used := c.GetOrCreate("pc", "us-east-1", "1", credentialsKindAssumeRole+"/Bucket-used", func() aws.CredentialsProvider {
+		return credentials.NewStaticCredentialsProvider("used", "secret", "")
+	})
+
+	now = now.Add(credentialsCacheIdleTTL / 2)
+	c.GetOrCreate("pc", "us-east-1", "1", credentialsKindAssumeRole+"/Bucket-used", nil)
+
+	now = now.Add(credentialsCacheIdleTTL)
+	got := c.GetOrCreate("pc", "us-east-1", "1", credentialsKindAssumeRole+"/Bucket-used", nil)
+	if got != used {
+		t.Errorf("GetOrCreate(...): expected the recently used provider to be kept")
+	}
+	if diff := cmp.Diff(1, len(c.entries)); diff != "" {
+		t.Errorf("GetOrCreate(...): -want entries, +got entries:\n%s", diff)
+	}
+}
+
+func TestWithCredentialsCache(t *testing.T) {
+	pc := &v1beta1.ProviderConfig{ObjectMeta: v1.ObjectMeta{Name: "pc", UID: "uid", Generation: 3}}
+	type args struct {
+		pc     *v1beta1.ProviderConfig
+		region string
+		data   []byte
+	}
+
+	cases := map[string]struct {
+		args args
+		want *credentialsCacheRef
+	}{
+		"NoUID": {
+			args: args{
+				pc: &v1beta1.ProviderConfig{ObjectMeta: v1.ObjectMeta{Name: "pc"}},
+			},
+		},
+		"NoCredentialsData": {
+			args: args{
+				pc:     pc,
+				region: "us-east-1",
+			},
+			want: &credentialsCacheRef{providerConfig: "pc", region: "us-east-1", version: "uid/3/"},
+		},
+		"CredentialsData": {
+			args: args{
+				pc:     pc,
+				region: "us-east-1",
+				data:   []byte("credentials"),
+			},
+			want: &credentialsCacheRef{
+				providerConfig: "pc",
+				region:         "us-east-1",
+				version:        "uid/3/631aada47deaf488bb72eee0873a20472c8f43ff960f2188f66cc41eb3f35428",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx := withCredentialsCache(context.Background(), tc.args.pc, tc.args.region, tc.args.data)
+			var got *credentialsCacheRef
+			if ref, ok := ctx.Value(credentialsCacheRefKey{}).(credentialsCacheRef); ok {
+				got = &ref
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(credentialsCacheRef{})); diff != "" {
+				t.Errorf("withCredentialsCache(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -43,6 +43,11 @@ type InstanceClient interface {
 	DescribeInstanceAttribute(context.Context, *ec2.DescribeInstanceAttributeInput, ...func(*ec2.Options)) (*ec2.DescribeInstanceAttributeOutput, error)
 	ModifyInstanceAttribute(context.Context, *ec2.ModifyInstanceAttributeInput, ...func(*ec2.Options)) (*ec2.ModifyInstanceAttributeOutput, error)
 	CreateTags(context.Context, *ec2.CreateTagsInput, ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	StopInstances(context.Context, *ec2.StopInstancesInput, ...func(*ec2.Options)) (*ec2.StopInstancesOutput, error)
+	StartInstances(context.Context, *ec2.StartInstancesInput, ...func(*ec2.Options)) (*ec2.StartInstancesOutput, error)
+	ModifyInstanceMetadataOptions(context.Context, *ec2.ModifyInstanceMetadataOptionsInput, ...func(*ec2.Options)) (*ec2.ModifyInstanceMetadataOptionsOutput, error)
+	MonitorInstances(context.Context, *ec2.MonitorInstancesInput, ...func(*ec2.Options)) (*ec2.MonitorInstancesOutput, error)
+	UnmonitorInstances(context.Context, *ec2.UnmonitorInstancesInput, ...func(*ec2.Options)) (*ec2.UnmonitorInstancesOutput, error)
 }
 
 // NewInstanceClient returns a new client using AWS credentials as JSON encoded data.
@@ -79,9 +84,130 @@ func IsInstanceUpToDate(spec manualv1alpha1.InstanceParameters, instance types.I
 	if awsclients.StringValue(spec.UserData) != attributeValue(attributes.UserData) {
 		return false
 	}
+	// InstanceType
+	if spec.InstanceType != "" && spec.InstanceType != attributeValue(attributes.InstanceType) {
+		return false
+	}
+	// EBSOptimized
+	if spec.EBSOptimized != nil && *spec.EBSOptimized != attributeBoolValue(attributes.EbsOptimized) {
+		return false
+	}
+	if !IsInstanceMetadataOptionsUpToDate(spec.MetadataOptions, GenerateInstanceMetadataOptionsRequest(instance.MetadataOptions)) {
+		return false
+	}
+	if !IsInstanceMonitoringUpToDate(spec.Monitoring, GenerateMonitoring(instance.Monitoring)) {
+		return false
+	}
 	return manualv1alpha1.CompareGroupIDs(spec.SecurityGroupIDs, instance.SecurityGroups)
 }
 
+// IsInstanceMetadataOptionsUpToDate returns true if all metadata options that
+// are set in spec match the observed ones.
+func IsInstanceMetadataOptionsUpToDate(spec, observed *manualv1alpha1.InstanceMetadataOptionsRequest) bool {
+	if spec == nil {
+		return true
+	}
+	if observed == nil {
+		observed = &manualv1alpha1.InstanceMetadataOptionsRequest{}
+	}
+	if spec.HTTPEndpoint != "" && spec.HTTPEndpoint != observed.HTTPEndpoint {
+		return false
+	}
+	if spec.HTTPTokens != "" && spec.HTTPTokens != observed.HTTPTokens {
+		return false
+	}
+	return spec.HTTPPutResponseHopLimit == nil || *spec.HTTPPutResponseHopLimit == awsclients.Int32Value(observed.HTTPPutResponseHopLimit)
+}
+
+// IsInstanceMonitoringUpToDate returns true if detailed monitoring is enabled
+// or being enabled when spec asks for it and disabled or being disabled
+// otherwise.
+func IsInstanceMonitoringUpToDate(spec *manualv1alpha1.RunInstancesMonitoringEnabled, observed *manualv1alpha1.Monitoring) bool {
+	if spec == nil || spec.Enabled == nil {
+		return true
+	}
+	state := ""
+	if observed != nil {
+		state = observed.State
+	}
+	switch types.MonitoringState(state) {
+	case types.MonitoringStateEnabled, types.MonitoringStatePending:
+		return *spec.Enabled
+	default:
+		return !*spec.Enabled
+	}
+}
+
+// GenerateModifyInstanceMetadataOptionsInput returns the input to change the
+// metadata options of the given instance to the ones set in spec.
+func GenerateModifyInstanceMetadataOptionsInput(id string, spec *manualv1alpha1.InstanceMetadataOptionsRequest) *ec2.ModifyInstanceMetadataOptionsInput {
+	return &ec2.ModifyInstanceMetadataOptionsInput{
+		InstanceId:              aws.String(id),
+		HttpEndpoint:            types.InstanceMetadataEndpointState(spec.HTTPEndpoint),
+		HttpPutResponseHopLimit: spec.HTTPPutResponseHopLimit,
+		HttpTokens:              types.HttpTokensState(spec.HTTPTokens),
+	}
+}
+
+// InstanceAttributeModification is the change of a single Instance attribute.
+type InstanceAttributeModification struct {
+	Attribute types.InstanceAttributeName
+	Input     *ec2.ModifyInstanceAttributeInput
+}
+
+// GenerateStoppedInstanceModifications returns a modification for every
+// attribute that differs from spec and can only be modified while the
+// instance is stopped.
+func GenerateStoppedInstanceModifications(id string, spec manualv1alpha1.InstanceParameters, attributes ec2.DescribeInstanceAttributeOutput) []InstanceAttributeModification { //nolint:gocyclo
+	var mods []InstanceAttributeModification
+	if spec.InstanceType != "" && spec.InstanceType != attributeValue(attributes.InstanceType) {
+		mods = append(mods, InstanceAttributeModification{
+			Attribute: types.InstanceAttributeNameInstanceType,
+			Input: &ec2.ModifyInstanceAttributeInput{
+				InstanceId:   aws.String(id),
+				InstanceType: &types.AttributeValue{Value: aws.String(spec.InstanceType)},
+			},
+		})
+	}
+	if spec.EBSOptimized != nil && *spec.EBSOptimized != attributeBoolValue(attributes.EbsOptimized) {
+		mods = append(mods, InstanceAttributeModification{
+			Attribute: types.InstanceAttributeNameEbsOptimized,
+			Input: &ec2.ModifyInstanceAttributeInput{
+				InstanceId:   aws.String(id),
+				EbsOptimized: &types.AttributeBooleanValue{Value: spec.EBSOptimized},
+			},
+		})
+	}
+	if spec.KernelID != nil && *spec.KernelID != attributeValue(attributes.KernelId) {
+		mods = append(mods, InstanceAttributeModification{
+			Attribute: types.InstanceAttributeNameKernel,
+			Input: &ec2.ModifyInstanceAttributeInput{
+				InstanceId: aws.String(id),
+				Kernel:     &types.AttributeValue{Value: spec.KernelID},
+			},
+		})
+	}
+	if spec.RAMDiskID != nil && *spec.RAMDiskID != attributeValue(attributes.RamdiskId) {
+		mods = append(mods, InstanceAttributeModification{
+			Attribute: types.InstanceAttributeNameRamdisk,
+			Input: &ec2.ModifyInstanceAttributeInput{
+				InstanceId: aws.String(id),
+				Ramdisk:    &types.AttributeValue{Value: spec.RAMDiskID},
+			},
+		})
+	}
+	if spec.UserData != nil && *spec.UserData != attributeValue(attributes.UserData) {
+		mods = append(mods, InstanceAttributeModification{
+			Attribute: types.InstanceAttributeNameUserData,
+			Input: &ec2.ModifyInstanceAttributeInput{
+				InstanceId: aws.String(id),
+				UserData:   &types.BlobAttributeValue{Value: []byte(*spec.UserData)},
+			},
+		})
+	}
+	return mods
+}
+
 // GenerateInstanceObservation is used to produce manualv1alpha1.InstanceObservation from
 // a []ec2.Instance.
 func GenerateInstanceObservation(i types.Instance) manualv1alpha1.InstanceObservation {
@@ -158,6 +284,38 @@ func GenerateInstanceCondition(o manualv1alpha1.InstanceObservation) Condition {
 	}
 }
 
+// GenerateInstanceDesiredStateCondition returns the condition of an instance
+// whose power state is managed. The instance is Available once it reached the
+// desired state and Unavailable while it is in another state.
+func GenerateInstanceDesiredStateCondition(desired string, o manualv1alpha1.InstanceObservation) Condition {
+	switch o.State {
+	case string(types.InstanceStateNameShuttingDown):
+		return Deleting
+	case string(types.InstanceStateNameTerminated):
+		return Deleted
+	}
+	if IsInstanceInDesiredState(desired, o.State) {
+		return Available
+	}
+	if desired == manualv1alpha1.InstanceDesiredStateRunning && o.State == string(types.InstanceStateNamePending) {
+		return Creating
+	}
+	return Unavailable
+}
+
+// IsInstanceInDesiredState returns true if an instance in the given state is
+// in the desired power state.
+func IsInstanceInDesiredState(desired, state string) bool {
+	switch desired {
+	case manualv1alpha1.InstanceDesiredStateRunning:
+		return state == string(types.InstanceStateNameRunning)
+	case manualv1alpha1.InstanceDesiredStateStopped, manualv1alpha1.InstanceDesiredStateHibernated:
+		return state == string(types.InstanceStateNameStopped)
+	default:
+		return true
+	}
+}
+
 // Condition denotes the current state across instances
 type Condition string
 
@@ -173,6 +331,9 @@ const (
 	// Deleted is the condition that represents all instances have entered
 	// the terminated state
 	Deleted Condition = "deleted"
+	// Unavailable is the condition that represents an instance that is not
+	// in its desired power state
+	Unavailable Condition = "unavailable"
 )
 
 // LateInitializeInstance fills the empty fields in *manualv1alpha1.InstanceParameters with
@@ -147,6 +147,70 @@ func TestGenerateInstanceConditions(t *testing.T) {
 	}
 }
 
+func TestGenerateInstanceDesiredStateCondition(t *testing.T) {
+	type args struct {
+		desired  string
+		observed manualv1alpha1.InstanceObservation
+	}
+	cases := map[string]struct {
+		args args
+		want Condition
+	}{
+		"RunningIsRunning": {
+			args: args{
+				desired:  manualv1alpha1.InstanceDesiredStateRunning,
+				observed: manualv1alpha1.InstanceObservation{State: string(types.InstanceStateNameRunning)},
+			},
+			want: Available,
+		},
+		"RunningIsPending": {
+			args: args{
+				desired:  manualv1alpha1.InstanceDesiredStateRunning,
+				observed: manualv1alpha1.InstanceObservation{State: string(types.InstanceStateNamePending)},
+			},
+			want: Creating,
+		},
+		"RunningIsStopped": {
+			args: args{
+				desired:  manualv1alpha1.InstanceDesiredStateRunning,
+				observed: manualv1alpha1.InstanceObservation{State: string(types.InstanceStateNameStopped)},
+			},
+			want: Unavailable,
+		},
+		"StoppedIsStopping": {
+			args: args{
+				desired:  manualv1alpha1.InstanceDesiredStateStopped,
+				observed: manualv1alpha1.InstanceObservation{State: string(types.InstanceStateNameStopping)},
+			},
+			want: Unavailable,
+		},
+		"HibernatedIsStopped": {
+			args: args{
+				desired:  manualv1alpha1.InstanceDesiredStateHibernated,
+				observed: manualv1alpha1.InstanceObservation{State: string(types.InstanceStateNameStopped)},
+			},
+			want: Available,
+		},
+		"StoppedIsTerminated": {
+			args: args{
+				desired:  manualv1alpha1.InstanceDesiredStateStopped,
+				observed: manualv1alpha1.InstanceObservation{State: string(types.InstanceStateNameTerminated)},
+			},
+			want: Deleted,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			condition := GenerateInstanceDesiredStateCondition(tc.args.desired, tc.args.observed)
+
+			if diff := cmp.Diff(tc.want, condition); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestGenerateDescribeInstancesByExternalTags(t *testing.T) {
 	type args struct {
 		extTags map[string]string
@@ -877,3 +941,130 @@ func TestGenerateEC2RunInstancesInput(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateStoppedInstanceModifications(t *testing.T) {
+	type args struct {
+		spec       manualv1alpha1.InstanceParameters
+		attributes ec2.DescribeInstanceAttributeOutput
+	}
+	cases := map[string]struct {
+		args args
+		want []InstanceAttributeModification
+	}{
+		"UpToDate": {
+			args: args{
+				spec: manualv1alpha1.InstanceParameters{
+					InstanceType: string(types.InstanceTypeM5Large),
+					EBSOptimized: aws.Bool(true),
+				},
+				attributes: ec2.DescribeInstanceAttributeOutput{
+					InstanceType: &types.AttributeValue{Value: aws.String(string(types.InstanceTypeM5Large))},
+					EbsOptimized: &types.AttributeBooleanValue{Value: aws.Bool(true)},
+				},
+			},
+		},
+		"UnsetFieldsAreIgnored": {
+			args: args{
+				attributes: ec2.DescribeInstanceAttributeOutput{
+					InstanceType: &types.AttributeValue{Value: aws.String(string(types.InstanceTypeM5Large))},
+					KernelId:     &types.AttributeValue{Value: aws.String("aki-1")},
+				},
+			},
+		},
+		"Changed": {
+			args: args{
+				spec: manualv1alpha1.InstanceParameters{
+					InstanceType: string(types.InstanceTypeM5Xlarge),
+					EBSOptimized: aws.Bool(true),
+					KernelID:     aws.String("aki-2"),
+				},
+				attributes: ec2.DescribeInstanceAttributeOutput{
+					InstanceType: &types.AttributeValue{Value: aws.String(string(types.InstanceTypeM5Large))},
+					KernelId:     &types.AttributeValue{Value: aws.String("aki-1")},
+				},
+			},
+			want: []InstanceAttributeModification{
+				{
+					Attribute: types.InstanceAttributeNameInstanceType,
+					Input: &ec2.ModifyInstanceAttributeInput{
+						InstanceId:   aws.String(instanceID),
+						InstanceType: &types.AttributeValue{Value: aws.String(string(types.InstanceTypeM5Xlarge))},
+					},
+				},
+				{
+					Attribute: types.InstanceAttributeNameEbsOptimized,
+					Input: &ec2.ModifyInstanceAttributeInput{
+						InstanceId:   aws.String(instanceID),
+						EbsOptimized: &types.AttributeBooleanValue{Value: aws.Bool(true)},
+					},
+				},
+				{
+					Attribute: types.InstanceAttributeNameKernel,
+					Input: &ec2.ModifyInstanceAttributeInput{
+						InstanceId: aws.String(instanceID),
+						Kernel:     &types.AttributeValue{Value: aws.String("aki-2")},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateStoppedInstanceModifications(instanceID, tc.args.spec, tc.args.attributes)
+
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreTypes(document.NoSerde{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsInstanceMonitoringUpToDate(t *testing.T) {
+	type args struct {
+		spec     *manualv1alpha1.RunInstancesMonitoringEnabled
+		observed *manualv1alpha1.Monitoring
+	}
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"NotSet": {
+			args: args{
+				observed: &manualv1alpha1.Monitoring{State: string(types.MonitoringStateEnabled)},
+			},
+			want: true,
+		},
+		"EnablePending": {
+			args: args{
+				spec:     &manualv1alpha1.RunInstancesMonitoringEnabled{Enabled: aws.Bool(true)},
+				observed: &manualv1alpha1.Monitoring{State: string(types.MonitoringStatePending)},
+			},
+			want: true,
+		},
+		"EnableDisabled": {
+			args: args{
+				spec:     &manualv1alpha1.RunInstancesMonitoringEnabled{Enabled: aws.Bool(true)},
+				observed: &manualv1alpha1.Monitoring{State: string(types.MonitoringStateDisabled)},
+			},
+			want: false,
+		},
+		"DisableDisabling": {
+			args: args{
+				spec:     &manualv1alpha1.RunInstancesMonitoringEnabled{Enabled: aws.Bool(false)},
+				observed: &manualv1alpha1.Monitoring{State: string(types.MonitoringStateDisabling)},
+			},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsInstanceMonitoringUpToDate(tc.args.spec, tc.args.observed)
+
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+	awsclients "github.com/crossplane-contrib/provider-aws/pkg/clients"
+)
+
+const (
+	// KeyPairNotFound is the code that is returned by ec2 when the given key pair does not exist
+	KeyPairNotFound = "InvalidKeyPair.NotFound"
+)
+
+// KeyPairClient is the external client used for KeyPair Custom Resource
+type KeyPairClient interface {
+	CreateKeyPair(ctx context.Context, input *ec2.CreateKeyPairInput, opts ...func(*ec2.Options)) (*ec2.CreateKeyPairOutput, error)
+	ImportKeyPair(ctx context.Context, input *ec2.ImportKeyPairInput, opts ...func(*ec2.Options)) (*ec2.ImportKeyPairOutput, error)
+	DeleteKeyPair(ctx context.Context, input *ec2.DeleteKeyPairInput, opts ...func(*ec2.Options)) (*ec2.DeleteKeyPairOutput, error)
+	DescribeKeyPairs(ctx context.Context, input *ec2.DescribeKeyPairsInput, opts ...func(*ec2.Options)) (*ec2.DescribeKeyPairsOutput, error)
+	CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// NewKeyPairClient returns a new client using AWS credentials as JSON encoded data.
+func NewKeyPairClient(cfg aws.Config) KeyPairClient {
+	return ec2.NewFromConfig(cfg)
+}
+
+// IsKeyPairNotFoundErr returns true if the error is because the key pair doesn't exist
+func IsKeyPairNotFoundErr(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == KeyPairNotFound
+}
+
+// GenerateKeyPairObservation is used to produce
+// manualv1alpha1.KeyPairObservation from ec2types.KeyPairInfo.
+func GenerateKeyPairObservation(kp ec2types.KeyPairInfo) manualv1alpha1.KeyPairObservation {
+	return manualv1alpha1.KeyPairObservation{
+		KeyPairID:      aws.ToString(kp.KeyPairId),
+		KeyFingerprint: aws.ToString(kp.KeyFingerprint),
+		KeyType:        string(kp.KeyType),
+	}
+}
+
+// LateInitializeKeyPair fills the empty fields in
+// *manualv1alpha1.KeyPairParameters with the values seen in
+// ec2types.KeyPairInfo.
+func LateInitializeKeyPair(in *manualv1alpha1.KeyPairParameters, kp *ec2types.KeyPairInfo) {
+	if kp == nil {
+		return
+	}
+	if kp.KeyType != "" {
+		in.KeyType = awsclients.LateInitializeStringPtr(in.KeyType, aws.String(string(kp.KeyType)))
+	}
+
+	if len(in.Tags) == 0 && len(kp.Tags) != 0 {
+		in.Tags = manualv1alpha1.BuildFromEC2Tags(kp.Tags)
+	}
+}
+
+// IsKeyPairUpToDate checks whether there is a change in any of the modifiable
+// fields. Only the tags of a key pair can be changed.
+func IsKeyPairUpToDate(p manualv1alpha1.KeyPairParameters, kp ec2types.KeyPairInfo) bool {
+	addTags, removeTags := DiffEC2Tags(manualv1alpha1.GenerateEC2Tags(p.Tags), kp.Tags)
+	return len(addTags) == 0 && len(removeTags) == 0
+}
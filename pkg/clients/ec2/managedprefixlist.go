@@ -0,0 +1,196 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+)
+
+const (
+	// PrefixListIDNotFound is the code that is returned by ec2 when the given PrefixListID is invalid
+	PrefixListIDNotFound = "InvalidPrefixListID.NotFound"
+)
+
+// ManagedPrefixListClient is the external client used for ManagedPrefixList
+// Custom Resource
+type ManagedPrefixListClient interface {
+	CreateManagedPrefixList(ctx context.Context, input *ec2.CreateManagedPrefixListInput, opts ...func(*ec2.Options)) (*ec2.CreateManagedPrefixListOutput, error)
+	DeleteManagedPrefixList(ctx context.Context, input *ec2.DeleteManagedPrefixListInput, opts ...func(*ec2.Options)) (*ec2.DeleteManagedPrefixListOutput, error)
+	DescribeManagedPrefixLists(ctx context.Context, input *ec2.DescribeManagedPrefixListsInput, opts ...func(*ec2.Options)) (*ec2.DescribeManagedPrefixListsOutput, error)
+	GetManagedPrefixListEntries(ctx context.Context, input *ec2.GetManagedPrefixListEntriesInput, opts ...func(*ec2.Options)) (*ec2.GetManagedPrefixListEntriesOutput, error)
+	ModifyManagedPrefixList(ctx context.Context, input *ec2.ModifyManagedPrefixListInput, opts ...func(*ec2.Options)) (*ec2.ModifyManagedPrefixListOutput, error)
+	CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// NewManagedPrefixListClient returns a new client using AWS credentials as JSON encoded data.
+func NewManagedPrefixListClient(cfg aws.Config) ManagedPrefixListClient {
+	return ec2.NewFromConfig(cfg)
+}
+
+// IsPrefixListNotFoundErr returns true if the error is because the prefix list doesn't exist
+func IsPrefixListNotFoundErr(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == PrefixListIDNotFound
+}
+
+// IsManagedPrefixListModifiable returns true if the prefix list is in a state
+// in which it can be modified. Modifications are rejected while a previous
+// create, modify or restore operation is still in progress.
+func IsManagedPrefixListModifiable(state ec2types.PrefixListState) bool {
+	switch state { //nolint:exhaustive
+	case ec2types.PrefixListStateCreateComplete,
+		ec2types.PrefixListStateModifyComplete,
+		ec2types.PrefixListStateModifyFailed,
+		ec2types.PrefixListStateRestoreComplete:
+		return true
+	}
+	return false
+}
+
+// GenerateManagedPrefixListObservation is used to produce
+// manualv1alpha1.ManagedPrefixListObservation from ec2types.ManagedPrefixList.
+func GenerateManagedPrefixListObservation(pl ec2types.ManagedPrefixList) manualv1alpha1.ManagedPrefixListObservation {
+	return manualv1alpha1.ManagedPrefixListObservation{
+		PrefixListID:  aws.ToString(pl.PrefixListId),
+		PrefixListARN: aws.ToString(pl.PrefixListArn),
+		OwnerID:       aws.ToString(pl.OwnerId),
+		State:         string(pl.State),
+		StateMessage:  aws.ToString(pl.StateMessage),
+		Version:       aws.ToInt64(pl.Version),
+	}
+}
+
+// GenerateAddPrefixListEntries converts a list of
+// manualv1alpha1.PrefixListEntry into ec2types.AddPrefixListEntry.
+func GenerateAddPrefixListEntries(entries []manualv1alpha1.PrefixListEntry) []ec2types.AddPrefixListEntry {
+	if len(entries) == 0 {
+		return nil
+	}
+	res := make([]ec2types.AddPrefixListEntry, len(entries))
+	for i, e := range entries {
+		res[i] = ec2types.AddPrefixListEntry{
+			Cidr:        aws.String(e.CIDR),
+			Description: e.Description,
+		}
+	}
+	return res
+}
+
+// DiffPrefixListEntries returns the entries that have to be added to and
+// removed from the prefix list to get from the observed to the desired
+// entries. Entries are identified by their CIDR, an entry whose description
+// changed is added again which updates the description in place.
+func DiffPrefixListEntries(want []manualv1alpha1.PrefixListEntry, have []ec2types.PrefixListEntry) (add []ec2types.AddPrefixListEntry, remove []ec2types.RemovePrefixListEntry) {
+	wantSet := make(map[string]manualv1alpha1.PrefixListEntry, len(want))
+	for _, e := range want {
+		wantSet[e.CIDR] = e
+	}
+	haveSet := make(map[string]ec2types.PrefixListEntry, len(have))
+	for _, e := range have {
+		cidr := aws.ToString(e.Cidr)
+		haveSet[cidr] = e
+		if _, ok := wantSet[cidr]; !ok {
+			remove = append(remove, ec2types.RemovePrefixListEntry{Cidr: e.Cidr})
+		}
+	}
+	for _, e := range wantSet {
+		if h, ok := haveSet[e.CIDR]; ok && aws.ToString(h.Description) == aws.ToString(e.Description) {
+			continue
+		}
+		add = append(add, ec2types.AddPrefixListEntry{
+			Cidr:        aws.String(e.CIDR),
+			Description: e.Description,
+		})
+	}
+	sort.Slice(add, func(i, j int) bool { return aws.ToString(add[i].Cidr) < aws.ToString(add[j].Cidr) })
+	sort.Slice(remove, func(i, j int) bool { return aws.ToString(remove[i].Cidr) < aws.ToString(remove[j].Cidr) })
+	return add, remove
+}
+
+// LateInitializeManagedPrefixList fills the empty fields in
+// *manualv1alpha1.ManagedPrefixListParameters with the values seen in
+// ec2types.ManagedPrefixList.
+func LateInitializeManagedPrefixList(in *manualv1alpha1.ManagedPrefixListParameters, pl *ec2types.ManagedPrefixList) {
+	if pl == nil {
+		return
+	}
+	if len(in.Tags) == 0 && len(pl.Tags) != 0 {
+		in.Tags = manualv1alpha1.BuildFromEC2Tags(pl.Tags)
+	}
+}
+
+// IsManagedPrefixListUpToDate checks whether there is a change in any of the
+// modifiable fields.
+func IsManagedPrefixListUpToDate(p manualv1alpha1.ManagedPrefixListParameters, pl ec2types.ManagedPrefixList, entries []ec2types.PrefixListEntry) bool {
+	if p.PrefixListName != aws.ToString(pl.PrefixListName) || p.MaxEntries != aws.ToInt32(pl.MaxEntries) {
+		return false
+	}
+
+	addTags, removeTags := DiffEC2Tags(manualv1alpha1.GenerateEC2Tags(p.Tags), pl.Tags)
+	if len(addTags) > 0 || len(removeTags) > 0 {
+		return false
+	}
+
+	add, remove := DiffPrefixListEntries(p.Entries, entries)
+	return len(add) == 0 && len(remove) == 0
+}
+
+// GenerateModifyManagedPrefixListInput returns the input to bring the prefix
+// list closer to the desired state, or nil if name, size and entries are up
+// to date. The size of a prefix list cannot be changed in the same request
+// as its entries, so a resize is sent on its own: before the entries when the
+// prefix list grows and after them when it shrinks. Entry changes are bound
+// to the observed version of the prefix list so that they fail instead of
+// overwriting concurrent modifications.
+func GenerateModifyManagedPrefixListInput(p manualv1alpha1.ManagedPrefixListParameters, pl ec2types.ManagedPrefixList, entries []ec2types.PrefixListEntry) *ec2.ModifyManagedPrefixListInput {
+	input := &ec2.ModifyManagedPrefixListInput{
+		PrefixListId: pl.PrefixListId,
+	}
+	changed := false
+	if p.PrefixListName != aws.ToString(pl.PrefixListName) {
+		input.PrefixListName = aws.String(p.PrefixListName)
+		changed = true
+	}
+
+	add, remove := DiffPrefixListEntries(p.Entries, entries)
+	resize := p.MaxEntries != aws.ToInt32(pl.MaxEntries)
+	switch {
+	case resize && (p.MaxEntries > aws.ToInt32(pl.MaxEntries) || len(add)+len(remove) == 0):
+		input.MaxEntries = aws.Int32(p.MaxEntries)
+		changed = true
+	case len(add)+len(remove) > 0:
+		input.AddEntries = add
+		input.RemoveEntries = remove
+		input.CurrentVersion = pl.Version
+		changed = true
+	}
+
+	if !changed {
+		return nil
+	}
+	return input
+}
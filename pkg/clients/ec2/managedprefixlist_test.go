@@ -0,0 +1,180 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go/document"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+)
+
+var (
+	prefixListID   = "pl-1234"
+	prefixListName = "some-list"
+	cidr1          = "10.0.0.0/16"
+	cidr2          = "10.1.0.0/16"
+	cidr3          = "10.2.0.0/16"
+)
+
+func prefixListParams(maxEntries int32, entries ...manualv1alpha1.PrefixListEntry) manualv1alpha1.ManagedPrefixListParameters {
+	return manualv1alpha1.ManagedPrefixListParameters{
+		PrefixListName: prefixListName,
+		MaxEntries:     maxEntries,
+		AddressFamily:  "IPv4",
+		Entries:        entries,
+	}
+}
+
+func prefixList(maxEntries int32) ec2types.ManagedPrefixList {
+	return ec2types.ManagedPrefixList{
+		PrefixListId:   aws.String(prefixListID),
+		PrefixListName: aws.String(prefixListName),
+		MaxEntries:     aws.Int32(maxEntries),
+		AddressFamily:  aws.String("IPv4"),
+		Version:        aws.Int64(3),
+	}
+}
+
+func TestDiffPrefixListEntries(t *testing.T) {
+	type want struct {
+		add    []ec2types.AddPrefixListEntry
+		remove []ec2types.RemovePrefixListEntry
+	}
+
+	cases := map[string]struct {
+		entries  []manualv1alpha1.PrefixListEntry
+		observed []ec2types.PrefixListEntry
+		want
+	}{
+		"Same": {
+			entries:  []manualv1alpha1.PrefixListEntry{{CIDR: cidr1, Description: aws.String("a")}},
+			observed: []ec2types.PrefixListEntry{{Cidr: aws.String(cidr1), Description: aws.String("a")}},
+		},
+		"Changed": {
+			entries: []manualv1alpha1.PrefixListEntry{
+				{CIDR: cidr2},
+				{CIDR: cidr1, Description: aws.String("b")},
+			},
+			observed: []ec2types.PrefixListEntry{
+				{Cidr: aws.String(cidr1), Description: aws.String("a")},
+				{Cidr: aws.String(cidr3)},
+			},
+			want: want{
+				add: []ec2types.AddPrefixListEntry{
+					{Cidr: aws.String(cidr1), Description: aws.String("b")},
+					{Cidr: aws.String(cidr2)},
+				},
+				remove: []ec2types.RemovePrefixListEntry{{Cidr: aws.String(cidr3)}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			add, remove := DiffPrefixListEntries(tc.entries, tc.observed)
+			if diff := cmp.Diff(tc.want.add, add, cmpopts.IgnoreTypes(document.NoSerde{})); diff != "" {
+				t.Errorf("r add: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove, cmpopts.IgnoreTypes(document.NoSerde{})); diff != "" {
+				t.Errorf("r remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateModifyManagedPrefixListInput(t *testing.T) {
+	type args struct {
+		p        manualv1alpha1.ManagedPrefixListParameters
+		pl       ec2types.ManagedPrefixList
+		observed []ec2types.PrefixListEntry
+	}
+
+	cases := map[string]struct {
+		args
+		want *ec2.ModifyManagedPrefixListInput
+	}{
+		"UpToDate": {
+			args: args{
+				p:        prefixListParams(5, manualv1alpha1.PrefixListEntry{CIDR: cidr1}),
+				pl:       prefixList(5),
+				observed: []ec2types.PrefixListEntry{{Cidr: aws.String(cidr1)}},
+			},
+		},
+		"Entries": {
+			args: args{
+				p:        prefixListParams(5, manualv1alpha1.PrefixListEntry{CIDR: cidr2}),
+				pl:       prefixList(5),
+				observed: []ec2types.PrefixListEntry{{Cidr: aws.String(cidr1)}},
+			},
+			want: &ec2.ModifyManagedPrefixListInput{
+				PrefixListId:   aws.String(prefixListID),
+				CurrentVersion: aws.Int64(3),
+				AddEntries:     []ec2types.AddPrefixListEntry{{Cidr: aws.String(cidr2)}},
+				RemoveEntries:  []ec2types.RemovePrefixListEntry{{Cidr: aws.String(cidr1)}},
+			},
+		},
+		"GrowBeforeEntries": {
+			args: args{
+				p:  prefixListParams(10, manualv1alpha1.PrefixListEntry{CIDR: cidr1}),
+				pl: prefixList(5),
+			},
+			want: &ec2.ModifyManagedPrefixListInput{
+				PrefixListId: aws.String(prefixListID),
+				MaxEntries:   aws.Int32(10),
+			},
+		},
+		"ShrinkAfterEntries": {
+			args: args{
+				p:        prefixListParams(1, manualv1alpha1.PrefixListEntry{CIDR: cidr1}),
+				pl:       prefixList(5),
+				observed: []ec2types.PrefixListEntry{{Cidr: aws.String(cidr1)}, {Cidr: aws.String(cidr2)}},
+			},
+			want: &ec2.ModifyManagedPrefixListInput{
+				PrefixListId:   aws.String(prefixListID),
+				CurrentVersion: aws.Int64(3),
+				RemoveEntries:  []ec2types.RemovePrefixListEntry{{Cidr: aws.String(cidr2)}},
+			},
+		},
+		"Shrink": {
+			args: args{
+				p:        prefixListParams(1, manualv1alpha1.PrefixListEntry{CIDR: cidr1}),
+				pl:       prefixList(5),
+				observed: []ec2types.PrefixListEntry{{Cidr: aws.String(cidr1)}},
+			},
+			want: &ec2.ModifyManagedPrefixListInput{
+				PrefixListId: aws.String(prefixListID),
+				MaxEntries:   aws.Int32(1),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateModifyManagedPrefixListInput(tc.args.p, tc.args.pl, tc.args.observed)
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreTypes(document.NoSerde{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,286 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+	awsclients "github.com/crossplane-contrib/provider-aws/pkg/clients"
+)
+
+const (
+	// NetworkACLIDNotFound is the code that is returned by ec2 when the given NetworkAclID is invalid
+	NetworkACLIDNotFound = "InvalidNetworkAclID.NotFound"
+
+	// NetworkACLEntryNotFound is the code that is returned when the given network ACL entry is not found
+	NetworkACLEntryNotFound = "InvalidNetworkAclEntry.NotFound"
+
+	// MaxNetworkACLRuleNumber is the highest rule number of a user defined
+	// network ACL entry. Higher rule numbers belong to the default entries
+	// that cannot be modified.
+	MaxNetworkACLRuleNumber = 32766
+)
+
+// NetworkACLClient is the external client used for NetworkACL and
+// NetworkACLEntry Custom Resources
+type NetworkACLClient interface {
+	CreateNetworkAcl(ctx context.Context, input *ec2.CreateNetworkAclInput, opts ...func(*ec2.Options)) (*ec2.CreateNetworkAclOutput, error)
+	DeleteNetworkAcl(ctx context.Context, input *ec2.DeleteNetworkAclInput, opts ...func(*ec2.Options)) (*ec2.DeleteNetworkAclOutput, error)
+	DescribeNetworkAcls(ctx context.Context, input *ec2.DescribeNetworkAclsInput, opts ...func(*ec2.Options)) (*ec2.DescribeNetworkAclsOutput, error)
+	ReplaceNetworkAclAssociation(ctx context.Context, input *ec2.ReplaceNetworkAclAssociationInput, opts ...func(*ec2.Options)) (*ec2.ReplaceNetworkAclAssociationOutput, error)
+	CreateNetworkAclEntry(ctx context.Context, input *ec2.CreateNetworkAclEntryInput, opts ...func(*ec2.Options)) (*ec2.CreateNetworkAclEntryOutput, error)
+	ReplaceNetworkAclEntry(ctx context.Context, input *ec2.ReplaceNetworkAclEntryInput, opts ...func(*ec2.Options)) (*ec2.ReplaceNetworkAclEntryOutput, error)
+	DeleteNetworkAclEntry(ctx context.Context, input *ec2.DeleteNetworkAclEntryInput, opts ...func(*ec2.Options)) (*ec2.DeleteNetworkAclEntryOutput, error)
+	CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// NewNetworkACLClient returns a new client using AWS credentials as JSON encoded data.
+func NewNetworkACLClient(cfg aws.Config) NetworkACLClient {
+	return ec2.NewFromConfig(cfg)
+}
+
+// IsNetworkACLNotFoundErr returns true if the error is because the network ACL doesn't exist
+func IsNetworkACLNotFoundErr(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == NetworkACLIDNotFound
+}
+
+// IsNetworkACLEntryNotFoundErr returns true if the error is because the network ACL entry doesn't exist
+func IsNetworkACLEntryNotFoundErr(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == NetworkACLEntryNotFound
+}
+
+// GenerateNetworkACLObservation is used to produce
+// manualv1alpha1.NetworkACLObservation from ec2types.NetworkAcl.
+func GenerateNetworkACLObservation(acl ec2types.NetworkAcl) manualv1alpha1.NetworkACLObservation {
+	o := manualv1alpha1.NetworkACLObservation{
+		NetworkACLID: aws.ToString(acl.NetworkAclId),
+		IsDefault:    aws.ToBool(acl.IsDefault),
+		OwnerID:      aws.ToString(acl.OwnerId),
+	}
+
+	if len(acl.Associations) > 0 {
+		o.Associations = make([]manualv1alpha1.NetworkACLAssociation, len(acl.Associations))
+		for i, asc := range acl.Associations {
+			o.Associations[i] = manualv1alpha1.NetworkACLAssociation{
+				AssociationID: aws.ToString(asc.NetworkAclAssociationId),
+				SubnetID:      aws.ToString(asc.SubnetId),
+			}
+		}
+	}
+
+	if len(acl.Entries) > 0 {
+		o.Entries = make([]manualv1alpha1.NetworkACLRule, len(acl.Entries))
+		for i, e := range acl.Entries {
+			o.Entries[i] = GenerateNetworkACLRule(e)
+		}
+	}
+
+	return o
+}
+
+// GenerateNetworkACLRule converts an ec2types.NetworkAclEntry into a
+// manualv1alpha1.NetworkACLRule.
+func GenerateNetworkACLRule(e ec2types.NetworkAclEntry) manualv1alpha1.NetworkACLRule {
+	r := manualv1alpha1.NetworkACLRule{
+		RuleNumber:    aws.ToInt32(e.RuleNumber),
+		Egress:        aws.ToBool(e.Egress),
+		Protocol:      aws.ToString(e.Protocol),
+		RuleAction:    string(e.RuleAction),
+		CIDRBlock:     e.CidrBlock,
+		IPv6CIDRBlock: e.Ipv6CidrBlock,
+	}
+	if e.IcmpTypeCode != nil {
+		r.ICMPTypeCode = &manualv1alpha1.ICMPTypeCode{
+			Code: e.IcmpTypeCode.Code,
+			Type: e.IcmpTypeCode.Type,
+		}
+	}
+	if e.PortRange != nil {
+		r.PortRange = &manualv1alpha1.PortRange{
+			From: e.PortRange.From,
+			To:   e.PortRange.To,
+		}
+	}
+	return r
+}
+
+// GenerateEC2NetworkACLEntry converts a manualv1alpha1.NetworkACLRule into
+// an ec2types.NetworkAclEntry.
+func GenerateEC2NetworkACLEntry(r manualv1alpha1.NetworkACLRule) ec2types.NetworkAclEntry {
+	e := ec2types.NetworkAclEntry{
+		RuleNumber:    aws.Int32(r.RuleNumber),
+		Egress:        aws.Bool(r.Egress),
+		Protocol:      aws.String(r.Protocol),
+		RuleAction:    ec2types.RuleAction(r.RuleAction),
+		CidrBlock:     r.CIDRBlock,
+		Ipv6CidrBlock: r.IPv6CIDRBlock,
+	}
+	if r.ICMPTypeCode != nil {
+		e.IcmpTypeCode = &ec2types.IcmpTypeCode{
+			Code: r.ICMPTypeCode.Code,
+			Type: r.ICMPTypeCode.Type,
+		}
+	}
+	if r.PortRange != nil {
+		e.PortRange = &ec2types.PortRange{
+			From: r.PortRange.From,
+			To:   r.PortRange.To,
+		}
+	}
+	return e
+}
+
+// GenerateEC2NetworkACLEntries converts a list of
+// manualv1alpha1.NetworkACLRule into ec2types.NetworkAclEntry.
+func GenerateEC2NetworkACLEntries(rules []manualv1alpha1.NetworkACLRule) []ec2types.NetworkAclEntry {
+	if len(rules) == 0 {
+		return nil
+	}
+	res := make([]ec2types.NetworkAclEntry, len(rules))
+	for i, r := range rules {
+		res[i] = GenerateEC2NetworkACLEntry(r)
+	}
+	return res
+}
+
+// GenerateNetworkACLRuleFromEntry returns the manualv1alpha1.NetworkACLRule
+// described by the parameters of a NetworkACLEntry.
+func GenerateNetworkACLRuleFromEntry(p manualv1alpha1.NetworkACLEntryParameters) manualv1alpha1.NetworkACLRule {
+	return manualv1alpha1.NetworkACLRule{
+		RuleNumber:    p.RuleNumber,
+		Egress:        p.Egress,
+		Protocol:      p.Protocol,
+		RuleAction:    p.RuleAction,
+		CIDRBlock:     p.CIDRBlock,
+		IPv6CIDRBlock: p.IPv6CIDRBlock,
+		ICMPTypeCode:  p.ICMPTypeCode,
+		PortRange:     p.PortRange,
+	}
+}
+
+// GenerateCreateNetworkACLEntryInput returns the input to create the given
+// entry in the network ACL with the given ID.
+func GenerateCreateNetworkACLEntryInput(aclID string, e ec2types.NetworkAclEntry) *ec2.CreateNetworkAclEntryInput {
+	return &ec2.CreateNetworkAclEntryInput{
+		NetworkAclId:  aws.String(aclID),
+		RuleNumber:    e.RuleNumber,
+		Egress:        e.Egress,
+		Protocol:      e.Protocol,
+		RuleAction:    e.RuleAction,
+		CidrBlock:     e.CidrBlock,
+		Ipv6CidrBlock: e.Ipv6CidrBlock,
+		IcmpTypeCode:  e.IcmpTypeCode,
+		PortRange:     e.PortRange,
+	}
+}
+
+// GenerateReplaceNetworkACLEntryInput returns the input to replace the
+// entry with the same direction and rule number in the network ACL with the
+// given ID.
+func GenerateReplaceNetworkACLEntryInput(aclID string, e ec2types.NetworkAclEntry) *ec2.ReplaceNetworkAclEntryInput {
+	return &ec2.ReplaceNetworkAclEntryInput{
+		NetworkAclId:  aws.String(aclID),
+		RuleNumber:    e.RuleNumber,
+		Egress:        e.Egress,
+		Protocol:      e.Protocol,
+		RuleAction:    e.RuleAction,
+		CidrBlock:     e.CidrBlock,
+		Ipv6CidrBlock: e.Ipv6CidrBlock,
+		IcmpTypeCode:  e.IcmpTypeCode,
+		PortRange:     e.PortRange,
+	}
+}
+
+// FindNetworkACLEntry returns the entry with the given direction and rule
+// number or nil if there is none.
+func FindNetworkACLEntry(entries []ec2types.NetworkAclEntry, egress bool, ruleNumber int32) *ec2types.NetworkAclEntry {
+	for i := range entries {
+		if aws.ToBool(entries[i].Egress) == egress && aws.ToInt32(entries[i].RuleNumber) == ruleNumber {
+			return &entries[i]
+		}
+	}
+	return nil
+}
+
+// LateInitializeNetworkACL fills the empty fields in
+// *manualv1alpha1.NetworkACLParameters with the values seen in
+// ec2types.NetworkAcl.
+func LateInitializeNetworkACL(in *manualv1alpha1.NetworkACLParameters, acl *ec2types.NetworkAcl) {
+	if acl == nil {
+		return
+	}
+	in.VPCID = awsclients.LateInitializeStringPtr(in.VPCID, acl.VpcId)
+
+	if len(in.Tags) == 0 && len(acl.Tags) != 0 {
+		in.Tags = manualv1alpha1.BuildFromEC2Tags(acl.Tags)
+	}
+}
+
+// IsNetworkACLUpToDate checks whether there is a change in any of the
+// modifiable fields.
+func IsNetworkACLUpToDate(p manualv1alpha1.NetworkACLParameters, acl ec2types.NetworkAcl) bool {
+	addTags, removeTags := DiffEC2Tags(manualv1alpha1.GenerateEC2Tags(p.Tags), acl.Tags)
+	if len(addTags) > 0 || len(removeTags) > 0 {
+		return false
+	}
+
+	associate, disassociate := DiffNetworkACLAssociations(p.SubnetIDs, acl.Associations)
+	if len(associate) > 0 || len(disassociate) > 0 {
+		return false
+	}
+
+	if !awsclients.BoolValue(p.ManageEntries) {
+		return true
+	}
+	create, replace, remove := DiffNetworkACLEntries(GenerateEC2NetworkACLEntries(p.Entries), acl.Entries)
+	return len(create) == 0 && len(replace) == 0 && len(remove) == 0
+}
+
+// DiffNetworkACLAssociations returns the subnets that have to be associated
+// with the network ACL and the associations that have to be replaced by
+// the default network ACL.
+func DiffNetworkACLAssociations(want []string, have []ec2types.NetworkAclAssociation) (associate []string, disassociate []ec2types.NetworkAclAssociation) {
+	wantSet := make(map[string]struct{}, len(want))
+	for _, id := range want {
+		wantSet[id] = struct{}{}
+	}
+	haveSet := make(map[string]struct{}, len(have))
+	for _, asc := range have {
+		id := aws.ToString(asc.SubnetId)
+		haveSet[id] = struct{}{}
+		if _, ok := wantSet[id]; !ok {
+			disassociate = append(disassociate, asc)
+		}
+	}
+	for _, id := range want {
+		if _, ok := haveSet[id]; !ok {
+			associate = append(associate, id)
+			haveSet[id] = struct{}{}
+		}
+	}
+	return associate, disassociate
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+// protocolNumbers maps the protocol names accepted by the EC2 API to the
+// protocol numbers it returns.
+var protocolNumbers = map[string]string{
+	"all":    "-1",
+	"icmp":   "1",
+	"tcp":    "6",
+	"udp":    "17",
+	"icmpv6": "58",
+}
+
+// entryKey represents the unique tuple (direction, rule number) of a network
+// ACL entry in a format supported as a map key
+type entryKey struct {
+	egress     bool
+	ruleNumber int32
+}
+
+func getEntryKey(e ec2types.NetworkAclEntry) entryKey {
+	return entryKey{
+		egress:     aws.ToBool(e.Egress),
+		ruleNumber: aws.ToInt32(e.RuleNumber),
+	}
+}
+
+// normalizeProtocol returns the protocol number of the given protocol name
+// or number.
+func normalizeProtocol(p *string) string {
+	s := strings.ToLower(aws.ToString(p))
+	if n, ok := protocolNumbers[s]; ok {
+		return n
+	}
+	return s
+}
+
+func equalPortRange(a, b *ec2types.PortRange) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return aws.ToInt32(a.From) == aws.ToInt32(b.From) && aws.ToInt32(a.To) == aws.ToInt32(b.To)
+}
+
+func equalICMPTypeCode(a, b *ec2types.IcmpTypeCode) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return aws.ToInt32(a.Code) == aws.ToInt32(b.Code) && aws.ToInt32(a.Type) == aws.ToInt32(b.Type)
+}
+
+// IsNetworkACLEntryUpToDate returns true if the observed entry matches the
+// desired one. Port ranges are only compared for TCP and UDP, and ICMP types
+// and codes only for ICMP and ICMPv6, since EC2 ignores them for any other
+// protocol.
+func IsNetworkACLEntryUpToDate(want, have ec2types.NetworkAclEntry) bool {
+	protocol := normalizeProtocol(want.Protocol)
+	if protocol != normalizeProtocol(have.Protocol) ||
+		!strings.EqualFold(string(want.RuleAction), string(have.RuleAction)) ||
+		aws.ToString(want.CidrBlock) != aws.ToString(have.CidrBlock) ||
+		aws.ToString(want.Ipv6CidrBlock) != aws.ToString(have.Ipv6CidrBlock) {
+		return false
+	}
+	switch protocol {
+	case "6", "17":
+		return equalPortRange(want.PortRange, have.PortRange)
+	case "1", "58":
+		return equalICMPTypeCode(want.IcmpTypeCode, have.IcmpTypeCode)
+	}
+	return true
+}
+
+func convertToEntryMap(entries []ec2types.NetworkAclEntry) map[entryKey]ec2types.NetworkAclEntry {
+	ret := make(map[entryKey]ec2types.NetworkAclEntry, len(entries))
+	for _, e := range entries {
+		// The default entries with rule number 32767 cannot be modified.
+		if aws.ToInt32(e.RuleNumber) > MaxNetworkACLRuleNumber {
+			continue
+		}
+		ret[getEntryKey(e)] = e
+	}
+	return ret
+}
+
+// DiffNetworkACLEntries compares two sets of network ACL entries, and returns
+// the entries to create, replace and remove to make them identical. Entries
+// are identified by their direction and rule number. The default entries of
+// a network ACL are ignored.
+func DiffNetworkACLEntries(want, have []ec2types.NetworkAclEntry) (create, replace, remove []ec2types.NetworkAclEntry) {
+	wantMap := convertToEntryMap(want)
+	haveMap := convertToEntryMap(have)
+
+	for key, w := range wantMap {
+		h, ok := haveMap[key]
+		switch {
+		case !ok:
+			create = append(create, w)
+		case !IsNetworkACLEntryUpToDate(w, h):
+			replace = append(replace, w)
+		}
+	}
+
+	for key, h := range haveMap {
+		if _, ok := wantMap[key]; !ok {
+			remove = append(remove, h)
+		}
+	}
+
+	// Sort the results to apply the changes in a deterministic order.
+	sortEntries(create)
+	sortEntries(replace)
+	sortEntries(remove)
+	return create, replace, remove
+}
+
+func sortEntries(entries []ec2types.NetworkAclEntry) {
+	sort.Slice(entries, func(i, j int) bool {
+		a, b := getEntryKey(entries[i]), getEntryKey(entries[j])
+		if a.egress != b.egress {
+			return !a.egress
+		}
+		return a.ruleNumber < b.ruleNumber
+	})
+}
@@ -0,0 +1,180 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go/document"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+type naclEntryModifier func(*ec2types.NetworkAclEntry)
+
+func withNACLPortRange(from, to int32) naclEntryModifier {
+	return func(e *ec2types.NetworkAclEntry) {
+		e.PortRange = &ec2types.PortRange{From: aws.Int32(from), To: aws.Int32(to)}
+	}
+}
+
+func withNACLProtocol(p string) naclEntryModifier {
+	return func(e *ec2types.NetworkAclEntry) { e.Protocol = aws.String(p) }
+}
+
+func withNACLAction(a ec2types.RuleAction) naclEntryModifier {
+	return func(e *ec2types.NetworkAclEntry) { e.RuleAction = a }
+}
+
+func withNACLEgress() naclEntryModifier {
+	return func(e *ec2types.NetworkAclEntry) { e.Egress = aws.Bool(true) }
+}
+
+func naclEntry(rule int32, m ...naclEntryModifier) ec2types.NetworkAclEntry {
+	e := ec2types.NetworkAclEntry{
+		RuleNumber: aws.Int32(rule),
+		Egress:     aws.Bool(false),
+		Protocol:   aws.String("6"),
+		RuleAction: ec2types.RuleActionAllow,
+		CidrBlock:  aws.String("10.0.0.0/16"),
+	}
+	for _, f := range m {
+		f(&e)
+	}
+	return e
+}
+
+func TestDiffNetworkACLEntries(t *testing.T) {
+	type testCase struct {
+		name string
+
+		want, have              []ec2types.NetworkAclEntry
+		create, replace, remove []ec2types.NetworkAclEntry
+	}
+
+	cases := []testCase{
+		{
+			name: "Same",
+			want: []ec2types.NetworkAclEntry{naclEntry(100, withNACLPortRange(80, 80))},
+			have: []ec2types.NetworkAclEntry{naclEntry(100, withNACLPortRange(80, 80))},
+		},
+		{
+			name: "ProtocolName",
+			want: []ec2types.NetworkAclEntry{naclEntry(100, withNACLProtocol("TCP"), withNACLPortRange(80, 80))},
+			have: []ec2types.NetworkAclEntry{naclEntry(100, withNACLPortRange(80, 80))},
+		},
+		{
+			name: "IgnorePortRangeForAllProtocols",
+			want: []ec2types.NetworkAclEntry{naclEntry(100, withNACLProtocol("-1"), withNACLPortRange(80, 80))},
+			have: []ec2types.NetworkAclEntry{naclEntry(100, withNACLProtocol("-1"))},
+		},
+		{
+			name: "IgnoreDefaultEntries",
+			have: []ec2types.NetworkAclEntry{
+				naclEntry(32767, withNACLProtocol("-1"), withNACLAction(ec2types.RuleActionDeny)),
+				naclEntry(32767, withNACLProtocol("-1"), withNACLAction(ec2types.RuleActionDeny), withNACLEgress()),
+			},
+		},
+		{
+			name:   "Create",
+			want:   []ec2types.NetworkAclEntry{naclEntry(100, withNACLPortRange(80, 80)), naclEntry(100, withNACLEgress())},
+			have:   []ec2types.NetworkAclEntry{naclEntry(100, withNACLPortRange(80, 80))},
+			create: []ec2types.NetworkAclEntry{naclEntry(100, withNACLEgress())},
+		},
+		{
+			name:    "Replace",
+			want:    []ec2types.NetworkAclEntry{naclEntry(100, withNACLPortRange(443, 443))},
+			have:    []ec2types.NetworkAclEntry{naclEntry(100, withNACLPortRange(80, 80))},
+			replace: []ec2types.NetworkAclEntry{naclEntry(100, withNACLPortRange(443, 443))},
+		},
+		{
+			name:    "ReplaceAction",
+			want:    []ec2types.NetworkAclEntry{naclEntry(100, withNACLPortRange(80, 80), withNACLAction(ec2types.RuleActionDeny))},
+			have:    []ec2types.NetworkAclEntry{naclEntry(100, withNACLPortRange(80, 80))},
+			replace: []ec2types.NetworkAclEntry{naclEntry(100, withNACLPortRange(80, 80), withNACLAction(ec2types.RuleActionDeny))},
+		},
+		{
+			name:   "Remove",
+			want:   []ec2types.NetworkAclEntry{naclEntry(100, withNACLPortRange(80, 80))},
+			have:   []ec2types.NetworkAclEntry{naclEntry(100, withNACLPortRange(80, 80)), naclEntry(200, withNACLPortRange(443, 443))},
+			remove: []ec2types.NetworkAclEntry{naclEntry(200, withNACLPortRange(443, 443))},
+		},
+	}
+
+	opts := cmp.Options{
+		cmpopts.IgnoreTypes(document.NoSerde{}),
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			create, replace, remove := DiffNetworkACLEntries(tc.want, tc.have)
+
+			if diff := cmp.Diff(tc.create, create, opts); diff != "" {
+				t.Errorf("r create: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.replace, replace, opts); diff != "" {
+				t.Errorf("r replace: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.remove, remove, opts); diff != "" {
+				t.Errorf("r remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffNetworkACLAssociations(t *testing.T) {
+	type want struct {
+		associate    []string
+		disassociate []ec2types.NetworkAclAssociation
+	}
+
+	cases := map[string]struct {
+		subnets      []string
+		associations []ec2types.NetworkAclAssociation
+		want
+	}{
+		"Same": {
+			subnets:      []string{"subnet-1"},
+			associations: []ec2types.NetworkAclAssociation{{SubnetId: aws.String("subnet-1")}},
+		},
+		"Changed": {
+			subnets: []string{"subnet-1", "subnet-2"},
+			associations: []ec2types.NetworkAclAssociation{
+				{SubnetId: aws.String("subnet-1")},
+				{SubnetId: aws.String("subnet-3")},
+			},
+			want: want{
+				associate:    []string{"subnet-2"},
+				disassociate: []ec2types.NetworkAclAssociation{{SubnetId: aws.String("subnet-3")}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			associate, disassociate := DiffNetworkACLAssociations(tc.subnets, tc.associations)
+			if diff := cmp.Diff(tc.want.associate, associate); diff != "" {
+				t.Errorf("r associate: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.disassociate, disassociate, cmpopts.IgnoreTypes(document.NoSerde{})); diff != "" {
+				t.Errorf("r disassociate: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
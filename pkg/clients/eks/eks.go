@@ -22,6 +22,8 @@ import (
 	"encoding/json"
 	"errors"
 	"net"
+	"sort"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
@@ -46,6 +48,18 @@ const (
 	expireHeader     = "X-Amz-Expires"
 	expireHeaderTime = "60"
 	v1Prefix         = "k8s-aws-v1."
+	execAPIVersion   = "client.authentication.k8s.io/v1beta1"
+)
+
+const (
+	// ConnectionKeyTokenExpiration is the connection secret key that holds the
+	// time the token in the kubeconfig expires, in RFC 3339 format.
+	ConnectionKeyTokenExpiration = "tokenExpiration"
+
+	// TokenLifetime is the time a presigned token is accepted by the cluster.
+	// EKS accepts tokens for 15 minutes; like aws-iam-authenticator we report
+	// them expired a minute earlier to account for clock skew.
+	TokenLifetime = 14 * time.Minute
 )
 
 // Client defines EKS Client operations
@@ -383,37 +397,19 @@ func IsUpToDate(p *v1beta1.ClusterParameters, cluster *ekstypes.Cluster) (bool,
 	}
 	res := cmp.Equal(&v1beta1.ClusterParameters{}, patch, cmpopts.EquateEmpty(),
 		cmpopts.IgnoreTypes(&xpv1.Reference{}, &xpv1.Selector{}, []xpv1.Reference{}),
-		cmpopts.IgnoreFields(v1beta1.ClusterParameters{}, "Region"),
+		cmpopts.IgnoreFields(v1beta1.ClusterParameters{}, "Region", "KubeconfigAuthentication"),
 		cmpopts.IgnoreFields(v1beta1.VpcConfigRequest{}, "PublicAccessCidrs", "SubnetIDs", "SecurityGroupIDs"))
 	return res, nil
 }
 
 // GetConnectionDetails extracts managed.ConnectionDetails out of ekstypes.Cluster.
-func GetConnectionDetails(ctx context.Context, cluster *ekstypes.Cluster, stsClient STSClient) managed.ConnectionDetails {
+// The kubeconfig authenticates as configured by the KubeconfigAuthentication
+// of the supplied parameters.
+func GetConnectionDetails(ctx context.Context, cluster *ekstypes.Cluster, stsClient STSClient, p *v1beta1.ClusterParameters) managed.ConnectionDetails {
 	if cluster == nil || cluster.Name == nil || cluster.Endpoint == nil || cluster.CertificateAuthority == nil || cluster.CertificateAuthority.Data == nil {
 		return managed.ConnectionDetails{}
 	}
 
-	getCallerIdentity, _ := stsClient.PresignGetCallerIdentity(ctx, &sts.GetCallerIdentityInput{},
-		func(po *sts.PresignOptions) {
-			po.ClientOptions = []func(*sts.Options){
-				sts.WithAPIOptions(
-					smithyhttp.AddHeaderValue(clusterIDHeader, *cluster.Name),
-					smithyhttp.AddHeaderValue(expireHeader, expireHeaderTime), // otherwise we get in authenticator log invalid X-Amz-Expires parameter in pre-signed URL: 0
-				),
-			}
-		},
-	)
-
-	// NOTE(hasheddan): This is carried over from the v1alpha3 version of the
-	// EKS cluster resource. Signing the URL means that anyone in possession of
-	// this Kubeconfig will now be able to access the EKS cluster until this URL
-	// expires. This is necessary for other systems, such as core Crossplane, to
-	// be able to schedule workloads to the cluster for now, but is not the most
-	// secure way of accessing the cluster.
-	// More information: https://docs.aws.amazon.com/eks/latest/userguide/create-kubeconfig.html
-	token := v1Prefix + base64.RawURLEncoding.EncodeToString([]byte(getCallerIdentity.URL))
-
 	// NOTE(hasheddan): We must decode the CA data before constructing our
 	// Kubeconfig, as the raw Kubeconfig will be base64 encoded again when
 	// written as a Secret.
@@ -421,6 +417,28 @@ func GetConnectionDetails(ctx context.Context, cluster *ekstypes.Cluster, stsCli
 	if err != nil {
 		return managed.ConnectionDetails{}
 	}
+
+	cd := managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretEndpointKey: []byte(*cluster.Endpoint),
+		xpv1.ResourceCredentialsSecretCAKey:       caData,
+	}
+
+	var authInfo *clientcmdapi.AuthInfo
+	if auth := p.KubeconfigAuthentication; auth != nil && auth.Mode == v1beta1.KubeconfigAuthenticationModeExec {
+		authInfo = &clientcmdapi.AuthInfo{
+			Exec: generateExecConfig(*cluster.Name, awsclients.StringValue(p.Region), auth.Exec),
+		}
+	} else {
+		token, expiration, err := generateToken(ctx, *cluster.Name, stsClient)
+		if err != nil {
+			return managed.ConnectionDetails{}
+		}
+		authInfo = &clientcmdapi.AuthInfo{
+			Token: token,
+		}
+		cd[ConnectionKeyTokenExpiration] = []byte(expiration.UTC().Format(time.RFC3339))
+	}
+
 	kc := clientcmdapi.Config{
 		Clusters: map[string]*clientcmdapi.Cluster{
 			*cluster.Name: {
@@ -435,9 +453,7 @@ func GetConnectionDetails(ctx context.Context, cluster *ekstypes.Cluster, stsCli
 			},
 		},
 		AuthInfos: map[string]*clientcmdapi.AuthInfo{
-			*cluster.Name: {
-				Token: token,
-			},
+			*cluster.Name: authInfo,
 		},
 		CurrentContext: *cluster.Name,
 	}
@@ -446,9 +462,76 @@ func GetConnectionDetails(ctx context.Context, cluster *ekstypes.Cluster, stsCli
 	if err != nil {
 		return managed.ConnectionDetails{}
 	}
-	return managed.ConnectionDetails{
-		xpv1.ResourceCredentialsSecretEndpointKey:   []byte(*cluster.Endpoint),
-		xpv1.ResourceCredentialsSecretKubeconfigKey: rawConfig,
-		xpv1.ResourceCredentialsSecretCAKey:         caData,
+	cd[xpv1.ResourceCredentialsSecretKubeconfigKey] = rawConfig
+	return cd
+}
+
+// generateToken returns a bearer token for the supplied cluster and the time
+// it expires.
+func generateToken(ctx context.Context, clusterName string, stsClient STSClient) (string, time.Time, error) {
+	getCallerIdentity, err := stsClient.PresignGetCallerIdentity(ctx, &sts.GetCallerIdentityInput{},
+		func(po *sts.PresignOptions) {
+			po.ClientOptions = []func(*sts.Options){
+				sts.WithAPIOptions(
+					smithyhttp.AddHeaderValue(clusterIDHeader, clusterName),
+					smithyhttp.AddHeaderValue(expireHeader, expireHeaderTime), // otherwise we get in authenticator log invalid X-Amz-Expires parameter in pre-signed URL: 0
+				),
+			}
+		},
+	)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	// NOTE(hasheddan): This is carried over from the v1alpha3 version of the
+	// EKS cluster resource. Signing the URL means that anyone in possession of
+	// this Kubeconfig will now be able to access the EKS cluster until this URL
+	// expires. This is necessary for other systems, such as core Crossplane, to
+	// be able to schedule workloads to the cluster for now, but is not the most
+	// secure way of accessing the cluster.
+	// More information: https://docs.aws.amazon.com/eks/latest/userguide/create-kubeconfig.html
+	return v1Prefix + base64.RawURLEncoding.EncodeToString([]byte(getCallerIdentity.URL)), time.Now().Add(TokenLifetime), nil
+}
+
+// generateExecConfig returns an exec credential plugin configuration that
+// fetches tokens for the supplied cluster.
+func generateExecConfig(clusterName, region string, cfg *v1beta1.KubeconfigExecConfig) *clientcmdapi.ExecConfig {
+	if cfg == nil {
+		cfg = &v1beta1.KubeconfigExecConfig{}
+	}
+	region = awsclients.StringValue(awsclients.LateInitializeStringPtr(cfg.Region, &region))
+
+	ec := &clientcmdapi.ExecConfig{
+		APIVersion:      execAPIVersion,
+		Command:         v1beta1.KubeconfigExecCommandAWSIAMAuthenticator,
+		InteractiveMode: clientcmdapi.NeverExecInteractiveMode,
+	}
+	if awsclients.StringValue(cfg.Command) == v1beta1.KubeconfigExecCommandAWSCLI {
+		ec.Command = v1beta1.KubeconfigExecCommandAWSCLI
+		ec.Args = []string{"eks", "get-token", "--cluster-name", clusterName}
+		if region != "" {
+			ec.Args = append(ec.Args, "--region", region)
+		}
+		if cfg.RoleARN != nil {
+			ec.Args = append(ec.Args, "--role-arn", *cfg.RoleARN)
+		}
+	} else {
+		ec.Args = []string{"token", "-i", clusterName}
+		if cfg.RoleARN != nil {
+			ec.Args = append(ec.Args, "-r", *cfg.RoleARN)
+		}
+		if region != "" {
+			ec.Env = append(ec.Env, clientcmdapi.ExecEnvVar{Name: "AWS_REGION", Value: region})
+		}
+	}
+
+	keys := make([]string, 0, len(cfg.Env))
+	for k := range cfg.Env {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		ec.Env = append(ec.Env, clientcmdapi.ExecEnvVar{Name: k, Value: cfg.Env[k]})
 	}
+	return ec
 }
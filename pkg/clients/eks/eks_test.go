@@ -17,18 +17,26 @@ limitations under the License.
 package eks
 
 import (
+	"context"
+	"encoding/base64"
 	"testing"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
 	"github.com/aws/aws-sdk-go-v2/service/eks"
 	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	awssts "github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/aws/smithy-go/document"
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 
 	"github.com/crossplane-contrib/provider-aws/apis/eks/v1beta1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/eks/fake"
 )
 
 var (
@@ -873,3 +881,179 @@ func TestIsUpToDate(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateExecConfig(t *testing.T) {
+	region := "eu-central-1"
+	otherRegion := "us-east-1"
+	cmdAWS := v1beta1.KubeconfigExecCommandAWSCLI
+
+	type args struct {
+		region string
+		cfg    *v1beta1.KubeconfigExecConfig
+	}
+
+	cases := map[string]struct {
+		args args
+		want *clientcmdapi.ExecConfig
+	}{
+		"DefaultAuthenticator": {
+			args: args{
+				region: region,
+			},
+			want: &clientcmdapi.ExecConfig{
+				APIVersion:      execAPIVersion,
+				Command:         v1beta1.KubeconfigExecCommandAWSIAMAuthenticator,
+				Args:            []string{"token", "-i", clusterName},
+				Env:             []clientcmdapi.ExecEnvVar{{Name: "AWS_REGION", Value: region}},
+				InteractiveMode: clientcmdapi.NeverExecInteractiveMode,
+			},
+		},
+		"AuthenticatorWithRoleAndEnv": {
+			args: args{
+				region: region,
+				cfg: &v1beta1.KubeconfigExecConfig{
+					RoleARN: &roleArn,
+					Env:     map[string]string{"B": "2", "A": "1"},
+				},
+			},
+			want: &clientcmdapi.ExecConfig{
+				APIVersion: execAPIVersion,
+				Command:    v1beta1.KubeconfigExecCommandAWSIAMAuthenticator,
+				Args:       []string{"token", "-i", clusterName, "-r", roleArn},
+				Env: []clientcmdapi.ExecEnvVar{
+					{Name: "AWS_REGION", Value: region},
+					{Name: "A", Value: "1"},
+					{Name: "B", Value: "2"},
+				},
+				InteractiveMode: clientcmdapi.NeverExecInteractiveMode,
+			},
+		},
+		"AWSCLIWithRegionOverride": {
+			args: args{
+				region: region,
+				cfg: &v1beta1.KubeconfigExecConfig{
+					Command: &cmdAWS,
+					RoleARN: &roleArn,
+					Region:  &otherRegion,
+				},
+			},
+			want: &clientcmdapi.ExecConfig{
+				APIVersion:      execAPIVersion,
+				Command:         v1beta1.KubeconfigExecCommandAWSCLI,
+				Args:            []string{"eks", "get-token", "--cluster-name", clusterName, "--region", otherRegion, "--role-arn", roleArn},
+				InteractiveMode: clientcmdapi.NeverExecInteractiveMode,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := generateExecConfig(clusterName, tc.args.region, tc.args.cfg)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("generateExecConfig(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetConnectionDetails(t *testing.T) {
+	endpoint := "https://my-cool-cluster.eks.amazonaws.com"
+	ca := "ca-data"
+	region := "eu-central-1"
+	presignedURL := "https://sts.amazonaws.com/?Action=GetCallerIdentity"
+	stsClient := &fake.MockSTSClient{
+		MockPresignGetCallerIdentity: func(ctx context.Context, input *awssts.GetCallerIdentityInput, opts []func(*awssts.PresignOptions)) (*v4.PresignedHTTPRequest, error) {
+			return &v4.PresignedHTTPRequest{URL: presignedURL}, nil
+		},
+	}
+	cluster := &ekstypes.Cluster{
+		Name:                 &clusterName,
+		Endpoint:             &endpoint,
+		CertificateAuthority: &ekstypes.Certificate{Data: aws.String(base64.StdEncoding.EncodeToString([]byte(ca)))},
+	}
+
+	type want struct {
+		keys            []string
+		authInfo        *clientcmdapi.AuthInfo
+		tokenExpiration bool
+	}
+
+	cases := map[string]struct {
+		p    *v1beta1.ClusterParameters
+		want want
+	}{
+		"TokenMode": {
+			p: &v1beta1.ClusterParameters{Region: &region},
+			want: want{
+				keys: []string{
+					xpv1.ResourceCredentialsSecretEndpointKey,
+					xpv1.ResourceCredentialsSecretCAKey,
+					xpv1.ResourceCredentialsSecretKubeconfigKey,
+					ConnectionKeyTokenExpiration,
+				},
+				authInfo: &clientcmdapi.AuthInfo{
+					Token: v1Prefix + base64.RawURLEncoding.EncodeToString([]byte(presignedURL)),
+				},
+				tokenExpiration: true,
+			},
+		},
+		"ExecMode": {
+			p: &v1beta1.ClusterParameters{
+				Region: &region,
+				KubeconfigAuthentication: &v1beta1.KubeconfigAuthentication{
+					Mode: v1beta1.KubeconfigAuthenticationModeExec,
+				},
+			},
+			want: want{
+				keys: []string{
+					xpv1.ResourceCredentialsSecretEndpointKey,
+					xpv1.ResourceCredentialsSecretCAKey,
+					xpv1.ResourceCredentialsSecretKubeconfigKey,
+				},
+				authInfo: &clientcmdapi.AuthInfo{
+					Exec: generateExecConfig(clusterName, region, nil),
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			before := time.Now()
+			got := GetConnectionDetails(context.Background(), cluster, stsClient, tc.p)
+
+			keys := make([]string, 0, len(got))
+			for k := range got {
+				keys = append(keys, k)
+			}
+			if diff := cmp.Diff(tc.want.keys, keys, cmpopts.SortSlices(func(a, b string) bool { return a < b })); diff != "" {
+				t.Errorf("GetConnectionDetails(...): -want keys, +got keys:\n%s", diff)
+			}
+			if diff := cmp.Diff(endpoint, string(got[xpv1.ResourceCredentialsSecretEndpointKey])); diff != "" {
+				t.Errorf("GetConnectionDetails(...): -want endpoint, +got endpoint:\n%s", diff)
+			}
+			if diff := cmp.Diff(ca, string(got[xpv1.ResourceCredentialsSecretCAKey])); diff != "" {
+				t.Errorf("GetConnectionDetails(...): -want CA, +got CA:\n%s", diff)
+			}
+
+			kc, err := clientcmd.Load(got[xpv1.ResourceCredentialsSecretKubeconfigKey])
+			if err != nil {
+				t.Fatalf("GetConnectionDetails(...): cannot load kubeconfig: %v", err)
+			}
+			if diff := cmp.Diff(tc.want.authInfo, kc.AuthInfos[clusterName], cmpopts.EquateEmpty(), cmpopts.IgnoreFields(clientcmdapi.AuthInfo{}, "LocationOfOrigin")); diff != "" {
+				t.Errorf("GetConnectionDetails(...): -want auth info, +got auth info:\n%s", diff)
+			}
+
+			if !tc.want.tokenExpiration {
+				return
+			}
+			exp, err := time.Parse(time.RFC3339, string(got[ConnectionKeyTokenExpiration]))
+			if err != nil {
+				t.Fatalf("GetConnectionDetails(...): cannot parse token expiration: %v", err)
+			}
+			if exp.Before(before.Add(TokenLifetime).Truncate(time.Second)) || exp.After(time.Now().Add(TokenLifetime)) {
+				t.Errorf("GetConnectionDetails(...): token expiration %s is not %s after the token was generated", exp, TokenLifetime)
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	endpointsv1 "github.com/aws/aws-sdk-go/aws/endpoints"
+
+	"github.com/crossplane-contrib/provider-aws/apis/v1beta1"
+)
+
+// globalSigningRegion is the region requests to services that do not have a
+// region are signed for.
+const globalSigningRegion = "us-east-1"
+
+// serviceEndpoint returns the endpoint override of the supplied service, if
+// any. Services are matched case-insensitively and ignoring spaces, so that
+// both the service IDs of AWS SDK v2 and the endpoints IDs of AWS SDK v1
+// match, e.g. "Route 53" and "route53".
+func serviceEndpoint(e *v1beta1.EndpointConfig, service string) (v1beta1.ServiceEndpointConfig, bool) {
+	for id, s := range e.Services {
+		if normalizeServiceID(id) == normalizeServiceID(service) {
+			return s, true
+		}
+	}
+	return v1beta1.ServiceEndpointConfig{}, false
+}
+
+func normalizeServiceID(id string) string {
+	return strings.ToLower(strings.ReplaceAll(id, " ", ""))
+}
+
+// serviceSigningRegion returns the region requests to the supplied endpoint
+// override are signed for.
+func serviceSigningRegion(s v1beta1.ServiceEndpointConfig, region string) string {
+	if s.SigningRegion != nil {
+		return *s.SigningRegion
+	}
+	if region == GlobalRegion {
+		return globalSigningRegion
+	}
+	return region
+}
+
+// endpointStateSource is an AWS SDK v2 config source that makes service
+// clients resolve FIPS and dual-stack endpoints as configured by an
+// EndpointConfig.
+type endpointStateSource struct {
+	endpoint *v1beta1.EndpointConfig
+}
+
+// GetUseFIPSEndpoint returns whether FIPS endpoints are resolved.
+func (s endpointStateSource) GetUseFIPSEndpoint(_ context.Context) (aws.FIPSEndpointState, bool, error) {
+	switch {
+	case s.endpoint.UseFIPSEndpoint == nil:
+		return aws.FIPSEndpointStateUnset, false, nil
+	case *s.endpoint.UseFIPSEndpoint:
+		return aws.FIPSEndpointStateEnabled, true, nil
+	default:
+		return aws.FIPSEndpointStateDisabled, true, nil
+	}
+}
+
+// GetUseDualStackEndpoint returns whether dual-stack endpoints are resolved.
+func (s endpointStateSource) GetUseDualStackEndpoint(_ context.Context) (aws.DualStackEndpointState, bool, error) {
+	switch {
+	case s.endpoint.UseDualStackEndpoint == nil:
+		return aws.DualStackEndpointStateUnset, false, nil
+	case *s.endpoint.UseDualStackEndpoint:
+		return aws.DualStackEndpointStateEnabled, true, nil
+	default:
+		return aws.DualStackEndpointStateDisabled, true, nil
+	}
+}
+
+// fipsEndpointStateV1 returns the AWS SDK v1 FIPS endpoint state of the
+// supplied toggle.
+func fipsEndpointStateV1(enabled bool) endpointsv1.FIPSEndpointState {
+	if enabled {
+		return endpointsv1.FIPSEndpointStateEnabled
+	}
+	return endpointsv1.FIPSEndpointStateDisabled
+}
+
+// dualStackEndpointStateV1 returns the AWS SDK v1 dual-stack endpoint state
+// of the supplied toggle.
+func dualStackEndpointStateV1(enabled bool) endpointsv1.DualStackEndpointState {
+	if enabled {
+		return endpointsv1.DualStackEndpointStateEnabled
+	}
+	return endpointsv1.DualStackEndpointStateDisabled
+}
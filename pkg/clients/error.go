@@ -19,7 +19,9 @@ package aws
 import (
 	"strings"
 
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	requestv1 "github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/smithy-go"
 	"github.com/pkg/errors"
 )
@@ -48,3 +50,38 @@ func Wrap(err error, msg string) error {
 	}
 	return errors.Wrap(err, msg)
 }
+
+// IsErrorThrottled returns whether the error was returned by the AWS API
+// because the request was throttled. Errors of both AWS SDK v1 and v2 are
+// supported.
+func IsErrorThrottled(err error) bool {
+	if err == nil {
+		return false
+	}
+	var awsErr smithy.APIError
+	if errors.As(err, &awsErr) {
+		_, ok := retry.DefaultThrottleErrorCodes[awsErr.ErrorCode()]
+		return ok
+	}
+	return requestv1.IsErrorThrottle(err)
+}
+
+// errorCodeUnknown is the error code of errors that were not returned by the
+// AWS API, e.g. network errors.
+const errorCodeUnknown = "Unknown"
+
+// ErrorCode returns the AWS error code of the supplied error, or an empty
+// string if the error is nil. Errors of both AWS SDK v1 and v2 are supported.
+func ErrorCode(err error) string {
+	if err == nil {
+		return ""
+	}
+	var awsErr smithy.APIError
+	if errors.As(err, &awsErr) {
+		return awsErr.ErrorCode()
+	}
+	if v1Err, ok := err.(awserr.Error); ok { //nolint:errorlint
+		return v1Err.Code()
+	}
+	return errorCodeUnknown
+}
@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/smithy-go"
 	smithyhttp "github.com/aws/smithy-go/transport/http"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
@@ -71,3 +72,93 @@ func TestWrap(t *testing.T) {
 		})
 	}
 }
+
+func TestIsErrorThrottled(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		arg    error
+		want   bool
+	}{
+		"Nil": {
+			arg:  nil,
+			want: false,
+		},
+		"NonAWSError": {
+			reason: "Errors that are not coming from AWS are never throttling errors",
+			arg:    errors.New(errBoom),
+			want:   false,
+		},
+		"V2Throttled": {
+			reason: "AWS SDK v2 errors with a throttling error code should be detected",
+			arg: &smithy.OperationError{
+				ServiceID:     "EC2",
+				OperationName: "DescribeVpcs",
+				Err:           &smithy.GenericAPIError{Code: "RequestLimitExceeded"},
+			},
+			want: true,
+		},
+		"V2NotThrottled": {
+			reason: "AWS SDK v2 errors with other error codes should be ignored",
+			arg:    &smithy.GenericAPIError{Code: "InvalidVpcID.NotFound"},
+			want:   false,
+		},
+		"V1Throttled": {
+			reason: "AWS SDK v1 errors with a throttling error code should be detected",
+			arg:    awserr.New("ThrottlingException", "Rate exceeded", nil),
+			want:   true,
+		},
+		"V1NotThrottled": {
+			reason: "AWS SDK v1 errors with other error codes should be ignored",
+			arg:    awserr.New("ResourceNotFoundException", "not found", nil),
+			want:   false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsErrorThrottled(tc.arg)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("%s\nIsErrorThrottled(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestErrorCode(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		arg    error
+		want   string
+	}{
+		"Nil": {
+			arg:  nil,
+			want: "",
+		},
+		"NonAWSError": {
+			reason: "Errors that are not coming from AWS have an unknown error code",
+			arg:    errors.New(errBoom),
+			want:   errorCodeUnknown,
+		},
+		"V2Error": {
+			reason: "The error code of wrapped AWS SDK v2 errors should be returned",
+			arg: &smithy.OperationError{
+				ServiceID:     "EC2",
+				OperationName: "DescribeVpcs",
+				Err:           &smithy.GenericAPIError{Code: "InvalidVpcID.NotFound"},
+			},
+			want: "InvalidVpcID.NotFound",
+		},
+		"V1Error": {
+			reason: "The error code of AWS SDK v1 errors should be returned",
+			arg:    awserr.New("ResourceNotFoundException", "not found", nil),
+			want:   "ResourceNotFoundException",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ErrorCode(tc.arg)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("%s\nErrorCode(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
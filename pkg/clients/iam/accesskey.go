@@ -18,9 +18,38 @@ package iam
 
 import (
 	"context"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+
+	"github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
+	awsclients "github.com/crossplane-contrib/provider-aws/pkg/clients"
+)
+
+// DefaultAccessKeyRotationGracePeriod is the grace period of an AccessKey
+// rotation if none is configured.
+const DefaultAccessKeyRotationGracePeriod = time.Hour
+
+// AccessKeyRotationAction is the next step of an AccessKey rotation.
+type AccessKeyRotationAction int
+
+// The steps of an AccessKey rotation.
+const (
+	// AccessKeyRotationNone means that nothing has to be done.
+	AccessKeyRotationNone AccessKeyRotationAction = iota
+	// AccessKeyRotationCreate means that a new access key has to be created.
+	AccessKeyRotationCreate
+	// AccessKeyRotationDeactivate means that the previous access key has to
+	// be deactivated.
+	AccessKeyRotationDeactivate
+	// AccessKeyRotationDelete means that the previous access key has to be
+	// deleted.
+	AccessKeyRotationDelete
+	// AccessKeyRotationForget means that the previous access key does not
+	// exist anymore and only has to be removed from the AccessKey.
+	AccessKeyRotationForget
 )
 
 // AccessClient is the external client used for AccessKey Custom Resource
@@ -29,9 +58,76 @@ type AccessClient interface {
 	DeleteAccessKey(ctx context.Context, input *iam.DeleteAccessKeyInput, opts ...func(*iam.Options)) (*iam.DeleteAccessKeyOutput, error)
 	ListAccessKeys(ctx context.Context, input *iam.ListAccessKeysInput, opts ...func(*iam.Options)) (*iam.ListAccessKeysOutput, error)
 	UpdateAccessKey(ctx context.Context, input *iam.UpdateAccessKeyInput, opts ...func(*iam.Options)) (*iam.UpdateAccessKeyOutput, error)
+	GetAccessKeyLastUsed(ctx context.Context, input *iam.GetAccessKeyLastUsedInput, opts ...func(*iam.Options)) (*iam.GetAccessKeyLastUsedOutput, error)
 }
 
 // NewAccessClient returns a new client using AWS credentials as JSON encoded data.
 func NewAccessClient(conf aws.Config) AccessClient {
 	return iam.NewFromConfig(conf)
 }
+
+// GenerateAccessKeyObservation is used to produce v1beta1.AccessKeyObservation
+// from the current access key, its last usage and the previous access key,
+// which may be nil.
+func GenerateAccessKeyObservation(key iamtypes.AccessKeyMetadata, lastUsed *iamtypes.AccessKeyLastUsed, previous *iamtypes.AccessKeyMetadata) v1beta1.AccessKeyObservation {
+	o := v1beta1.AccessKeyObservation{
+		AccessKeyID: aws.ToString(key.AccessKeyId),
+		CreateDate:  awsclients.TimeToMetaTime(key.CreateDate),
+	}
+	if lastUsed != nil {
+		o.LastUsedDate = awsclients.TimeToMetaTime(lastUsed.LastUsedDate)
+		// AWS reports N/A if the access key was never used.
+		if name := aws.ToString(lastUsed.ServiceName); name != "N/A" {
+			o.LastUsedServiceName = name
+		}
+	}
+	if previous != nil {
+		o.PreviousAccessKeyID = aws.ToString(previous.AccessKeyId)
+		o.PreviousAccessKeyStatus = string(previous.Status)
+	}
+	return o
+}
+
+// AccessKeyRotationGracePeriod returns the grace period of the given
+// rotation policy.
+func AccessKeyRotationGracePeriod(p *v1beta1.AccessKeyRotationPolicy) time.Duration {
+	if p == nil || p.GracePeriod == nil {
+		return DefaultAccessKeyRotationGracePeriod
+	}
+	return p.GracePeriod.Duration
+}
+
+// NextAccessKeyRotationAction returns the next step to rotate the given
+// AccessKey at the given time. A new access key is only created once the
+// previous one was deleted, because IAM allows at most two access keys per
+// user.
+func NextAccessKeyRotationAction(cr *v1beta1.AccessKey, now time.Time) AccessKeyRotationAction {
+	o := cr.Status.AtProvider
+	p := cr.Spec.ForProvider.RotationPolicy
+
+	if cr.GetAnnotations()[v1beta1.AnnotationKeyPreviousAccessKey] != "" {
+		if o.PreviousAccessKeyID == "" {
+			return AccessKeyRotationForget
+		}
+		if o.CreateDate == nil {
+			return AccessKeyRotationNone
+		}
+		grace := AccessKeyRotationGracePeriod(p)
+		rotated := now.Sub(o.CreateDate.Time)
+		switch {
+		case rotated >= 2*grace:
+			return AccessKeyRotationDelete
+		case rotated >= grace && o.PreviousAccessKeyStatus != string(iamtypes.StatusTypeInactive):
+			return AccessKeyRotationDeactivate
+		}
+		return AccessKeyRotationNone
+	}
+
+	if _, ok := cr.GetAnnotations()[v1beta1.AnnotationKeyRotateAccessKey]; ok {
+		return AccessKeyRotationCreate
+	}
+	if p != nil && p.MaxAge != nil && o.CreateDate != nil && now.Sub(o.CreateDate.Time) >= p.MaxAge.Duration {
+		return AccessKeyRotationCreate
+	}
+	return AccessKeyRotationNone
+}
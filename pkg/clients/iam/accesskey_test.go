@@ -0,0 +1,139 @@
+package iam
+
+import (
+	"testing"
+	"time"
+
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
+)
+
+func TestNextAccessKeyRotationAction(t *testing.T) {
+	now := time.Now()
+	created := metav1.NewTime(now.Add(-10 * time.Hour))
+	policy := &v1beta1.AccessKeyRotationPolicy{
+		MaxAge:      &metav1.Duration{Duration: 24 * time.Hour},
+		GracePeriod: &metav1.Duration{Duration: 4 * time.Hour},
+	}
+
+	type args struct {
+		annotations map[string]string
+		policy      *v1beta1.AccessKeyRotationPolicy
+		observation v1beta1.AccessKeyObservation
+	}
+	cases := map[string]struct {
+		args args
+		want AccessKeyRotationAction
+	}{
+		"NoPolicy": {
+			args: args{
+				observation: v1beta1.AccessKeyObservation{CreateDate: &created},
+			},
+			want: AccessKeyRotationNone,
+		},
+		"NotDue": {
+			args: args{
+				policy:      policy,
+				observation: v1beta1.AccessKeyObservation{CreateDate: &created},
+			},
+			want: AccessKeyRotationNone,
+		},
+		"MaxAgeExceeded": {
+			args: args{
+				policy: &v1beta1.AccessKeyRotationPolicy{
+					MaxAge: &metav1.Duration{Duration: 8 * time.Hour},
+				},
+				observation: v1beta1.AccessKeyObservation{CreateDate: &created},
+			},
+			want: AccessKeyRotationCreate,
+		},
+		"RotateAnnotation": {
+			args: args{
+				annotations: map[string]string{v1beta1.AnnotationKeyRotateAccessKey: ""},
+				observation: v1beta1.AccessKeyObservation{CreateDate: &created},
+			},
+			want: AccessKeyRotationCreate,
+		},
+		"PreviousInGracePeriod": {
+			args: args{
+				annotations: map[string]string{
+					v1beta1.AnnotationKeyPreviousAccessKey: "old",
+					v1beta1.AnnotationKeyRotateAccessKey:   "",
+				},
+				policy: &v1beta1.AccessKeyRotationPolicy{
+					GracePeriod: &metav1.Duration{Duration: 12 * time.Hour},
+				},
+				observation: v1beta1.AccessKeyObservation{
+					CreateDate:              &created,
+					PreviousAccessKeyID:     "old",
+					PreviousAccessKeyStatus: string(iamtypes.StatusTypeActive),
+				},
+			},
+			want: AccessKeyRotationNone,
+		},
+		"PreviousDueForDeactivation": {
+			args: args{
+				annotations: map[string]string{v1beta1.AnnotationKeyPreviousAccessKey: "old"},
+				policy: &v1beta1.AccessKeyRotationPolicy{
+					GracePeriod: &metav1.Duration{Duration: 8 * time.Hour},
+				},
+				observation: v1beta1.AccessKeyObservation{
+					CreateDate:              &created,
+					PreviousAccessKeyID:     "old",
+					PreviousAccessKeyStatus: string(iamtypes.StatusTypeActive),
+				},
+			},
+			want: AccessKeyRotationDeactivate,
+		},
+		"PreviousDeactivated": {
+			args: args{
+				annotations: map[string]string{v1beta1.AnnotationKeyPreviousAccessKey: "old"},
+				policy: &v1beta1.AccessKeyRotationPolicy{
+					GracePeriod: &metav1.Duration{Duration: 8 * time.Hour},
+				},
+				observation: v1beta1.AccessKeyObservation{
+					CreateDate:              &created,
+					PreviousAccessKeyID:     "old",
+					PreviousAccessKeyStatus: string(iamtypes.StatusTypeInactive),
+				},
+			},
+			want: AccessKeyRotationNone,
+		},
+		"PreviousDueForDeletion": {
+			args: args{
+				annotations: map[string]string{v1beta1.AnnotationKeyPreviousAccessKey: "old"},
+				policy:      policy,
+				observation: v1beta1.AccessKeyObservation{
+					CreateDate:              &created,
+					PreviousAccessKeyID:     "old",
+					PreviousAccessKeyStatus: string(iamtypes.StatusTypeInactive),
+				},
+			},
+			want: AccessKeyRotationDelete,
+		},
+		"PreviousGone": {
+			args: args{
+				annotations: map[string]string{v1beta1.AnnotationKeyPreviousAccessKey: "old"},
+				observation: v1beta1.AccessKeyObservation{CreateDate: &created},
+			},
+			want: AccessKeyRotationForget,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &v1beta1.AccessKey{}
+			cr.SetAnnotations(tc.args.annotations)
+			cr.Spec.ForProvider.RotationPolicy = tc.args.policy
+			cr.Status.AtProvider = tc.args.observation
+
+			got := NextAccessKeyRotationAction(cr, now)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
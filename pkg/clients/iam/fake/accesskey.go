@@ -33,6 +33,8 @@ type MockAccessClient struct {
 	MockDeleteAccessKey func(ctx context.Context, input *iam.DeleteAccessKeyInput, opts []func(*iam.Options)) (*iam.DeleteAccessKeyOutput, error)
 	MockListAccessKeys  func(ctx context.Context, input *iam.ListAccessKeysInput, opts []func(*iam.Options)) (*iam.ListAccessKeysOutput, error)
 	MockUpdateAccessKey func(ctx context.Context, input *iam.UpdateAccessKeyInput, opts []func(*iam.Options)) (*iam.UpdateAccessKeyOutput, error)
+
+	MockGetAccessKeyLastUsed func(ctx context.Context, input *iam.GetAccessKeyLastUsedInput, opts []func(*iam.Options)) (*iam.GetAccessKeyLastUsedOutput, error)
 }
 
 // UpdateAccessKey mocks UpdateAccessKey method
@@ -54,3 +56,8 @@ func (m MockAccessClient) CreateAccessKey(ctx context.Context, input *iam.Create
 func (m MockAccessClient) DeleteAccessKey(ctx context.Context, input *iam.DeleteAccessKeyInput, opts ...func(*iam.Options)) (*iam.DeleteAccessKeyOutput, error) {
 	return m.MockDeleteAccessKey(ctx, input, opts)
 }
+
+// GetAccessKeyLastUsed mocks GetAccessKeyLastUsed method
+func (m MockAccessClient) GetAccessKeyLastUsed(ctx context.Context, input *iam.GetAccessKeyLastUsedInput, opts ...func(*iam.Options)) (*iam.GetAccessKeyLastUsedOutput, error) {
+	return m.MockGetAccessKeyLastUsed(ctx, input, opts)
+}
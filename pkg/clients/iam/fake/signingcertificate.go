@@ -0,0 +1,56 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+
+	clientset "github.com/crossplane-contrib/provider-aws/pkg/clients/iam"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.SigningCertificateClient = (*MockSigningCertificateClient)(nil)
+
+// MockSigningCertificateClient is a type that implements all the methods for SigningCertificateClient interface
+type MockSigningCertificateClient struct {
+	MockListSigningCertificates  func(ctx context.Context, input *iam.ListSigningCertificatesInput, opts []func(*iam.Options)) (*iam.ListSigningCertificatesOutput, error)
+	MockUploadSigningCertificate func(ctx context.Context, input *iam.UploadSigningCertificateInput, opts []func(*iam.Options)) (*iam.UploadSigningCertificateOutput, error)
+	MockUpdateSigningCertificate func(ctx context.Context, input *iam.UpdateSigningCertificateInput, opts []func(*iam.Options)) (*iam.UpdateSigningCertificateOutput, error)
+	MockDeleteSigningCertificate func(ctx context.Context, input *iam.DeleteSigningCertificateInput, opts []func(*iam.Options)) (*iam.DeleteSigningCertificateOutput, error)
+}
+
+// ListSigningCertificates mocks ListSigningCertificates method
+func (m MockSigningCertificateClient) ListSigningCertificates(ctx context.Context, input *iam.ListSigningCertificatesInput, opts ...func(*iam.Options)) (*iam.ListSigningCertificatesOutput, error) {
+	return m.MockListSigningCertificates(ctx, input, opts)
+}
+
+// UploadSigningCertificate mocks UploadSigningCertificate method
+func (m MockSigningCertificateClient) UploadSigningCertificate(ctx context.Context, input *iam.UploadSigningCertificateInput, opts ...func(*iam.Options)) (*iam.UploadSigningCertificateOutput, error) {
+	return m.MockUploadSigningCertificate(ctx, input, opts)
+}
+
+// UpdateSigningCertificate mocks UpdateSigningCertificate method
+func (m MockSigningCertificateClient) UpdateSigningCertificate(ctx context.Context, input *iam.UpdateSigningCertificateInput, opts ...func(*iam.Options)) (*iam.UpdateSigningCertificateOutput, error) {
+	return m.MockUpdateSigningCertificate(ctx, input, opts)
+}
+
+// DeleteSigningCertificate mocks DeleteSigningCertificate method
+func (m MockSigningCertificateClient) DeleteSigningCertificate(ctx context.Context, input *iam.DeleteSigningCertificateInput, opts ...func(*iam.Options)) (*iam.DeleteSigningCertificateOutput, error) {
+	return m.MockDeleteSigningCertificate(ctx, input, opts)
+}
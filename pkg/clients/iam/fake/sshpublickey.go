@@ -0,0 +1,62 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+
+	clientset "github.com/crossplane-contrib/provider-aws/pkg/clients/iam"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.SSHPublicKeyClient = (*MockSSHPublicKeyClient)(nil)
+
+// MockSSHPublicKeyClient is a type that implements all the methods for SSHPublicKeyClient interface
+type MockSSHPublicKeyClient struct {
+	MockGetSSHPublicKey    func(ctx context.Context, input *iam.GetSSHPublicKeyInput, opts []func(*iam.Options)) (*iam.GetSSHPublicKeyOutput, error)
+	MockListSSHPublicKeys  func(ctx context.Context, input *iam.ListSSHPublicKeysInput, opts []func(*iam.Options)) (*iam.ListSSHPublicKeysOutput, error)
+	MockUploadSSHPublicKey func(ctx context.Context, input *iam.UploadSSHPublicKeyInput, opts []func(*iam.Options)) (*iam.UploadSSHPublicKeyOutput, error)
+	MockUpdateSSHPublicKey func(ctx context.Context, input *iam.UpdateSSHPublicKeyInput, opts []func(*iam.Options)) (*iam.UpdateSSHPublicKeyOutput, error)
+	MockDeleteSSHPublicKey func(ctx context.Context, input *iam.DeleteSSHPublicKeyInput, opts []func(*iam.Options)) (*iam.DeleteSSHPublicKeyOutput, error)
+}
+
+// GetSSHPublicKey mocks GetSSHPublicKey method
+func (m MockSSHPublicKeyClient) GetSSHPublicKey(ctx context.Context, input *iam.GetSSHPublicKeyInput, opts ...func(*iam.Options)) (*iam.GetSSHPublicKeyOutput, error) {
+	return m.MockGetSSHPublicKey(ctx, input, opts)
+}
+
+// ListSSHPublicKeys mocks ListSSHPublicKeys method
+func (m MockSSHPublicKeyClient) ListSSHPublicKeys(ctx context.Context, input *iam.ListSSHPublicKeysInput, opts ...func(*iam.Options)) (*iam.ListSSHPublicKeysOutput, error) {
+	return m.MockListSSHPublicKeys(ctx, input, opts)
+}
+
+// UploadSSHPublicKey mocks UploadSSHPublicKey method
+func (m MockSSHPublicKeyClient) UploadSSHPublicKey(ctx context.Context, input *iam.UploadSSHPublicKeyInput, opts ...func(*iam.Options)) (*iam.UploadSSHPublicKeyOutput, error) {
+	return m.MockUploadSSHPublicKey(ctx, input, opts)
+}
+
+// UpdateSSHPublicKey mocks UpdateSSHPublicKey method
+func (m MockSSHPublicKeyClient) UpdateSSHPublicKey(ctx context.Context, input *iam.UpdateSSHPublicKeyInput, opts ...func(*iam.Options)) (*iam.UpdateSSHPublicKeyOutput, error) {
+	return m.MockUpdateSSHPublicKey(ctx, input, opts)
+}
+
+// DeleteSSHPublicKey mocks DeleteSSHPublicKey method
+func (m MockSSHPublicKeyClient) DeleteSSHPublicKey(ctx context.Context, input *iam.DeleteSSHPublicKeyInput, opts ...func(*iam.Options)) (*iam.DeleteSSHPublicKeyOutput, error) {
+	return m.MockDeleteSSHPublicKey(ctx, input, opts)
+}
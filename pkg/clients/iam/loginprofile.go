@@ -0,0 +1,70 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/crossplane/crossplane-runtime/pkg/password"
+)
+
+const (
+	passwordLowercase = "abcdefghijklmnopqrstuvwxyz"
+	passwordUppercase = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	passwordNumbers   = "0123456789"
+	passwordSymbols   = "!@#$%^&*()_+-=[]{}|'"
+)
+
+// loginProfilePassword generates passwords that contain all character classes
+// an account password policy may require.
+var loginProfilePassword = password.Settings{
+	CharacterSet: passwordLowercase + passwordUppercase + passwordNumbers + passwordSymbols,
+	Length:       32,
+}
+
+// LoginProfileClient is the external client used for LoginProfile Custom Resource
+type LoginProfileClient interface {
+	GetLoginProfile(ctx context.Context, input *iam.GetLoginProfileInput, opts ...func(*iam.Options)) (*iam.GetLoginProfileOutput, error)
+	CreateLoginProfile(ctx context.Context, input *iam.CreateLoginProfileInput, opts ...func(*iam.Options)) (*iam.CreateLoginProfileOutput, error)
+	DeleteLoginProfile(ctx context.Context, input *iam.DeleteLoginProfileInput, opts ...func(*iam.Options)) (*iam.DeleteLoginProfileOutput, error)
+}
+
+// NewLoginProfileClient returns a new client using AWS credentials as JSON encoded data.
+func NewLoginProfileClient(conf aws.Config) LoginProfileClient {
+	return iam.NewFromConfig(conf)
+}
+
+// GenerateLoginProfilePassword returns a random password that contains at
+// least one lowercase letter, uppercase letter, number and symbol, so that it
+// satisfies any account password policy.
+func GenerateLoginProfilePassword() (string, error) {
+	for {
+		pw, err := loginProfilePassword.Generate()
+		if err != nil {
+			return "", err
+		}
+		if strings.ContainsAny(pw, passwordLowercase) &&
+			strings.ContainsAny(pw, passwordUppercase) &&
+			strings.ContainsAny(pw, passwordNumbers) &&
+			strings.ContainsAny(pw, passwordSymbols) {
+			return pw, nil
+		}
+	}
+}
@@ -0,0 +1,46 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerateLoginProfilePassword(t *testing.T) {
+	classes := map[string]string{
+		"Lowercase": passwordLowercase,
+		"Uppercase": passwordUppercase,
+		"Numbers":   passwordNumbers,
+		"Symbols":   passwordSymbols,
+	}
+
+	for i := 0; i < 100; i++ {
+		pw, err := GenerateLoginProfilePassword()
+		if err != nil {
+			t.Fatalf("GenerateLoginProfilePassword(): %s", err)
+		}
+		if len(pw) != loginProfilePassword.Length {
+			t.Errorf("GenerateLoginProfilePassword(): want length %d, got %d", loginProfilePassword.Length, len(pw))
+		}
+		for name, chars := range classes {
+			if !strings.ContainsAny(pw, chars) {
+				t.Errorf("GenerateLoginProfilePassword(): %q contains no %s", pw, name)
+			}
+		}
+	}
+}
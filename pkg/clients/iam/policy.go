@@ -42,10 +42,29 @@ func NewSTSClient(cfg aws.Config) STSClient {
 	return sts.NewFromConfig(cfg)
 }
 
+// GetPolicyDocument returns the JSON document of the policy which is either
+// given as raw document or as structured policy.
+func GetPolicyDocument(in v1beta1.PolicyParameters) (string, error) {
+	doc, err := policyutils.ConvertResourcePolicyOrRaw(in.PolicyDocument, awsclients.String(in.Document))
+	return aws.ToString(doc), err
+}
+
+// ValidatePolicyDocument checks the given document of a managed policy for
+// mistakes that would make IAM reject it.
+func ValidatePolicyDocument(document string) error {
+	return policyutils.ValidatePolicyString(document,
+		policyutils.WithIdentityPolicy(),
+		policyutils.WithMaxSize(policyutils.MaxManagedPolicySize))
+}
+
 // IsPolicyUpToDate checks whether there is a change in any of the modifiable fields in policy.
 func IsPolicyUpToDate(in v1beta1.PolicyParameters, policy iamtypes.PolicyVersion) (bool, string, error) {
+	document, err := GetPolicyDocument(in)
+	if err != nil {
+		return false, "", err
+	}
 	externalPolicyRaw := awsclients.StringValue(policy.Document)
-	if externalPolicyRaw == "" || in.Document == "" {
+	if externalPolicyRaw == "" || document == "" {
 		return false, "", nil
 	}
 
@@ -57,7 +76,7 @@ func IsPolicyUpToDate(in v1beta1.PolicyParameters, policy iamtypes.PolicyVersion
 	if err != nil {
 		return false, "", err
 	}
-	specPolicy, err := policyutils.ParsePolicyString(in.Document)
+	specPolicy, err := policyutils.ParsePolicyString(document)
 	if err != nil {
 		return false, "", err
 	}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"net/url"
+	"sort"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
@@ -17,12 +18,19 @@ import (
 
 	"github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
 	awsclients "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	policyutils "github.com/crossplane-contrib/provider-aws/pkg/utils/policy"
 )
 
 const (
 	errCheckUpToDate      = "unable to determine if external resource is up to date"
 	errPolicyJSONEscape   = "malformed AssumeRolePolicyDocument JSON"
 	errPolicyJSONUnescape = "malformed AssumeRolePolicyDocument escaping"
+
+	errListRolePolicies         = "cannot list inline policies of role"
+	errGetRolePolicy            = "cannot get inline policy of role"
+	errInlinePolicyUnescape     = "malformed inline policy escaping"
+	errInlinePolicyParse        = "cannot parse inline policy"
+	errListAttachedRolePolicies = "cannot list managed policies attached to role"
 )
 
 // RoleClient is the external client used for Role Custom Resource
@@ -36,6 +44,13 @@ type RoleClient interface {
 	UpdateAssumeRolePolicy(ctx context.Context, input *iam.UpdateAssumeRolePolicyInput, opts ...func(*iam.Options)) (*iam.UpdateAssumeRolePolicyOutput, error)
 	TagRole(ctx context.Context, input *iam.TagRoleInput, opts ...func(*iam.Options)) (*iam.TagRoleOutput, error)
 	UntagRole(ctx context.Context, input *iam.UntagRoleInput, opts ...func(*iam.Options)) (*iam.UntagRoleOutput, error)
+	ListRolePolicies(ctx context.Context, input *iam.ListRolePoliciesInput, opts ...func(*iam.Options)) (*iam.ListRolePoliciesOutput, error)
+	GetRolePolicy(ctx context.Context, input *iam.GetRolePolicyInput, opts ...func(*iam.Options)) (*iam.GetRolePolicyOutput, error)
+	PutRolePolicy(ctx context.Context, input *iam.PutRolePolicyInput, opts ...func(*iam.Options)) (*iam.PutRolePolicyOutput, error)
+	DeleteRolePolicy(ctx context.Context, input *iam.DeleteRolePolicyInput, opts ...func(*iam.Options)) (*iam.DeleteRolePolicyOutput, error)
+	ListAttachedRolePolicies(ctx context.Context, input *iam.ListAttachedRolePoliciesInput, opts ...func(*iam.Options)) (*iam.ListAttachedRolePoliciesOutput, error)
+	AttachRolePolicy(ctx context.Context, input *iam.AttachRolePolicyInput, opts ...func(*iam.Options)) (*iam.AttachRolePolicyOutput, error)
+	DetachRolePolicy(ctx context.Context, input *iam.DetachRolePolicyInput, opts ...func(*iam.Options)) (*iam.DetachRolePolicyOutput, error)
 }
 
 // NewRoleClient returns a new client using AWS credentials as JSON encoded data.
@@ -43,11 +58,34 @@ func NewRoleClient(conf aws.Config) RoleClient {
 	return iam.NewFromConfig(conf)
 }
 
+// GetAssumeRolePolicyDocument returns the JSON trust policy document of the
+// role which is either given as raw document or as structured policy.
+func GetAssumeRolePolicyDocument(p v1beta1.RoleParameters) (string, error) {
+	doc, err := policyutils.ConvertResourcePolicyOrRaw(p.AssumeRolePolicy, awsclients.String(p.AssumeRolePolicyDocument))
+	return aws.ToString(doc), err
+}
+
+// ValidateAssumeRolePolicyDocument checks the given trust policy document for
+// mistakes that would make IAM reject it.
+func ValidateAssumeRolePolicyDocument(document string) error {
+	return policyutils.ValidatePolicyString(document, policyutils.WithResourcePolicy())
+}
+
+// ValidateInlinePolicyDocument checks the given inline policy document for
+// mistakes that would make IAM reject it.
+func ValidateInlinePolicyDocument(document string) error {
+	return policyutils.ValidatePolicyString(document, policyutils.WithIdentityPolicy())
+}
+
 // GenerateCreateRoleInput from RoleSpec
-func GenerateCreateRoleInput(name string, p *v1beta1.RoleParameters) *iam.CreateRoleInput {
+func GenerateCreateRoleInput(name string, p *v1beta1.RoleParameters) (*iam.CreateRoleInput, error) {
+	doc, err := GetAssumeRolePolicyDocument(*p)
+	if err != nil {
+		return nil, err
+	}
 	m := &iam.CreateRoleInput{
 		RoleName:                 aws.String(name),
-		AssumeRolePolicyDocument: aws.String(p.AssumeRolePolicyDocument),
+		AssumeRolePolicyDocument: aws.String(doc),
 		Description:              p.Description,
 		MaxSessionDuration:       p.MaxSessionDuration,
 		Path:                     p.Path,
@@ -64,7 +102,7 @@ func GenerateCreateRoleInput(name string, p *v1beta1.RoleParameters) *iam.Create
 		}
 	}
 
-	return m
+	return m, nil
 }
 
 // GenerateRoleObservation is used to produce RoleExternalStatus from iamtypes.Role
@@ -78,8 +116,12 @@ func GenerateRoleObservation(role iamtypes.Role) v1beta1.RoleExternalStatus {
 // GenerateRole assigns the in RoleParamters to role.
 func GenerateRole(in v1beta1.RoleParameters, role *iamtypes.Role) error {
 
-	if in.AssumeRolePolicyDocument != "" {
-		s, err := awsclients.CompactAndEscapeJSON(in.AssumeRolePolicyDocument)
+	doc, err := GetAssumeRolePolicyDocument(in)
+	if err != nil {
+		return err
+	}
+	if doc != "" {
+		s, err := awsclients.CompactAndEscapeJSON(doc)
 		if err != nil {
 			return errors.Wrap(err, errPolicyJSONEscape)
 		}
@@ -113,7 +155,9 @@ func LateInitializeRole(in *v1beta1.RoleParameters, role *iamtypes.Role) {
 	if role == nil {
 		return
 	}
-	in.AssumeRolePolicyDocument = awsclients.LateInitializeString(in.AssumeRolePolicyDocument, role.AssumeRolePolicyDocument)
+	if in.AssumeRolePolicy == nil {
+		in.AssumeRolePolicyDocument = awsclients.LateInitializeString(in.AssumeRolePolicyDocument, role.AssumeRolePolicyDocument)
+	}
 	in.Description = awsclients.LateInitializeStringPtr(in.Description, role.Description)
 	in.MaxSessionDuration = awsclients.LateInitializeInt32Ptr(in.MaxSessionDuration, role.MaxSessionDuration)
 	in.Path = awsclients.LateInitializeStringPtr(in.Path, role.Path)
@@ -162,7 +206,22 @@ func isAssumeRolePolicyUpToDate(a, b *string) (bool, error) {
 		return false, errors.Wrap(err, errPolicyJSONUnescape)
 	}
 
-	return awsclients.IsPolicyUpToDate(&jsonA, &jsonB), nil
+	if awsclients.IsPolicyUpToDate(&jsonA, &jsonB) {
+		return true, nil
+	}
+
+	// Documents generated from a structured policy always use arrays for
+	// values that may have been returned as single strings by AWS.
+	policyA, err := policyutils.ParsePolicyString(jsonA)
+	if err != nil {
+		return false, nil //nolint:nilerr
+	}
+	policyB, err := policyutils.ParsePolicyString(jsonB)
+	if err != nil {
+		return false, nil //nolint:nilerr
+	}
+	equal, _ := policyutils.ArePoliciesEqal(&policyA, &policyB)
+	return equal, nil
 }
 
 // IsRoleUpToDate checks whether there is a change in any of the modifiable fields in role.
@@ -211,3 +270,116 @@ func lessTag(a, b iamtypes.Tag) bool {
 	}
 	return *a.Key <= *b.Key
 }
+
+// GetRoleInlinePolicies returns the documents of all inline policies embedded
+// in the given role keyed by their names.
+func GetRoleInlinePolicies(ctx context.Context, client RoleClient, roleName string) (map[string]string, error) {
+	policies := map[string]string{}
+	input := &iam.ListRolePoliciesInput{RoleName: aws.String(roleName)}
+	for {
+		out, err := client.ListRolePolicies(ctx, input)
+		if err != nil {
+			return nil, awsclients.Wrap(err, errListRolePolicies)
+		}
+		for _, name := range out.PolicyNames {
+			p, err := client.GetRolePolicy(ctx, &iam.GetRolePolicyInput{
+				RoleName:   aws.String(roleName),
+				PolicyName: aws.String(name),
+			})
+			if err != nil {
+				return nil, awsclients.Wrap(err, errGetRolePolicy)
+			}
+			doc, err := url.QueryUnescape(aws.ToString(p.PolicyDocument))
+			if err != nil {
+				return nil, errors.Wrap(err, errInlinePolicyUnescape)
+			}
+			policies[name] = doc
+		}
+		if !out.IsTruncated {
+			return policies, nil
+		}
+		input.Marker = out.Marker
+	}
+}
+
+// GetRoleManagedPolicyARNs returns the ARNs of all managed policies attached
+// to the given role.
+func GetRoleManagedPolicyARNs(ctx context.Context, client RoleClient, roleName string) ([]string, error) {
+	var arns []string
+	input := &iam.ListAttachedRolePoliciesInput{RoleName: aws.String(roleName)}
+	for {
+		out, err := client.ListAttachedRolePolicies(ctx, input)
+		if err != nil {
+			return nil, awsclients.Wrap(err, errListAttachedRolePolicies)
+		}
+		for _, p := range out.AttachedPolicies {
+			arns = append(arns, aws.ToString(p.PolicyArn))
+		}
+		if !out.IsTruncated {
+			return arns, nil
+		}
+		input.Marker = out.Marker
+	}
+}
+
+// DiffRoleInlinePolicies returns the desired inline policies that are
+// missing or differ semantically from the observed ones, and the sorted
+// names of the observed inline policies that are not desired.
+func DiffRoleInlinePolicies(desired, observed map[string]string) (map[string]string, []string, error) {
+	put := map[string]string{}
+	for name, doc := range desired {
+		if cur, ok := observed[name]; ok {
+			equal, err := arePolicyDocumentsEqual(doc, cur)
+			if err != nil {
+				return nil, nil, err
+			}
+			if equal {
+				continue
+			}
+		}
+		put[name] = doc
+	}
+	var remove []string
+	for name := range observed {
+		if _, ok := desired[name]; !ok {
+			remove = append(remove, name)
+		}
+	}
+	sort.Strings(remove)
+	return put, remove, nil
+}
+
+// DiffRoleManagedPolicies returns the desired managed policy ARNs that are not
+// attached yet and the attached ones that are not desired.
+func DiffRoleManagedPolicies(desired, observed []string) (attach, detach []string) {
+	attached := make(map[string]struct{}, len(observed))
+	for _, arn := range observed {
+		attached[arn] = struct{}{}
+	}
+	wanted := make(map[string]struct{}, len(desired))
+	for _, arn := range desired {
+		wanted[arn] = struct{}{}
+		if _, ok := attached[arn]; !ok {
+			attach = append(attach, arn)
+		}
+	}
+	for _, arn := range observed {
+		if _, ok := wanted[arn]; !ok {
+			detach = append(detach, arn)
+		}
+	}
+	return attach, detach
+}
+
+func arePolicyDocumentsEqual(a, b string) (bool, error) {
+	pa, err := policyutils.ParsePolicyString(a)
+	if err != nil {
+		return false, errors.Wrap(err, errInlinePolicyParse)
+	}
+	pb, err := policyutils.ParsePolicyString(b)
+	if err != nil {
+		return false, errors.Wrap(err, errInlinePolicyParse)
+	}
+	equal, _ := policyutils.ArePoliciesEqal(&pa, &pb)
+	return equal, nil
+}
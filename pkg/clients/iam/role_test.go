@@ -11,6 +11,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 
+	"github.com/crossplane-contrib/provider-aws/apis/common"
 	"github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
 	aws "github.com/crossplane-contrib/provider-aws/pkg/clients"
 )
@@ -123,7 +124,10 @@ func TestGenerateCreateRoleInput(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			r := GenerateCreateRoleInput(roleName, &tc.in)
+			r, err := GenerateCreateRoleInput(roleName, &tc.in)
+			if err != nil {
+				t.Errorf("r: unexpected error: %v", err)
+			}
 			if diff := cmp.Diff(r, &tc.out, cmpopts.IgnoreTypes(document.NoSerde{})); diff != "" {
 				t.Errorf("GenerateNetworkObservation(...): -want, +got:\n%s", diff)
 			}
@@ -270,6 +274,29 @@ func TestIsRoleUpToDate(t *testing.T) {
 			want:     true,
 			wantDiff: "",
 		},
+		"SameStructuredPolicy": {
+			args: args{
+				role: iamtypes.Role{
+					AssumeRolePolicyDocument: escapedPolicyJSON(),
+					Description:              &description,
+					MaxSessionDuration:       aws.Int32(1),
+				},
+				p: v1beta1.RoleParameters{
+					Description: &description,
+					AssumeRolePolicy: &common.ResourcePolicy{
+						Version: "2012-10-17",
+						Statements: []common.ResourcePolicyStatement{{
+							Effect:    "Allow",
+							Principal: &common.ResourcePrincipal{Service: []string{"eks.amazonaws.com"}},
+							Action:    []string{"sts:AssumeRole"},
+						}},
+					},
+					MaxSessionDuration: aws.Int32(1),
+				},
+			},
+			want:     true,
+			wantDiff: "",
+		},
 		"DifferentPolicy": {
 			args: args{
 				role: iamtypes.Role{
@@ -342,3 +369,99 @@ observed assume role policy: %7B%22Version%22%3A%222012-10-17%22%2C%22Statement%
 		})
 	}
 }
+
+func TestDiffRoleInlinePolicies(t *testing.T) {
+	type want struct {
+		put    map[string]string
+		remove []string
+	}
+
+	cases := map[string]struct {
+		desired  map[string]string
+		observed map[string]string
+		want     want
+	}{
+		"UpToDate": {
+			desired:  map[string]string{"assume": assumeRolePolicyDocument},
+			observed: map[string]string{"assume": `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"eks.amazonaws.com"},"Action":"sts:AssumeRole"}]}`},
+			want: want{
+				put: map[string]string{},
+			},
+		},
+		"Changed": {
+			desired:  map[string]string{"assume": assumeRolePolicyDocument2},
+			observed: map[string]string{"assume": assumeRolePolicyDocument},
+			want: want{
+				put: map[string]string{"assume": assumeRolePolicyDocument2},
+			},
+		},
+		"AddedAndRemoved": {
+			desired:  map[string]string{"new": assumeRolePolicyDocument},
+			observed: map[string]string{"old": assumeRolePolicyDocument, "older": assumeRolePolicyDocument},
+			want: want{
+				put:    map[string]string{"new": assumeRolePolicyDocument},
+				remove: []string{"old", "older"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			put, remove, err := DiffRoleInlinePolicies(tc.desired, tc.observed)
+			if err != nil {
+				t.Errorf("r: unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want.put, put); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffRoleManagedPolicies(t *testing.T) {
+	type want struct {
+		attach []string
+		detach []string
+	}
+
+	cases := map[string]struct {
+		desired  []string
+		observed []string
+		want     want
+	}{
+		"UpToDate": {
+			desired:  []string{"arn:a", "arn:b"},
+			observed: []string{"arn:b", "arn:a"},
+		},
+		"AttachAndDetach": {
+			desired:  []string{"arn:a", "arn:b"},
+			observed: []string{"arn:b", "arn:c"},
+			want: want{
+				attach: []string{"arn:a"},
+				detach: []string{"arn:c"},
+			},
+		},
+		"DetachAll": {
+			desired:  []string{},
+			observed: []string{"arn:a"},
+			want: want{
+				detach: []string{"arn:a"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			attach, detach := DiffRoleManagedPolicies(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want.attach, attach); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.detach, detach); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+)
+
+// SigningCertificateClient is the external client used for SigningCertificate
+// Custom Resource
+type SigningCertificateClient interface {
+	ListSigningCertificates(ctx context.Context, input *iam.ListSigningCertificatesInput, opts ...func(*iam.Options)) (*iam.ListSigningCertificatesOutput, error)
+	UploadSigningCertificate(ctx context.Context, input *iam.UploadSigningCertificateInput, opts ...func(*iam.Options)) (*iam.UploadSigningCertificateOutput, error)
+	UpdateSigningCertificate(ctx context.Context, input *iam.UpdateSigningCertificateInput, opts ...func(*iam.Options)) (*iam.UpdateSigningCertificateOutput, error)
+	DeleteSigningCertificate(ctx context.Context, input *iam.DeleteSigningCertificateInput, opts ...func(*iam.Options)) (*iam.DeleteSigningCertificateOutput, error)
+}
+
+// NewSigningCertificateClient returns a new client using AWS credentials as JSON encoded data.
+func NewSigningCertificateClient(conf aws.Config) SigningCertificateClient {
+	return iam.NewFromConfig(conf)
+}
@@ -0,0 +1,38 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+)
+
+// SSHPublicKeyClient is the external client used for SSHPublicKey Custom Resource
+type SSHPublicKeyClient interface {
+	GetSSHPublicKey(ctx context.Context, input *iam.GetSSHPublicKeyInput, opts ...func(*iam.Options)) (*iam.GetSSHPublicKeyOutput, error)
+	ListSSHPublicKeys(ctx context.Context, input *iam.ListSSHPublicKeysInput, opts ...func(*iam.Options)) (*iam.ListSSHPublicKeysOutput, error)
+	UploadSSHPublicKey(ctx context.Context, input *iam.UploadSSHPublicKeyInput, opts ...func(*iam.Options)) (*iam.UploadSSHPublicKeyOutput, error)
+	UpdateSSHPublicKey(ctx context.Context, input *iam.UpdateSSHPublicKeyInput, opts ...func(*iam.Options)) (*iam.UpdateSSHPublicKeyOutput, error)
+	DeleteSSHPublicKey(ctx context.Context, input *iam.DeleteSSHPublicKeyInput, opts ...func(*iam.Options)) (*iam.DeleteSSHPublicKeyOutput, error)
+}
+
+// NewSSHPublicKeyClient returns a new client using AWS credentials as JSON encoded data.
+func NewSSHPublicKeyClient(conf aws.Config) SSHPublicKeyClient {
+	return iam.NewFromConfig(conf)
+}
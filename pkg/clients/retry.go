@@ -0,0 +1,359 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	clientv1 "github.com/aws/aws-sdk-go/aws/client"
+	requestv1 "github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/smithy-go/middleware"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	"golang.org/x/time/rate"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	"github.com/crossplane-contrib/provider-aws/apis/v1beta1"
+)
+
+const errWaitRateLimit = "cannot wait for client-side rate limit"
+
+// ReasonThrottled is the reason of the Synced condition of resources that
+// could not be reconciled because the AWS API throttled their requests.
+const ReasonThrottled xpv1.ConditionReason = "Throttled"
+
+// throttledReconcileTTL is the time after which a throttled reconcile is
+// forgotten if its status was never written.
+const throttledReconcileTTL = 10 * time.Minute
+
+// throttledReconciles records the reconciles whose requests to the AWS API
+// were throttled, keyed by their controller-runtime reconcile ID. It allows
+// the status writer to tell throttling apart from other reconcile errors
+// without the AWS clients touching the managed resource being reconciled.
+var throttledReconciles = newReconcileRecorder(controller.ReconcileIDFromContext)
+
+type reconcileRecorder struct {
+	mu          sync.Mutex
+	entries     map[types.UID]time.Time
+	reconcileID func(ctx context.Context) types.UID
+}
+
+func newReconcileRecorder(reconcileID func(ctx context.Context) types.UID) *reconcileRecorder {
+	return &reconcileRecorder{entries: map[types.UID]time.Time{}, reconcileID: reconcileID}
+}
+
+// Record records the reconcile of the supplied context, if any.
+func (r *reconcileRecorder) Record(ctx context.Context) {
+	id := r.reconcileID(ctx)
+	if id == "" {
+		return
+	}
+	now := time.Now()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for k, t := range r.entries {
+		if now.Sub(t) > throttledReconcileTTL {
+			delete(r.entries, k)
+		}
+	}
+	r.entries[id] = now
+}
+
+// Pop returns whether the reconcile of the supplied context was recorded, and
+// forgets it.
+func (r *reconcileRecorder) Pop(ctx context.Context) bool {
+	id := r.reconcileID(ctx)
+	if id == "" {
+		return false
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	_, ok := r.entries[id]
+	delete(r.entries, id)
+	return ok
+}
+
+// recordThrottled is an AWS SDK v2 middleware that records the reconcile a
+// request is made in if the final result of the request, i.e. after all
+// retries were exhausted, is a throttling error.
+var recordThrottled = middleware.InitializeMiddlewareFunc("recordThrottled", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+	out, md, err := next.HandleInitialize(ctx, in)
+	if IsErrorThrottled(err) {
+		throttledReconciles.Record(ctx)
+	}
+	return out, md, err
+})
+
+// recordThrottledV1 is the AWS SDK v1 equivalent of recordThrottled.
+var recordThrottledV1 = requestv1.NamedHandler{
+	Name: "crossplane.RecordThrottledHandler",
+	Fn: func(r *requestv1.Request) {
+		if IsErrorThrottled(r.Error) {
+			throttledReconciles.Record(r.Context())
+		}
+	},
+}
+
+// setThrottledReason sets the reason of the Synced condition of the supplied
+// object to ReasonThrottled if it reports a reconcile error.
+func setThrottledReason(obj client.Object) {
+	o, ok := obj.(resource.Conditioned)
+	if !ok {
+		return
+	}
+	c := o.GetCondition(xpv1.TypeSynced)
+	if c.Status != corev1.ConditionFalse || c.Reason != xpv1.ReasonReconcileError {
+		return
+	}
+	c.Reason = ReasonThrottled
+	o.SetConditions(c)
+}
+
+// NewThrottledReasonClient returns a client that reports throttling by the
+// AWS API as the reason of the Synced condition when writing the status of
+// managed resources. All other calls are passed to the supplied client.
+func NewThrottledReasonClient(c client.Client) client.Client {
+	return &throttledReasonClient{Client: c, throttled: throttledReconciles}
+}
+
+type throttledReasonClient struct {
+	client.Client
+	throttled *reconcileRecorder
+}
+
+func (c *throttledReasonClient) Status() client.SubResourceWriter {
+	return &throttledReasonStatusWriter{SubResourceWriter: c.Client.Status(), throttled: c.throttled}
+}
+
+type throttledReasonStatusWriter struct {
+	client.SubResourceWriter
+	throttled *reconcileRecorder
+}
+
+func (w *throttledReasonStatusWriter) Update(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+	if w.throttled.Pop(ctx) {
+		setThrottledReason(obj)
+	}
+	return w.SubResourceWriter.Update(ctx, obj, opts...)
+}
+
+func (w *throttledReasonStatusWriter) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+	if w.throttled.Pop(ctx) {
+		setThrottledReason(obj)
+	}
+	return w.SubResourceWriter.Patch(ctx, obj, patch, opts...)
+}
+
+// SetRetryer configures the retry mode, attempts and backoff of the supplied
+// AWS SDK v2 config as well as the client-side rate limits of the services
+// according to the supplied ProviderConfig.
+func SetRetryer(pc *v1beta1.ProviderConfig, cfg *aws.Config) *aws.Config {
+	if r := newRetryer(pc); r != nil {
+		cfg.Retryer = r
+	}
+	if o := rateLimitAPIOption(pc); o != nil {
+		cfg.APIOptions = append(cfg.APIOptions, o)
+	}
+	return cfg
+}
+
+// stsRetryOptions returns the options of STS clients that are used to
+// retrieve credentials, so that they are retried and rate limited according
+// to the supplied ProviderConfig just like the clients that use them.
+func stsRetryOptions(pc *v1beta1.ProviderConfig) func(*sts.Options) {
+	return func(o *sts.Options) {
+		if r := newRetryer(pc); r != nil {
+			o.Retryer = r()
+		}
+		if opt := rateLimitAPIOption(pc); opt != nil {
+			o.APIOptions = append(o.APIOptions, opt)
+		}
+	}
+}
+
+// newRetryer returns a function that creates AWS SDK v2 retryers configured
+// according to the supplied ProviderConfig, or nil if it does not configure
+// retries.
+func newRetryer(pc *v1beta1.ProviderConfig) func() aws.Retryer {
+	rc := pc.Spec.Retry
+	if rc == nil {
+		return nil
+	}
+	standard := func(o *retry.StandardOptions) {
+		if rc.MaxAttempts != nil {
+			o.MaxAttempts = *rc.MaxAttempts
+		}
+		if rc.MaxBackoff != nil {
+			o.MaxBackoff = rc.MaxBackoff.Duration
+		}
+	}
+	if rc.Mode != nil && *rc.Mode == v1beta1.RetryModeAdaptive {
+		return func() aws.Retryer {
+			return retry.NewAdaptiveMode(func(o *retry.AdaptiveModeOptions) {
+				o.StandardOptions = append(o.StandardOptions, standard)
+			})
+		}
+	}
+	return func() aws.Retryer {
+		return retry.NewStandard(standard)
+	}
+}
+
+// rateLimitAPIOption returns an AWS SDK v2 API option that applies the
+// client-side rate limits of the supplied ProviderConfig, or nil if it does
+// not configure any.
+func rateLimitAPIOption(pc *v1beta1.ProviderConfig) func(*middleware.Stack) error {
+	if pc.Spec.Retry == nil || len(pc.Spec.Retry.RateLimits) == 0 {
+		return nil
+	}
+	return func(s *middleware.Stack) error {
+		return s.Finalize.Add(waitForRateLimit(pc), middleware.After)
+	}
+}
+
+// SetRetryerV1 is the AWS SDK v1 equivalent of SetRetryer. AWS SDK v1 does
+// not support the adaptive retry mode, so the standard one is always used.
+func SetRetryerV1(pc *v1beta1.ProviderConfig, sess *session.Session) *session.Session {
+	rc := pc.Spec.Retry
+	if rc == nil {
+		return sess
+	}
+	r := clientv1.DefaultRetryer{NumMaxRetries: clientv1.DefaultRetryerMaxNumRetries}
+	if rc.MaxAttempts != nil {
+		r.NumMaxRetries = *rc.MaxAttempts - 1
+	}
+	if rc.MaxBackoff != nil {
+		r.MaxRetryDelay = rc.MaxBackoff.Duration
+		r.MaxThrottleDelay = rc.MaxBackoff.Duration
+	}
+	sess.Config = requestv1.WithRetryer(sess.Config, r)
+	if len(rc.RateLimits) > 0 {
+		sess.Handlers.Send.PushFront(func(r *requestv1.Request) {
+			l := rateLimiters.Get(pc, r.ClientInfo.ServiceID)
+			if l == nil {
+				return
+			}
+			if err := l.Wait(r.Context()); err != nil {
+				r.Error = errors.Wrap(err, errWaitRateLimit)
+			}
+		})
+	}
+	return sess
+}
+
+// waitForRateLimit returns a middleware that blocks requests until the
+// client-side rate limit of their service permits them.
+func waitForRateLimit(pc *v1beta1.ProviderConfig) middleware.FinalizeMiddleware {
+	return middleware.FinalizeMiddlewareFunc("waitForRateLimit", func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+		if l := rateLimiters.Get(pc, awsmiddleware.GetServiceID(ctx)); l != nil {
+			if err := l.Wait(ctx); err != nil {
+				return middleware.FinalizeOutput{}, middleware.Metadata{}, errors.Wrap(err, errWaitRateLimit)
+			}
+		}
+		return next.HandleFinalize(ctx, in)
+	})
+}
+
+// rateLimiters is shared by all controllers so that the client-side rate
+// limits apply to all managed resources referencing the same ProviderConfig.
+var rateLimiters = NewServiceRateLimiters()
+
+// ServiceRateLimiters holds the client-side rate limiters of AWS services per
+// ProviderConfig. It is safe for concurrent use.
+type ServiceRateLimiters struct {
+	mu       sync.Mutex
+	limiters map[serviceRateLimiterKey]*rate.Limiter
+}
+
+// serviceRateLimiterKey includes the UID of the ProviderConfig so that a
+// ProviderConfig that is recreated with the same name starts with fresh
+// limiters.
+type serviceRateLimiterKey struct {
+	providerConfig string
+	uid            types.UID
+	service        string
+}
+
+// NewServiceRateLimiters returns an empty ServiceRateLimiters.
+func NewServiceRateLimiters() *ServiceRateLimiters {
+	return &ServiceRateLimiters{limiters: map[serviceRateLimiterKey]*rate.Limiter{}}
+}
+
+// Get returns the rate limiter of the supplied service for the supplied
+// ProviderConfig, or nil if the service is not rate limited. Service IDs are
+// matched case-insensitively. Existing limiters are updated in place when
+// their limits change.
+func (l *ServiceRateLimiters) Get(pc *v1beta1.ProviderConfig, service string) *rate.Limiter {
+	if pc.Spec.Retry == nil {
+		return nil
+	}
+	var limit *v1beta1.ServiceRateLimit
+	for i, rl := range pc.Spec.Retry.RateLimits {
+		if strings.EqualFold(rl.Service, service) {
+			limit = &pc.Spec.Retry.RateLimits[i]
+			break
+		}
+	}
+	if limit == nil {
+		return nil
+	}
+	r := rate.Limit(limit.RequestsPerSecond)
+	burst := limit.RequestsPerSecond
+	if limit.Burst != nil {
+		burst = *limit.Burst
+	}
+
+	k := serviceRateLimiterKey{providerConfig: pc.GetName(), uid: pc.GetUID(), service: strings.ToLower(service)}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	rl, ok := l.limiters[k]
+	if !ok {
+		rl = rate.NewLimiter(r, burst)
+		l.limiters[k] = rl
+		return rl
+	}
+	if rl.Limit() != r {
+		rl.SetLimit(r)
+	}
+	if rl.Burst() != burst {
+		rl.SetBurst(burst)
+	}
+	return rl
+}
+
+// Evict removes all rate limiters of the supplied ProviderConfig.
+func (l *ServiceRateLimiters) Evict(providerConfig string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for k := range l.limiters {
+		if k.providerConfig == providerConfig {
+			delete(l.limiters, k)
+		}
+	}
+}
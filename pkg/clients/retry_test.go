@@ -0,0 +1,272 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"golang.org/x/time/rate"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-aws/apis/v1beta1"
+)
+
+func TestSetRetryer(t *testing.T) {
+	adaptive := v1beta1.RetryModeAdaptive
+	type want struct {
+		adaptive    bool
+		maxAttempts int
+	}
+	cases := map[string]struct {
+		retry *v1beta1.RetryConfig
+		want  *want
+	}{
+		"NoRetryConfig": {},
+		"Standard": {
+			retry: &v1beta1.RetryConfig{
+				MaxAttempts: aws.Int(10),
+				MaxBackoff:  &metav1.Duration{Duration: time.Minute},
+			},
+			want: &want{maxAttempts: 10},
+		},
+		"Adaptive": {
+			retry: &v1beta1.RetryConfig{
+				MaxAttempts: aws.Int(5),
+				Mode:        &adaptive,
+			},
+			want: &want{adaptive: true, maxAttempts: 5},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			pc := &v1beta1.ProviderConfig{Spec: v1beta1.ProviderConfigSpec{Retry: tc.retry}}
+			cfg := SetRetryer(pc, &aws.Config{})
+			if tc.want == nil {
+				if cfg.Retryer != nil {
+					t.Errorf("SetRetryer(...): expected no retryer to be configured")
+				}
+				return
+			}
+			r := cfg.Retryer()
+			_, isAdaptive := r.(*retry.AdaptiveMode)
+			if diff := cmp.Diff(tc.want.adaptive, isAdaptive); diff != "" {
+				t.Errorf("SetRetryer(...): -want adaptive, +got adaptive:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.maxAttempts, r.MaxAttempts()); diff != "" {
+				t.Errorf("SetRetryer(...): -want max attempts, +got max attempts:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestServiceRateLimitersGet(t *testing.T) {
+	pc := &v1beta1.ProviderConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "pc", UID: "uid"},
+		Spec: v1beta1.ProviderConfigSpec{Retry: &v1beta1.RetryConfig{
+			RateLimits: []v1beta1.ServiceRateLimit{
+				{Service: "EC2", RequestsPerSecond: 10},
+				{Service: "Route 53", RequestsPerSecond: 5, Burst: aws.Int(1)},
+			},
+		}},
+	}
+	type want struct {
+		limited bool
+		limit   rate.Limit
+		burst   int
+	}
+	cases := map[string]struct {
+		service string
+		want    want
+	}{
+		"NotLimited": {
+			service: "IAM",
+			want:    want{},
+		},
+		"DefaultBurst": {
+			service: "EC2",
+			want:    want{limited: true, limit: 10, burst: 10},
+		},
+		"CaseInsensitive": {
+			service: "route 53",
+			want:    want{limited: true, limit: 5, burst: 1},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			l := NewServiceRateLimiters().Get(pc, tc.service)
+			got := want{}
+			if l != nil {
+				got = want{limited: true, limit: l.Limit(), burst: l.Burst()}
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("Get(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func rateLimitedProviderConfig(uid types.UID, rps int) *v1beta1.ProviderConfig {
+	return &v1beta1.ProviderConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "pc", UID: uid},
+		Spec: v1beta1.ProviderConfigSpec{Retry: &v1beta1.RetryConfig{
+			RateLimits: []v1beta1.ServiceRateLimit{{Service: "EC2", RequestsPerSecond: rps}},
+		}},
+	}
+}
+
+func TestServiceRateLimitersGetUpdatesLimits(t *testing.T) {
+	l := NewServiceRateLimiters()
+	first := l.Get(rateLimitedProviderConfig("uid", 10), "EC2")
+	second := l.Get(rateLimitedProviderConfig("uid", 20), "EC2")
+	if first != second {
+		t.Errorf("Get(...): expected the existing limiter to be reused")
+	}
+	if diff := cmp.Diff(rate.Limit(20), second.Limit()); diff != "" {
+		t.Errorf("Get(...): -want limit, +got limit:\n%s", diff)
+	}
+}
+
+func TestServiceRateLimitersGetRecreatedProviderConfig(t *testing.T) {
+	l := NewServiceRateLimiters()
+	first := l.Get(rateLimitedProviderConfig("uid", 10), "EC2")
+	second := l.Get(rateLimitedProviderConfig("other-uid", 10), "EC2")
+	if first == second {
+		t.Errorf("Get(...): expected a new limiter for a recreated ProviderConfig")
+	}
+}
+
+func TestServiceRateLimitersEvict(t *testing.T) {
+	l := NewServiceRateLimiters()
+	l.Get(rateLimitedProviderConfig("uid", 10), "EC2")
+	l.Get(rateLimitedProviderConfig("other-uid", 10), "EC2")
+	l.Evict("pc")
+	if diff := cmp.Diff(0, len(l.limiters)); diff != "" {
+		t.Errorf("Evict(...): -want limiters, +got limiters:\n%s", diff)
+	}
+}
+
+type reconcileIDKey struct{}
+
+func withReconcileID(id types.UID) context.Context {
+	return context.WithValue(context.Background(), reconcileIDKey{}, id)
+}
+
+func testReconcileID(ctx context.Context) types.UID {
+	id, _ := ctx.Value(reconcileIDKey{}).(types.UID)
+	return id
+}
+
+func TestReconcileRecorder(t *testing.T) {
+	cases := map[string]struct {
+		record context.Context
+		pop    context.Context
+		want   bool
+	}{
+		"Recorded": {
+			record: withReconcileID("a"),
+			pop:    withReconcileID("a"),
+			want:   true,
+		},
+		"OtherReconcile": {
+			record: withReconcileID("a"),
+			pop:    withReconcileID("b"),
+			want:   false,
+		},
+		"NoReconcileID": {
+			record: context.Background(),
+			pop:    context.Background(),
+			want:   false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := newReconcileRecorder(testReconcileID)
+			r.Record(tc.record)
+			if diff := cmp.Diff(tc.want, r.Pop(tc.pop)); diff != "" {
+				t.Errorf("Pop(...): -want, +got:\n%s", diff)
+			}
+			if r.Pop(tc.pop) {
+				t.Errorf("Pop(...): expected the reconcile to be forgotten")
+			}
+		})
+	}
+}
+
+func TestThrottledReasonStatusWriter(t *testing.T) {
+	reconcileErr := xpv1.ReconcileError(errors.New(errBoom))
+	throttledErr := reconcileErr
+	throttledErr.Reason = ReasonThrottled
+	cases := map[string]struct {
+		throttled bool
+		cond      xpv1.Condition
+		want      xpv1.Condition
+	}{
+		"Throttled": {
+			throttled: true,
+			cond:      reconcileErr,
+			want:      throttledErr,
+		},
+		"NotThrottled": {
+			cond: reconcileErr,
+			want: reconcileErr,
+		},
+		"ThrottledButSynced": {
+			throttled: true,
+			cond:      xpv1.ReconcileSuccess(),
+			want:      xpv1.ReconcileSuccess(),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx := withReconcileID("a")
+			r := newReconcileRecorder(testReconcileID)
+			if tc.throttled {
+				r.Record(ctx)
+			}
+			var got xpv1.Condition
+			c := &throttledReasonClient{
+				Client: &test.MockClient{
+					MockStatusUpdate: func(_ context.Context, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+						got = obj.(resource.Conditioned).GetCondition(xpv1.TypeSynced)
+						return nil
+					},
+				},
+				throttled: r,
+			}
+			mg := &fake.Managed{}
+			mg.SetConditions(tc.cond)
+			if err := c.Status().Update(ctx, mg); err != nil {
+				t.Fatalf("Update(...): %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got, test.EquateConditions()); diff != "" {
+				t.Errorf("Update(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"reflect"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	ststypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-aws/apis/v1beta1"
+)
+
+const (
+	errParseRoleSessionName  = "cannot parse role session name template"
+	errRenderRoleSessionName = "cannot render role session name template"
+)
+
+// maxRoleSessionNameLength is the maximum length of a role session name
+// accepted by STS.
+const maxRoleSessionNameLength = 64
+
+// invalidRoleSessionNameChars matches the characters that are not allowed in
+// role session names.
+var invalidRoleSessionNameChars = regexp.MustCompile(`[^\w+=,.@-]`)
+
+// RoleSessionNameData is the data role session name templates are rendered
+// with.
+type RoleSessionNameData struct {
+	// Kind of the managed resource the session is used for.
+	Kind string
+
+	// Name of the managed resource the session is used for.
+	Name string
+
+	// ProviderConfig the session is assumed for.
+	ProviderConfig string
+}
+
+type roleSessionNameDataKey struct{}
+
+// withRoleSessionNameData returns a copy of the supplied context that renders
+// role session names for the supplied managed resource, if any, and
+// ProviderConfig.
+func withRoleSessionNameData(ctx context.Context, mg resource.Managed, pc *v1beta1.ProviderConfig) context.Context {
+	d := RoleSessionNameData{ProviderConfig: pc.GetName()}
+	if mg != nil {
+		d.Name = mg.GetName()
+		d.Kind = mg.GetObjectKind().GroupVersionKind().Kind
+		if d.Kind == "" {
+			// Typed objects read from the API server usually lack their
+			// TypeMeta, but their Go type is named after their kind.
+			d.Kind = reflect.Indirect(reflect.ValueOf(mg)).Type().Name()
+		}
+	}
+	return context.WithValue(ctx, roleSessionNameDataKey{}, d)
+}
+
+// renderRoleSessionName renders the supplied role session name template with
+// the data of the supplied context and replaces characters that are not
+// allowed in role session names. An empty name is returned if the template
+// renders to less than two characters, in which case the AWS SDK generates
+// one.
+func renderRoleSessionName(ctx context.Context, tmpl string) (string, error) {
+	if tmpl == "" {
+		return "", nil
+	}
+	t, err := template.New("roleSessionName").Option("missingkey=zero").Parse(tmpl)
+	if err != nil {
+		return "", errors.Wrap(err, errParseRoleSessionName)
+	}
+	d, _ := ctx.Value(roleSessionNameDataKey{}).(RoleSessionNameData)
+	b := &strings.Builder{}
+	if err := t.Execute(b, d); err != nil {
+		return "", errors.Wrap(err, errRenderRoleSessionName)
+	}
+	name := invalidRoleSessionNameChars.ReplaceAllString(b.String(), "-")
+	if len(name) > maxRoleSessionNameLength {
+		name = name[:maxRoleSessionNameLength]
+	}
+	if len(name) < 2 {
+		return "", nil
+	}
+	return name, nil
+}
+
+// sessionCredentialsKind returns the kind the credentials of a role session
+// with the supplied name are cached as. Sessions with different names, e.g.
+// ones rendered for different managed resources, are cached separately.
+func sessionCredentialsKind(kind, sessionName string) string {
+	if sessionName == "" {
+		return kind
+	}
+	return kind + "/" + sessionName
+}
+
+// policyDescriptors converts the supplied policy ARNs to the policy
+// descriptors accepted by STS.
+func policyDescriptors(arns []string) []ststypes.PolicyDescriptorType {
+	if len(arns) == 0 {
+		return nil
+	}
+	d := make([]ststypes.PolicyDescriptorType, len(arns))
+	for i := range arns {
+		d[i] = ststypes.PolicyDescriptorType{Arn: &arns[i]}
+	}
+	return d
+}
+
+// withAssumeRoleSessionName sets the supplied role session name, if any.
+func withAssumeRoleSessionName(name string) func(*stscreds.AssumeRoleOptions) {
+	return func(opt *stscreds.AssumeRoleOptions) {
+		if name != "" {
+			opt.RoleSessionName = name
+		}
+	}
+}
+
+// withWebIdentityRoleSessionName sets the supplied role session name, if any.
+func withWebIdentityRoleSessionName(name string) func(*stscreds.WebIdentityRoleOptions) {
+	return func(opt *stscreds.WebIdentityRoleOptions) {
+		if name != "" {
+			opt.RoleSessionName = name
+		}
+	}
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-aws/apis/v1beta1"
+)
+
+func TestRenderRoleSessionName(t *testing.T) {
+	pc := &v1beta1.ProviderConfig{ObjectMeta: metav1.ObjectMeta{Name: "default"}}
+	mg := &fake.Managed{ObjectMeta: metav1.ObjectMeta{Name: "my-bucket"}}
+
+	type args struct {
+		ctx  context.Context
+		tmpl string
+	}
+	type want struct {
+		name string
+		err  error
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NoTemplate": {
+			args: args{ctx: withRoleSessionNameData(context.Background(), mg, pc)},
+			want: want{name: ""},
+		},
+		"Static": {
+			args: args{ctx: context.Background(), tmpl: "crossplane"},
+			want: want{name: "crossplane"},
+		},
+		"ManagedResource": {
+			args: args{ctx: withRoleSessionNameData(context.Background(), mg, pc), tmpl: "{{ .ProviderConfig }}@{{ .Kind }}-{{ .Name }}"},
+			want: want{name: "default@Managed-my-bucket"},
+		},
+		"NoManagedResource": {
+			args: args{ctx: withRoleSessionNameData(context.Background(), nil, pc), tmpl: "crossplane-{{ .ProviderConfig }}{{ .Name }}"},
+			want: want{name: "crossplane-default"},
+		},
+		"InvalidCharacters": {
+			args: args{ctx: context.Background(), tmpl: "crossplane/my bucket"},
+			want: want{name: "crossplane-my-bucket"},
+		},
+		"TooLong": {
+			args: args{ctx: context.Background(), tmpl: strings.Repeat("a", 70)},
+			want: want{name: strings.Repeat("a", 64)},
+		},
+		"TooShort": {
+			args: args{ctx: context.Background(), tmpl: "{{ .Name }}a"},
+			want: want{name: ""},
+		},
+		"InvalidTemplate": {
+			args: args{ctx: context.Background(), tmpl: "{{ .Name"},
+			want: want{err: errors.New(errParseRoleSessionName)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := renderRoleSessionName(tc.args.ctx, tc.args.tmpl)
+			if tc.want.err != nil {
+				if err == nil || !strings.HasPrefix(err.Error(), tc.want.err.Error()) {
+					t.Errorf("renderRoleSessionName(...): want error %q, got %v", tc.want.err, err)
+				}
+				return
+			}
+			if diff := cmp.Diff(nil, err, test.EquateErrors()); diff != "" {
+				t.Errorf("renderRoleSessionName(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.name, got); diff != "" {
+				t.Errorf("renderRoleSessionName(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/pkg/errors"
+)
+
+const (
+	errListMultipartUploads = "cannot list multipart uploads"
+	errAbortMultipartUpload = "cannot abort multipart upload"
+	errListObjectVersions   = "cannot list object versions"
+	errDeleteObjects        = "cannot delete objects"
+	errDeleteObjectFmt      = "cannot delete %d objects, e.g. version %s of %s: %s: %s"
+)
+
+// EmptyBucketResult is the progress made by EmptyBucket.
+type EmptyBucketResult struct {
+	// AbortedUploads is the number of multipart uploads that were aborted.
+	AbortedUploads int
+
+	// DeletedObjects is the number of object versions and delete markers
+	// that were deleted.
+	DeletedObjects int
+
+	// Empty is true if the bucket has no object versions or delete markers
+	// left.
+	Empty bool
+}
+
+// EmptyBucket aborts the in-progress multipart uploads of the supplied bucket
+// and deletes its object versions and delete markers, which also covers the
+// objects of buckets that were never versioned. At most maxBatches batches of
+// up to 1000 objects are deleted, so that large buckets are emptied over
+// multiple calls. Objects locked in governance mode are only deleted if
+// bypassGovernanceRetention is true, and objects locked in compliance mode or
+// under legal hold cannot be deleted at all.
+func EmptyBucket(ctx context.Context, client BucketClient, bucket string, bypassGovernanceRetention bool, maxBatches int) (EmptyBucketResult, error) {
+	res := EmptyBucketResult{}
+	aborted, err := abortMultipartUploads(ctx, client, bucket)
+	res.AbortedUploads = aborted
+	if err != nil {
+		return res, err
+	}
+
+	in := &s3.ListObjectVersionsInput{Bucket: aws.String(bucket)}
+	for i := 0; i < maxBatches; i++ {
+		out, err := client.ListObjectVersions(ctx, in)
+		if err != nil {
+			return res, errors.Wrap(err, errListObjectVersions)
+		}
+		objects := objectVersionIdentifiers(out)
+		if len(objects) > 0 {
+			del, err := client.DeleteObjects(ctx, &s3.DeleteObjectsInput{
+				Bucket:                    aws.String(bucket),
+				BypassGovernanceRetention: bypassGovernanceRetention,
+				Delete:                    &s3types.Delete{Objects: objects, Quiet: true},
+			})
+			if err != nil {
+				return res, errors.Wrap(err, errDeleteObjects)
+			}
+			res.DeletedObjects += len(objects) - len(del.Errors)
+			if len(del.Errors) > 0 {
+				e := del.Errors[0]
+				return res, errors.Errorf(errDeleteObjectFmt, len(del.Errors), aws.ToString(e.VersionId), aws.ToString(e.Key), aws.ToString(e.Code), aws.ToString(e.Message))
+			}
+		}
+		if !out.IsTruncated {
+			res.Empty = true
+			return res, nil
+		}
+		in.KeyMarker, in.VersionIdMarker = out.NextKeyMarker, out.NextVersionIdMarker
+	}
+	return res, nil
+}
+
+// objectVersionIdentifiers returns the identifiers of the object versions and
+// delete markers of the supplied page.
+func objectVersionIdentifiers(out *s3.ListObjectVersionsOutput) []s3types.ObjectIdentifier {
+	ids := make([]s3types.ObjectIdentifier, 0, len(out.Versions)+len(out.DeleteMarkers))
+	for _, v := range out.Versions {
+		ids = append(ids, s3types.ObjectIdentifier{Key: v.Key, VersionId: v.VersionId})
+	}
+	for _, m := range out.DeleteMarkers {
+		ids = append(ids, s3types.ObjectIdentifier{Key: m.Key, VersionId: m.VersionId})
+	}
+	return ids
+}
+
+// abortMultipartUploads aborts all in-progress multipart uploads of the
+// supplied bucket and returns how many were aborted.
+func abortMultipartUploads(ctx context.Context, client BucketClient, bucket string) (int, error) {
+	aborted := 0
+	in := &s3.ListMultipartUploadsInput{Bucket: aws.String(bucket)}
+	for {
+		out, err := client.ListMultipartUploads(ctx, in)
+		if err != nil {
+			return aborted, errors.Wrap(err, errListMultipartUploads)
+		}
+		for _, u := range out.Uploads {
+			if _, err := client.AbortMultipartUpload(ctx, &s3.AbortMultipartUploadInput{
+				Bucket:   aws.String(bucket),
+				Key:      u.Key,
+				UploadId: u.UploadId,
+			}); err != nil && !isNoSuchUpload(err) {
+				return aborted, errors.Wrap(err, errAbortMultipartUpload)
+			}
+			aborted++
+		}
+		if !out.IsTruncated {
+			return aborted, nil
+		}
+		in.KeyMarker, in.UploadIdMarker = out.NextKeyMarker, out.NextUploadIdMarker
+	}
+}
+
+// isNoSuchUpload returns true if the error is caused by a multipart upload
+// that was completed or aborted in the meantime.
+func isNoSuchUpload(err error) bool {
+	var noSuchUpload *s3types.NoSuchUpload
+	return errors.As(err, &noSuchUpload)
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+	"github.com/pkg/errors"
+)
+
+const (
+	// NoSuchKeyErrCode is the error code sent by AWS when an object does not exist
+	NoSuchKeyErrCode = "NoSuchKey"
+)
+
+// ObjectClient is the external client used for the S3 Object Custom Resource
+type ObjectClient interface {
+	HeadObject(ctx context.Context, input *s3.HeadObjectInput, opts ...func(*s3.Options)) (*s3.HeadObjectOutput, error)
+	PutObject(ctx context.Context, input *s3.PutObjectInput, opts ...func(*s3.Options)) (*s3.PutObjectOutput, error)
+	DeleteObject(ctx context.Context, input *s3.DeleteObjectInput, opts ...func(*s3.Options)) (*s3.DeleteObjectOutput, error)
+	DeleteObjects(ctx context.Context, input *s3.DeleteObjectsInput, opts ...func(*s3.Options)) (*s3.DeleteObjectsOutput, error)
+	ListObjectVersions(ctx context.Context, input *s3.ListObjectVersionsInput, opts ...func(*s3.Options)) (*s3.ListObjectVersionsOutput, error)
+	GetObjectTagging(ctx context.Context, input *s3.GetObjectTaggingInput, opts ...func(*s3.Options)) (*s3.GetObjectTaggingOutput, error)
+}
+
+// NewObjectClient returns a new client given an aws config
+func NewObjectClient(cfg aws.Config) ObjectClient {
+	return s3.NewFromConfig(cfg)
+}
+
+// IsObjectNotFound returns true if the error indicates that the object does
+// not exist. HeadObject responses carry no body, so a missing object is
+// reported as a plain NotFound there.
+func IsObjectNotFound(err error) bool {
+	if IsNotFound(err) {
+		return true
+	}
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == NoSuchKeyErrCode
+}
+
+// DeleteObjectVersions deletes every version and delete marker of the object
+// with the supplied key.
+func DeleteObjectVersions(ctx context.Context, client ObjectClient, bucket, key string) error {
+	in := &s3.ListObjectVersionsInput{Bucket: aws.String(bucket), Prefix: aws.String(key)}
+	for {
+		out, err := client.ListObjectVersions(ctx, in)
+		if err != nil {
+			return errors.Wrap(err, errListObjectVersions)
+		}
+		// Prefix also matches longer keys, which are not ours to delete.
+		objects := make([]s3types.ObjectIdentifier, 0, len(out.Versions)+len(out.DeleteMarkers))
+		for _, id := range objectVersionIdentifiers(out) {
+			if aws.ToString(id.Key) == key {
+				objects = append(objects, id)
+			}
+		}
+		if len(objects) > 0 {
+			del, err := client.DeleteObjects(ctx, &s3.DeleteObjectsInput{
+				Bucket: aws.String(bucket),
+				Delete: &s3types.Delete{Objects: objects, Quiet: true},
+			})
+			if err != nil {
+				return errors.Wrap(err, errDeleteObjects)
+			}
+			if len(del.Errors) > 0 {
+				e := del.Errors[0]
+				return errors.Errorf(errDeleteObjectFmt, len(del.Errors), aws.ToString(e.VersionId), aws.ToString(e.Key), aws.ToString(e.Code), aws.ToString(e.Message))
+			}
+		}
+		if !out.IsTruncated {
+			return nil
+		}
+		in.KeyMarker, in.VersionIdMarker = out.NextKeyMarker, out.NextVersionIdMarker
+	}
+}
@@ -30,6 +30,48 @@ type MockS3ControlClient struct {
 
 	DeleteAccessPointPolicyWithContextOutput s3control.DeleteAccessPointPolicyOutput
 	DeleteAccessPointPolicyWithContextErr    error
+
+	CreateMultiRegionAccessPointWithContextOutput s3control.CreateMultiRegionAccessPointOutput
+	CreateMultiRegionAccessPointWithContextErr    error
+
+	GetMultiRegionAccessPointWithContextOutput s3control.GetMultiRegionAccessPointOutput
+	GetMultiRegionAccessPointWithContextErr    error
+
+	DeleteMultiRegionAccessPointWithContextOutput s3control.DeleteMultiRegionAccessPointOutput
+	DeleteMultiRegionAccessPointWithContextErr    error
+
+	DescribeMultiRegionAccessPointOperationWithContextOutput s3control.DescribeMultiRegionAccessPointOperationOutput
+	DescribeMultiRegionAccessPointOperationWithContextErr    error
+
+	GetMultiRegionAccessPointPolicyWithContextOutput s3control.GetMultiRegionAccessPointPolicyOutput
+	GetMultiRegionAccessPointPolicyWithContextErr    error
+
+	PutMultiRegionAccessPointPolicyWithContextOutput s3control.PutMultiRegionAccessPointPolicyOutput
+	PutMultiRegionAccessPointPolicyWithContextErr    error
+
+	CreateAccessPointForObjectLambdaWithContextOutput s3control.CreateAccessPointForObjectLambdaOutput
+	CreateAccessPointForObjectLambdaWithContextErr    error
+
+	GetAccessPointForObjectLambdaWithContextOutput s3control.GetAccessPointForObjectLambdaOutput
+	GetAccessPointForObjectLambdaWithContextErr    error
+
+	GetAccessPointConfigurationForObjectLambdaWithContextOutput s3control.GetAccessPointConfigurationForObjectLambdaOutput
+	GetAccessPointConfigurationForObjectLambdaWithContextErr    error
+
+	PutAccessPointConfigurationForObjectLambdaWithContextOutput s3control.PutAccessPointConfigurationForObjectLambdaOutput
+	PutAccessPointConfigurationForObjectLambdaWithContextErr    error
+
+	DeleteAccessPointForObjectLambdaWithContextOutput s3control.DeleteAccessPointForObjectLambdaOutput
+	DeleteAccessPointForObjectLambdaWithContextErr    error
+
+	GetAccessPointPolicyForObjectLambdaWithContextOutput s3control.GetAccessPointPolicyForObjectLambdaOutput
+	GetAccessPointPolicyForObjectLambdaWithContextErr    error
+
+	PutAccessPointPolicyForObjectLambdaWithContextOutput s3control.PutAccessPointPolicyForObjectLambdaOutput
+	PutAccessPointPolicyForObjectLambdaWithContextErr    error
+
+	DeleteAccessPointPolicyForObjectLambdaWithContextOutput s3control.DeleteAccessPointPolicyForObjectLambdaOutput
+	DeleteAccessPointPolicyForObjectLambdaWithContextErr    error
 }
 
 // DeleteAccessPointWithContext is the fake method call to invoke the internal mock method
@@ -61,3 +103,73 @@ func (m *MockS3ControlClient) PutAccessPointPolicyWithContext(_ aws.Context, _ *
 func (m *MockS3ControlClient) DeleteAccessPointPolicyWithContext(_ aws.Context, _ *s3control.DeleteAccessPointPolicyInput, _ ...request.Option) (*s3control.DeleteAccessPointPolicyOutput, error) {
 	return &m.DeleteAccessPointPolicyWithContextOutput, m.DeleteAccessPointPolicyWithContextErr
 }
+
+// CreateMultiRegionAccessPointWithContext is the fake method call to invoke the internal mock method
+func (m *MockS3ControlClient) CreateMultiRegionAccessPointWithContext(_ aws.Context, _ *s3control.CreateMultiRegionAccessPointInput, _ ...request.Option) (*s3control.CreateMultiRegionAccessPointOutput, error) {
+	return &m.CreateMultiRegionAccessPointWithContextOutput, m.CreateMultiRegionAccessPointWithContextErr
+}
+
+// GetMultiRegionAccessPointWithContext is the fake method call to invoke the internal mock method
+func (m *MockS3ControlClient) GetMultiRegionAccessPointWithContext(_ aws.Context, _ *s3control.GetMultiRegionAccessPointInput, _ ...request.Option) (*s3control.GetMultiRegionAccessPointOutput, error) {
+	return &m.GetMultiRegionAccessPointWithContextOutput, m.GetMultiRegionAccessPointWithContextErr
+}
+
+// DeleteMultiRegionAccessPointWithContext is the fake method call to invoke the internal mock method
+func (m *MockS3ControlClient) DeleteMultiRegionAccessPointWithContext(_ aws.Context, _ *s3control.DeleteMultiRegionAccessPointInput, _ ...request.Option) (*s3control.DeleteMultiRegionAccessPointOutput, error) {
+	return &m.DeleteMultiRegionAccessPointWithContextOutput, m.DeleteMultiRegionAccessPointWithContextErr
+}
+
+// DescribeMultiRegionAccessPointOperationWithContext is the fake method call to invoke the internal mock method
+func (m *MockS3ControlClient) DescribeMultiRegionAccessPointOperationWithContext(_ aws.Context, _ *s3control.DescribeMultiRegionAccessPointOperationInput, _ ...request.Option) (*s3control.DescribeMultiRegionAccessPointOperationOutput, error) {
+	return &m.DescribeMultiRegionAccessPointOperationWithContextOutput, m.DescribeMultiRegionAccessPointOperationWithContextErr
+}
+
+// GetMultiRegionAccessPointPolicyWithContext is the fake method call to invoke the internal mock method
+func (m *MockS3ControlClient) GetMultiRegionAccessPointPolicyWithContext(_ aws.Context, _ *s3control.GetMultiRegionAccessPointPolicyInput, _ ...request.Option) (*s3control.GetMultiRegionAccessPointPolicyOutput, error) {
+	return &m.GetMultiRegionAccessPointPolicyWithContextOutput, m.GetMultiRegionAccessPointPolicyWithContextErr
+}
+
+// PutMultiRegionAccessPointPolicyWithContext is the fake method call to invoke the internal mock method
+func (m *MockS3ControlClient) PutMultiRegionAccessPointPolicyWithContext(_ aws.Context, _ *s3control.PutMultiRegionAccessPointPolicyInput, _ ...request.Option) (*s3control.PutMultiRegionAccessPointPolicyOutput, error) {
+	return &m.PutMultiRegionAccessPointPolicyWithContextOutput, m.PutMultiRegionAccessPointPolicyWithContextErr
+}
+
+// CreateAccessPointForObjectLambdaWithContext is the fake method call to invoke the internal mock method
+func (m *MockS3ControlClient) CreateAccessPointForObjectLambdaWithContext(_ aws.Context, _ *s3control.CreateAccessPointForObjectLambdaInput, _ ...request.Option) (*s3control.CreateAccessPointForObjectLambdaOutput, error) {
+	return &m.CreateAccessPointForObjectLambdaWithContextOutput, m.CreateAccessPointForObjectLambdaWithContextErr
+}
+
+// GetAccessPointForObjectLambdaWithContext is the fake method call to invoke the internal mock method
+func (m *MockS3ControlClient) GetAccessPointForObjectLambdaWithContext(_ aws.Context, _ *s3control.GetAccessPointForObjectLambdaInput, _ ...request.Option) (*s3control.GetAccessPointForObjectLambdaOutput, error) {
+	return &m.GetAccessPointForObjectLambdaWithContextOutput, m.GetAccessPointForObjectLambdaWithContextErr
+}
+
+// GetAccessPointConfigurationForObjectLambdaWithContext is the fake method call to invoke the internal mock method
+func (m *MockS3ControlClient) GetAccessPointConfigurationForObjectLambdaWithContext(_ aws.Context, _ *s3control.GetAccessPointConfigurationForObjectLambdaInput, _ ...request.Option) (*s3control.GetAccessPointConfigurationForObjectLambdaOutput, error) {
+	return &m.GetAccessPointConfigurationForObjectLambdaWithContextOutput, m.GetAccessPointConfigurationForObjectLambdaWithContextErr
+}
+
+// PutAccessPointConfigurationForObjectLambdaWithContext is the fake method call to invoke the internal mock method
+func (m *MockS3ControlClient) PutAccessPointConfigurationForObjectLambdaWithContext(_ aws.Context, _ *s3control.PutAccessPointConfigurationForObjectLambdaInput, _ ...request.Option) (*s3control.PutAccessPointConfigurationForObjectLambdaOutput, error) {
+	return &m.PutAccessPointConfigurationForObjectLambdaWithContextOutput, m.PutAccessPointConfigurationForObjectLambdaWithContextErr
+}
+
+// DeleteAccessPointForObjectLambdaWithContext is the fake method call to invoke the internal mock method
+func (m *MockS3ControlClient) DeleteAccessPointForObjectLambdaWithContext(_ aws.Context, _ *s3control.DeleteAccessPointForObjectLambdaInput, _ ...request.Option) (*s3control.DeleteAccessPointForObjectLambdaOutput, error) {
+	return &m.DeleteAccessPointForObjectLambdaWithContextOutput, m.DeleteAccessPointForObjectLambdaWithContextErr
+}
+
+// GetAccessPointPolicyForObjectLambdaWithContext is the fake method call to invoke the internal mock method
+func (m *MockS3ControlClient) GetAccessPointPolicyForObjectLambdaWithContext(_ aws.Context, _ *s3control.GetAccessPointPolicyForObjectLambdaInput, _ ...request.Option) (*s3control.GetAccessPointPolicyForObjectLambdaOutput, error) {
+	return &m.GetAccessPointPolicyForObjectLambdaWithContextOutput, m.GetAccessPointPolicyForObjectLambdaWithContextErr
+}
+
+// PutAccessPointPolicyForObjectLambdaWithContext is the fake method call to invoke the internal mock method
+func (m *MockS3ControlClient) PutAccessPointPolicyForObjectLambdaWithContext(_ aws.Context, _ *s3control.PutAccessPointPolicyForObjectLambdaInput, _ ...request.Option) (*s3control.PutAccessPointPolicyForObjectLambdaOutput, error) {
+	return &m.PutAccessPointPolicyForObjectLambdaWithContextOutput, m.PutAccessPointPolicyForObjectLambdaWithContextErr
+}
+
+// DeleteAccessPointPolicyForObjectLambdaWithContext is the fake method call to invoke the internal mock method
+func (m *MockS3ControlClient) DeleteAccessPointPolicyForObjectLambdaWithContext(_ aws.Context, _ *s3control.DeleteAccessPointPolicyForObjectLambdaInput, _ ...request.Option) (*s3control.DeleteAccessPointPolicyForObjectLambdaOutput, error) {
+	return &m.DeleteAccessPointPolicyForObjectLambdaWithContextOutput, m.DeleteAccessPointPolicyForObjectLambdaWithContextErr
+}
@@ -103,7 +103,9 @@ func LateInitializeTopicAttr(in *v1beta1.TopicParameters, attrs map[string]strin
 	in.DisplayName = awsclients.LateInitializeStringPtr(in.DisplayName, aws.String(attrs[string(TopicDisplayName)]))
 	in.DeliveryPolicy = awsclients.LateInitializeStringPtr(in.DeliveryPolicy, aws.String(attrs[string(TopicDeliveryPolicy)]))
 	in.KMSMasterKeyID = awsclients.LateInitializeStringPtr(in.KMSMasterKeyID, aws.String(attrs[string(TopicKmsMasterKeyID)]))
-	in.Policy = awsclients.LateInitializeStringPtr(in.Policy, aws.String(attrs[string(TopicPolicy)]))
+	if in.PolicyDocument == nil {
+		in.Policy = awsclients.LateInitializeStringPtr(in.Policy, aws.String(attrs[string(TopicPolicy)]))
+	}
 
 	in.FifoTopic = nil
 	fifoTopic, err := strconv.ParseBool(attrs[string(TopicFifoTopic)])
@@ -119,7 +121,10 @@ func LateInitializeTopicAttr(in *v1beta1.TopicParameters, attrs map[string]strin
 // So we need to compare each topic attribute and call SetTopicAttribute for ones which has
 // changed.
 func GetChangedAttributes(p v1beta1.TopicParameters, attrs map[string]string) (map[string]string, error) {
-	topicAttrs := getTopicAttributes(p)
+	topicAttrs, err := getTopicAttributes(p)
+	if err != nil {
+		return nil, err
+	}
 	changedAttrs := make(map[string]string)
 	for k, v := range topicAttrs {
 		if k == string(TopicPolicy) {
@@ -165,7 +170,11 @@ func GenerateTopicObservation(attr map[string]string) v1beta1.TopicObservation {
 func IsSNSTopicUpToDate(p v1beta1.TopicParameters, attr map[string]string) (bool, error) {
 	fifoTopic, _ := strconv.ParseBool(attr[string(TopicFifoTopic)])
 
-	isPolicyUpToDate, err := isSNSPolicyUpToDate(ptr.Deref(p.Policy, ""), attr[string(TopicPolicy)])
+	policy, err := getTopicPolicy(p)
+	if err != nil {
+		return false, err
+	}
+	isPolicyUpToDate, err := isSNSPolicyUpToDate(policy, attr[string(TopicPolicy)])
 	if err != nil {
 		return false, err
 	}
@@ -197,20 +206,39 @@ func isSNSPolicyUpToDate(specPolicyStr, currPolicyStr string) (bool, error) {
 	return equalPolicies, nil
 }
 
-func getTopicAttributes(p v1beta1.TopicParameters) map[string]string {
+// getTopicPolicy returns the JSON policy of the topic which is either given
+// as raw policy or as structured resource policy.
+func getTopicPolicy(p v1beta1.TopicParameters) (string, error) {
+	policy, err := policyutils.ConvertResourcePolicyOrRaw(p.PolicyDocument, p.Policy)
+	return ptr.Deref(policy, ""), err
+}
+
+// ValidateTopicPolicy checks the given topic policy for mistakes that would
+// make SNS reject it.
+func ValidateTopicPolicy(policy string) error {
+	return policyutils.ValidatePolicyString(policy,
+		policyutils.WithResourcePolicy(),
+		policyutils.WithMaxSize(policyutils.MaxTopicPolicySize))
+}
+
+func getTopicAttributes(p v1beta1.TopicParameters) (map[string]string, error) {
+	policy, err := getTopicPolicy(p)
+	if err != nil {
+		return nil, err
+	}
 	topicAttr := make(map[string]string)
 
 	topicAttr[string(TopicDeliveryPolicy)] = aws.ToString(p.DeliveryPolicy)
 	topicAttr[string(TopicDisplayName)] = aws.ToString(p.DisplayName)
 	topicAttr[string(TopicKmsMasterKeyID)] = aws.ToString(p.KMSMasterKeyID)
-	topicAttr[string(TopicPolicy)] = aws.ToString(p.Policy)
+	topicAttr[string(TopicPolicy)] = policy
 
 	fifoTopic := aws.ToBool(p.FifoTopic)
 	if fifoTopic {
 		topicAttr[string(TopicFifoTopic)] = strconv.FormatBool(fifoTopic)
 	}
 
-	return topicAttr
+	return topicAttr, nil
 }
 
 // IsTopicNotFound returns true if the error code indicates that the item was not found
@@ -29,6 +29,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 
+	"github.com/crossplane-contrib/provider-aws/apis/common"
 	"github.com/crossplane-contrib/provider-aws/apis/sns/v1beta1"
 )
 
@@ -420,6 +421,30 @@ func TestIsSNSTopicUpToDate(t *testing.T) {
 				isUpToDate: true,
 			},
 		},
+		"NoUpdateExistsWithStructuredPolicy": {
+			args: args{
+				attr: topicAttributes(
+					withAttrPolicy(&testPolicyA),
+				),
+				p: v1beta1.TopicParameters{
+					PolicyDocument: &common.ResourcePolicy{
+						Version: "2012-10-17",
+						Statements: []common.ResourcePolicyStatement{{
+							SID:    aws.String("PublishToTopic"),
+							Effect: "Allow",
+							Principal: &common.ResourcePrincipal{
+								AWSPrincipals: []common.AWSPrincipal{{IAMRoleARN: aws.String("arn:aws:iam::*****:role/my-role")}},
+							},
+							Action:   []string{"SNS:Publish", "SNS:GetTopicAttributes"},
+							Resource: []string{"arn:aws:sns:eu-west-1:******:my-queue"},
+						}},
+					},
+				},
+			},
+			want: want{
+				isUpToDate: true,
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -34,6 +34,7 @@ import (
 
 	"github.com/crossplane-contrib/provider-aws/apis/sqs/v1beta1"
 	awsclients "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	policyutils "github.com/crossplane-contrib/provider-aws/pkg/utils/policy"
 )
 
 const (
@@ -59,8 +60,11 @@ func NewClient(cfg aws.Config) Client {
 }
 
 // GenerateCreateAttributes returns a map of queue attributes for Create operation
-func GenerateCreateAttributes(p *v1beta1.QueueParameters) map[string]string {
-	m := GenerateQueueAttributes(p)
+func GenerateCreateAttributes(p *v1beta1.QueueParameters) (map[string]string, error) {
+	m, err := GenerateQueueAttributes(p)
+	if err != nil {
+		return nil, err
+	}
 	if aws.ToBool(p.FIFOQueue) {
 		// SQS expects this attribute only if its value is true.
 		// https://github.com/aws/aws-sdk-php/issues/1331
@@ -69,11 +73,15 @@ func GenerateCreateAttributes(p *v1beta1.QueueParameters) map[string]string {
 		}
 		m[v1beta1.AttributeFifoQueue] = "true"
 	}
-	return m
+	return m, nil
 }
 
 // GenerateQueueAttributes returns a map of queue attributes
-func GenerateQueueAttributes(p *v1beta1.QueueParameters) map[string]string { //nolint:gocyclo
+func GenerateQueueAttributes(p *v1beta1.QueueParameters) (map[string]string, error) { //nolint:gocyclo
+	policy, err := policyutils.ConvertResourcePolicyOrRaw(p.PolicyDocument, p.Policy)
+	if err != nil {
+		return nil, err
+	}
 	m := map[string]string{}
 	if p.DelaySeconds != nil {
 		m[v1beta1.AttributeDelaySeconds] = strconv.FormatInt(aws.ToInt64(p.DelaySeconds), 10)
@@ -84,8 +92,8 @@ func GenerateQueueAttributes(p *v1beta1.QueueParameters) map[string]string { //n
 	if p.MessageRetentionPeriod != nil {
 		m[v1beta1.AttributeMessageRetentionPeriod] = strconv.FormatInt(aws.ToInt64(p.MessageRetentionPeriod), 10)
 	}
-	if p.Policy != nil {
-		m[v1beta1.AttributePolicy] = aws.ToString(p.Policy)
+	if policy != nil {
+		m[v1beta1.AttributePolicy] = aws.ToString(policy)
 	}
 	if p.ReceiveMessageWaitTimeSeconds != nil {
 		m[v1beta1.AttributeReceiveMessageWaitTimeSeconds] = strconv.FormatInt(aws.ToInt64(p.ReceiveMessageWaitTimeSeconds), 10)
@@ -119,9 +127,9 @@ func GenerateQueueAttributes(p *v1beta1.QueueParameters) map[string]string { //n
 		m[v1beta1.AttributeSqsManagedSseEnabled] = strconv.FormatBool(aws.ToBool(p.SqsManagedSseEnabled))
 	}
 	if len(m) == 0 {
-		return nil
+		return nil, nil
 	}
-	return m
+	return m, nil
 }
 
 // GenerateQueueObservation returns a QueueObservation with information retrieved
@@ -213,7 +221,7 @@ func IsUpToDate(p v1beta1.QueueParameters, attributes map[string]string, tags ma
 	if !cmp.Equal(aws.ToString(p.KMSMasterKeyID), attributes[v1beta1.AttributeKmsMasterKeyID]) {
 		return false
 	}
-	if !cmp.Equal(aws.ToString(p.Policy), attributes[v1beta1.AttributePolicy]) {
+	if !isPolicyUpToDate(p, attributes[v1beta1.AttributePolicy]) {
 		return false
 	}
 	if attributes[v1beta1.AttributeContentBasedDeduplication] != "" && strconv.FormatBool(aws.ToBool(p.ContentBasedDeduplication)) != attributes[v1beta1.AttributeContentBasedDeduplication] {
@@ -237,6 +245,29 @@ func IsUpToDate(p v1beta1.QueueParameters, attributes map[string]string, tags ma
 	return true
 }
 
+// isPolicyUpToDate compares the raw policy literally and the structured
+// resource policy semantically with the observed policy.
+// ValidateQueuePolicy checks the given queue policy for mistakes that would
+// make SQS reject it.
+func ValidateQueuePolicy(policy string) error {
+	return policyutils.ValidatePolicyString(policy, policyutils.WithResourcePolicy())
+}
+
+func isPolicyUpToDate(p v1beta1.QueueParameters, observed string) bool {
+	if p.PolicyDocument == nil {
+		return cmp.Equal(aws.ToString(p.Policy), observed)
+	}
+	if p.Policy != nil || observed == "" {
+		return false
+	}
+	current, err := policyutils.ParsePolicyString(observed)
+	if err != nil {
+		return false
+	}
+	equal, _ := policyutils.ArePoliciesEqal(policyutils.ConvertResourcePolicyToPolicy(p.PolicyDocument), &current)
+	return equal
+}
+
 // TagsDiff returns the tags added and removed from spec when compared to the AWS SQS tags.
 func TagsDiff(sqsTags map[string]string, newTags map[string]string) (removed, added map[string]string) {
 	removed = map[string]string{}
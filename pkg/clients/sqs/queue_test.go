@@ -25,6 +25,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/google/go-cmp/cmp"
 
+	"github.com/crossplane-contrib/provider-aws/apis/common"
 	"github.com/crossplane-contrib/provider-aws/apis/sqs/v1beta1"
 )
 
@@ -37,6 +38,16 @@ var (
 	url                               = "url"
 	maxReceiveCount int64             = 5
 	m               map[string]string = make(map[string]string)
+
+	policyDocument = &common.ResourcePolicy{
+		Version: "2012-10-17",
+		Statements: []common.ResourcePolicyStatement{{
+			Effect:    "Allow",
+			Principal: &common.ResourcePrincipal{Service: []string{"sns.amazonaws.com"}},
+			Action:    []string{"sqs:SendMessage"},
+			Resource:  []string{arn},
+		}},
+	}
 )
 
 func sqsParams(m ...func(*v1beta1.QueueParameters)) *v1beta1.QueueParameters {
@@ -170,6 +181,28 @@ func TestIsUpToDate(t *testing.T) {
 			},
 			want: true,
 		},
+		"SameResourcePolicy": {
+			args: args{
+				p: v1beta1.QueueParameters{
+					PolicyDocument: policyDocument,
+				},
+				attributes: map[string]string{
+					v1beta1.AttributePolicy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"sns.amazonaws.com"},"Action":"sqs:SendMessage","Resource":"arn"}]}`,
+				},
+			},
+			want: true,
+		},
+		"DifferentResourcePolicy": {
+			args: args{
+				p: v1beta1.QueueParameters{
+					PolicyDocument: policyDocument,
+				},
+				attributes: map[string]string{
+					v1beta1.AttributePolicy: `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Principal":{"Service":"sns.amazonaws.com"},"Action":"sqs:SendMessage","Resource":"arn"}]}`,
+				},
+			},
+			want: false,
+		},
 	}
 
 	for name, tc := range cases {
@@ -186,6 +219,7 @@ func TestGenerateQueueAttributes(t *testing.T) {
 	cases := map[string]struct {
 		in  v1beta1.QueueParameters
 		out map[string]string
+		err bool
 	}{
 		"FilledInput": {
 			in: *sqsParams(),
@@ -207,6 +241,21 @@ func TestGenerateQueueAttributes(t *testing.T) {
 				v1beta1.AttributeKmsMasterKeyID: kmsMasterKeyID,
 			},
 		},
+		"ResourcePolicy": {
+			in: v1beta1.QueueParameters{
+				PolicyDocument: policyDocument,
+			},
+			out: map[string]string{
+				v1beta1.AttributePolicy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":["sns.amazonaws.com"]},"Action":["sqs:SendMessage"],"Resource":["arn"]}]}`,
+			},
+		},
+		"RawAndResourcePolicy": {
+			in: v1beta1.QueueParameters{
+				Policy:         aws.String("{}"),
+				PolicyDocument: policyDocument,
+			},
+			err: true,
+		},
 		"EmptyInput": {
 			in:  v1beta1.QueueParameters{},
 			out: nil,
@@ -215,7 +264,10 @@ func TestGenerateQueueAttributes(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			r := GenerateQueueAttributes(&tc.in)
+			r, err := GenerateQueueAttributes(&tc.in)
+			if (err != nil) != tc.err {
+				t.Errorf("GenerateQueueAttributes(...): unexpected error: %v", err)
+			}
 			if diff := cmp.Diff(r, tc.out); diff != "" {
 				t.Errorf("GenerateQueueAttributes(...): -want, +got:\n%s", diff)
 			}
@@ -250,7 +302,10 @@ func TestGenerateCreateAttributes(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			r := GenerateCreateAttributes(&tc.in)
+			r, err := GenerateCreateAttributes(&tc.in)
+			if err != nil {
+				t.Errorf("GenerateCreateAttributes(...): unexpected error: %v", err)
+			}
 			if diff := cmp.Diff(r, tc.out); diff != "" {
 				t.Errorf("GenerateCreateAttributes(...): -want, +got:\n%s", diff)
 			}
@@ -16,6 +16,135 @@ limitations under the License.
 
 package aws
 
+import (
+	"context"
+	"encoding/json"
+	"sort"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-aws/apis/v1beta1"
+)
+
+// AnnotationKeyDefaultTags is the annotation that records the default tags of
+// the ProviderConfig that were applied to the tags of a managed resource.
+const AnnotationKeyDefaultTags = "aws.crossplane.io/default-tags"
+
+const errUpdateDefaultTags = "cannot update managed resource with default tags"
+
+// GetDefaultTags returns the default tags of the ProviderConfig referenced by
+// the supplied managed resource.
+func GetDefaultTags(ctx context.Context, c client.Client, mg resource.Managed) (map[string]string, error) {
+	if mg.GetProviderConfigReference() == nil {
+		return nil, nil
+	}
+	pc := &v1beta1.ProviderConfig{}
+	if err := c.Get(ctx, types.NamespacedName{Name: mg.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, "cannot get referenced ProviderConfig")
+	}
+	return pc.Spec.DefaultTags, nil
+}
+
+// ApplyDefaultTags returns the supplied tags of a managed resource with the
+// supplied default tags applied. Tags set by the user take precedence over
+// default tags with the same key. The default tags that were applied are
+// recorded in an annotation of the managed resource, so that tags that were
+// added as defaults follow later changes of their default value and are
+// removed once they are no longer a default, unless the user changed them in
+// the meantime.
+func ApplyDefaultTags(mg resource.Managed, tags, defaults map[string]string) map[string]string {
+	applied := map[string]string{}
+	if a := mg.GetAnnotations()[AnnotationKeyDefaultTags]; a != "" {
+		// NOTE: An unparseable annotation is treated like a missing one, i.e.
+		// all existing tags are considered to be set by the user.
+		_ = json.Unmarshal([]byte(a), &applied)
+	}
+
+	result := make(map[string]string, len(tags)+len(defaults))
+	for k, v := range tags {
+		result[k] = v
+	}
+	for k, v := range applied {
+		if _, ok := defaults[k]; ok {
+			continue
+		}
+		if cur, ok := result[k]; ok && cur == v {
+			delete(result, k)
+		}
+	}
+
+	next := make(map[string]string, len(defaults))
+	for k, v := range defaults {
+		cur, exists := result[k]
+		prev, wasApplied := applied[k]
+		if exists && (!wasApplied || cur != prev) {
+			continue
+		}
+		result[k] = v
+		next[k] = v
+	}
+
+	if len(next) == 0 {
+		meta.RemoveAnnotations(mg, AnnotationKeyDefaultTags)
+		return result
+	}
+	// NOTE: Marshalling a map of strings cannot fail.
+	b, _ := json.Marshal(next)
+	meta.AddAnnotations(mg, map[string]string{AnnotationKeyDefaultTags: string(b)})
+	return result
+}
+
+// NewDefaultTagger returns an initializer that applies the default tags of the
+// ProviderConfig referenced by a managed resource to the tags returned by
+// tagsOf and persists them, so that they are part of the desired state.
+// keyValue and newTag convert between the tag type of the managed resource and
+// key value pairs. The order of existing tags is preserved, new tags are
+// appended in the order of their keys.
+func NewDefaultTagger[T any](kube client.Client, tagsOf func(resource.Managed) *[]*T, keyValue func(*T) (string, string), newTag func(key, value string) *T) managed.InitializerFn {
+	return func(ctx context.Context, mg resource.Managed) error {
+		defaults, err := GetDefaultTags(ctx, kube, mg)
+		if err != nil {
+			return err
+		}
+		spec := tagsOf(mg)
+		current := make(map[string]string, len(*spec))
+		for _, t := range *spec {
+			k, v := keyValue(t)
+			current[k] = v
+		}
+		annotation := mg.GetAnnotations()[AnnotationKeyDefaultTags]
+		desired := ApplyDefaultTags(mg, current, defaults)
+		if cmp.Equal(current, desired) && annotation == mg.GetAnnotations()[AnnotationKeyDefaultTags] {
+			return nil
+		}
+
+		tags := make([]*T, 0, len(desired))
+		for _, t := range *spec {
+			k, _ := keyValue(t)
+			if v, ok := desired[k]; ok {
+				tags = append(tags, newTag(k, v))
+				delete(desired, k)
+			}
+		}
+		keys := make([]string, 0, len(desired))
+		for k := range desired {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			tags = append(tags, newTag(k, desired[k]))
+		}
+		*spec = tags
+		return errors.Wrap(kube.Update(ctx, mg), errUpdateDefaultTags)
+	}
+}
+
 // DiffTags returns tags that should be added or removed.
 func DiffTags(local, remote map[string]string) (add map[string]string, remove []string) {
 	add = make(map[string]string, len(local))
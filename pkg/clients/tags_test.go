@@ -17,11 +17,20 @@ limitations under the License.
 package aws
 
 import (
+	"context"
 	"testing"
 
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-aws/apis/v1beta1"
 )
 
 func TestDiffTags(t *testing.T) {
@@ -182,3 +191,224 @@ func TestDiffTagsMapPtr(t *testing.T) {
 		})
 	}
 }
+
+func TestGetDefaultTags(t *testing.T) {
+	type want struct {
+		tags map[string]string
+		err  error
+	}
+
+	cases := map[string]struct {
+		mg   *fake.Managed
+		kube client.Client
+		want want
+	}{
+		"NoProviderConfigReference": {
+			mg:   &fake.Managed{},
+			want: want{},
+		},
+		"DefaultTags": {
+			mg: &fake.Managed{ProviderConfigReferencer: fake.ProviderConfigReferencer{Ref: &xpv1.Reference{Name: "pc"}}},
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+				obj.(*v1beta1.ProviderConfig).Spec.DefaultTags = map[string]string{"owner": "team"}
+				return nil
+			})},
+			want: want{tags: map[string]string{"owner": "team"}},
+		},
+		"GetError": {
+			mg:   &fake.Managed{ProviderConfigReferencer: fake.ProviderConfigReferencer{Ref: &xpv1.Reference{Name: "pc"}}},
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(errors.New(errBoom))},
+			want: want{err: errors.Wrap(errors.New(errBoom), "cannot get referenced ProviderConfig")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := GetDefaultTags(context.Background(), tc.kube, tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("GetDefaultTags(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.tags, got); diff != "" {
+				t.Errorf("GetDefaultTags(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestApplyDefaultTags(t *testing.T) {
+	type args struct {
+		applied  string
+		tags     map[string]string
+		defaults map[string]string
+	}
+	type want struct {
+		tags    map[string]string
+		applied string
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NoDefaults": {
+			args: args{
+				tags: map[string]string{"key": "val"},
+			},
+			want: want{tags: map[string]string{"key": "val"}},
+		},
+		"AddDefaults": {
+			args: args{
+				tags:     map[string]string{"key": "val"},
+				defaults: map[string]string{"owner": "team"},
+			},
+			want: want{
+				tags:    map[string]string{"key": "val", "owner": "team"},
+				applied: `{"owner":"team"}`,
+			},
+		},
+		"TagsTakePrecedence": {
+			args: args{
+				tags:     map[string]string{"key": "val", "cost-center": "7"},
+				defaults: map[string]string{"cost-center": "42", "owner": "team"},
+			},
+			want: want{
+				tags:    map[string]string{"key": "val", "cost-center": "7", "owner": "team"},
+				applied: `{"owner":"team"}`,
+			},
+		},
+		"UpdateChangedDefault": {
+			args: args{
+				applied:  `{"owner":"team"}`,
+				tags:     map[string]string{"owner": "team"},
+				defaults: map[string]string{"owner": "other-team"},
+			},
+			want: want{
+				tags:    map[string]string{"owner": "other-team"},
+				applied: `{"owner":"other-team"}`,
+			},
+		},
+		"KeepDefaultChangedByUser": {
+			args: args{
+				applied:  `{"owner":"team"}`,
+				tags:     map[string]string{"owner": "me"},
+				defaults: map[string]string{"owner": "other-team"},
+			},
+			want: want{
+				tags: map[string]string{"owner": "me"},
+			},
+		},
+		"RemoveDroppedDefault": {
+			args: args{
+				applied: `{"owner":"team"}`,
+				tags:    map[string]string{"key": "val", "owner": "team"},
+			},
+			want: want{
+				tags: map[string]string{"key": "val"},
+			},
+		},
+		"KeepDroppedDefaultChangedByUser": {
+			args: args{
+				applied: `{"owner":"team"}`,
+				tags:    map[string]string{"owner": "me"},
+			},
+			want: want{
+				tags: map[string]string{"owner": "me"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mg := &fake.Managed{}
+			if tc.args.applied != "" {
+				meta.AddAnnotations(mg, map[string]string{AnnotationKeyDefaultTags: tc.args.applied})
+			}
+			got := ApplyDefaultTags(mg, tc.args.tags, tc.args.defaults)
+			if diff := cmp.Diff(tc.want.tags, got); diff != "" {
+				t.Errorf("ApplyDefaultTags(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.applied, mg.GetAnnotations()[AnnotationKeyDefaultTags]); diff != "" {
+				t.Errorf("ApplyDefaultTags(...): -want annotation, +got annotation:\n%s", diff)
+			}
+		})
+	}
+}
+
+type testTag struct {
+	Key   string
+	Value string
+}
+
+func TestNewDefaultTagger(t *testing.T) {
+	type want struct {
+		tags    []*testTag
+		updated bool
+		err     error
+	}
+
+	cases := map[string]struct {
+		tags     []*testTag
+		defaults map[string]string
+		update   error
+		want     want
+	}{
+		"UpToDate": {
+			tags: []*testTag{{Key: "key", Value: "val"}},
+			want: want{tags: []*testTag{{Key: "key", Value: "val"}}},
+		},
+		"AppendDefaults": {
+			tags:     []*testTag{{Key: "key", Value: "val"}},
+			defaults: map[string]string{"owner": "team", "cost-center": "42"},
+			want: want{
+				tags: []*testTag{
+					{Key: "key", Value: "val"},
+					{Key: "cost-center", Value: "42"},
+					{Key: "owner", Value: "team"},
+				},
+				updated: true,
+			},
+		},
+		"UpdateFailed": {
+			defaults: map[string]string{"owner": "team"},
+			update:   errors.New(errBoom),
+			want: want{
+				tags:    []*testTag{{Key: "owner", Value: "team"}},
+				updated: true,
+				err:     errors.Wrap(errors.New(errBoom), errUpdateDefaultTags),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			tags := tc.tags
+			updated := false
+			kube := &test.MockClient{
+				MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+					obj.(*v1beta1.ProviderConfig).Spec.DefaultTags = tc.defaults
+					return nil
+				}),
+				MockUpdate: func(_ context.Context, _ client.Object, _ ...client.UpdateOption) error {
+					updated = true
+					return tc.update
+				},
+			}
+			init := NewDefaultTagger(kube,
+				func(_ resource.Managed) *[]*testTag { return &tags },
+				func(t *testTag) (string, string) { return t.Key, t.Value },
+				func(k, v string) *testTag { return &testTag{Key: k, Value: v} },
+			)
+			mg := &fake.Managed{ProviderConfigReferencer: fake.ProviderConfigReferencer{Ref: &xpv1.Reference{Name: "pc"}}}
+			err := init.Initialize(context.Background(), mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Initialize(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.tags, tags); diff != "" {
+				t.Errorf("Initialize(...): -want tags, +got tags:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.updated, updated); diff != "" {
+				t.Errorf("Initialize(...): -want updated, +got updated:\n%s", diff)
+			}
+		})
+	}
+}
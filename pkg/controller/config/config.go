@@ -17,17 +17,69 @@ limitations under the License.
 package config
 
 import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/providerconfig"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/crossplane-contrib/provider-aws/apis/v1beta1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+)
+
+const (
+	errGetPC               = "cannot get ProviderConfig"
+	errValidateCredentials = "cannot validate credentials"
+	errUpdateStatus        = "cannot update ProviderConfig status"
+)
+
+// typeCredentialsValid ProviderConfigs have credentials that were
+// successfully used to make a request to the AWS API.
+const typeCredentialsValid xpv1.ConditionType = "CredentialsValid"
+
+// Reasons a ProviderConfig's credentials are or are not valid.
+const (
+	reasonCredentialsValid   xpv1.ConditionReason = "CredentialsValid"
+	reasonCredentialsInvalid xpv1.ConditionReason = "CredentialsInvalid"
 )
 
+func credentialsValid() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               typeCredentialsValid,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             reasonCredentialsValid,
+	}
+}
+
+func credentialsInvalid(err error) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               typeCredentialsValid,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             reasonCredentialsInvalid,
+		Message:            awsclient.Wrap(err, errValidateCredentials).Error(),
+	}
+}
+
+// credentialsValidationInterval is the interval at which the credentials of
+// a ProviderConfig are validated.
+const credentialsValidationInterval = 10 * time.Minute
+
 // Setup adds a controller that reconciles ProviderConfigs by accounting for
-// their current usage.
+// their current usage and validating their credentials.
 func Setup(mgr ctrl.Manager, o controller.Options) error {
 	name := providerconfig.ControllerName(v1beta1.ProviderConfigGroupKind)
 
@@ -41,7 +93,89 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		WithOptions(o.ForControllerRuntime()).
 		For(&v1beta1.ProviderConfig{}).
 		Watches(&v1beta1.ProviderConfigUsage{}, &resource.EnqueueRequestForProviderConfig{}).
-		Complete(providerconfig.NewReconciler(mgr, of,
-			providerconfig.WithLogger(o.Logger.WithValues("controller", name)),
-			providerconfig.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+		Complete(&cacheReleaser{
+			kube:    mgr.GetClient(),
+			release: awsclient.ReleaseProviderConfig,
+			Reconciler: &credentialsValidator{
+				kube:     mgr.GetClient(),
+				validate: awsclient.GetCallerIdentity,
+				record:   event.NewAPIRecorder(mgr.GetEventRecorderFor(name)),
+				Reconciler: providerconfig.NewReconciler(mgr, of,
+					providerconfig.WithLogger(o.Logger.WithValues("controller", name)),
+					providerconfig.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))),
+			},
+		})
+}
+
+// A cacheReleaser releases the credentials and rate limiters cached for a
+// ProviderConfig once it is deleted, before handing the request over to the
+// wrapped reconciler.
+type cacheReleaser struct {
+	reconcile.Reconciler
+	kube    client.Client
+	release func(providerConfig string)
+}
+
+// Reconcile releases the cached credentials and rate limiters of deleted
+// ProviderConfigs.
+func (r *cacheReleaser) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	pc := &v1beta1.ProviderConfig{}
+	err := r.kube.Get(ctx, req.NamespacedName, pc)
+	if kerrors.IsNotFound(err) || (err == nil && meta.WasDeleted(pc)) {
+		r.release(req.Name)
+	}
+	return r.Reconciler.Reconcile(ctx, req)
+}
+
+// A credentialsValidator validates the credentials of a ProviderConfig after
+// the wrapped reconciler accounted for its usage, and reports the result in
+// its status.
+type credentialsValidator struct {
+	reconcile.Reconciler
+	kube     client.Client
+	validate func(ctx context.Context, c client.Client, pc *v1beta1.ProviderConfig) (*sts.GetCallerIdentityOutput, error)
+	record   event.Recorder
+}
+
+// Reconcile validates the credentials of existing ProviderConfigs and
+// requeues them so that they are validated periodically.
+func (r *credentialsValidator) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	res, err := r.Reconciler.Reconcile(ctx, req)
+	if err != nil {
+		return res, err
+	}
+
+	pc := &v1beta1.ProviderConfig{}
+	if err := r.kube.Get(ctx, req.NamespacedName, pc); err != nil {
+		return res, errors.Wrap(client.IgnoreNotFound(err), errGetPC)
+	}
+	if meta.WasDeleted(pc) {
+		return res, nil
+	}
+
+	wasValid := pc.GetCondition(typeCredentialsValid).Status
+	id, err := r.validate(ctx, r.kube, pc)
+	if err != nil {
+		pc.SetConditions(credentialsInvalid(err), xpv1.Unavailable())
+		pc.Status.AccountID = ""
+		pc.Status.ARN = ""
+		if wasValid != corev1.ConditionFalse {
+			r.record.Event(pc, event.Warning(event.Reason(reasonCredentialsInvalid), awsclient.Wrap(err, errValidateCredentials)))
+		}
+	} else {
+		pc.SetConditions(credentialsValid(), xpv1.Available())
+		pc.Status.AccountID = awsclient.StringValue(id.Account)
+		pc.Status.ARN = awsclient.StringValue(id.Arn)
+		if wasValid != corev1.ConditionTrue {
+			r.record.Event(pc, event.Normal(event.Reason(reasonCredentialsValid), "Successfully validated credentials", "account", pc.Status.AccountID, "arn", pc.Status.ARN))
+		}
+	}
+	if err := r.kube.Status().Update(ctx, pc); err != nil {
+		return res, errors.Wrap(err, errUpdateStatus)
+	}
+
+	if res.RequeueAfter == 0 || res.RequeueAfter > credentialsValidationInterval {
+		res.RequeueAfter = credentialsValidationInterval
+	}
+	return res, nil
 }
@@ -0,0 +1,227 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane-contrib/provider-aws/apis/v1beta1"
+)
+
+type reconcilerFn func(ctx context.Context, req reconcile.Request) (reconcile.Result, error)
+
+func (fn reconcilerFn) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	return fn(ctx, req)
+}
+
+func TestCacheReleaserReconcile(t *testing.T) {
+	now := metav1.Now()
+	cases := map[string]struct {
+		kube client.Client
+		want []string
+	}{
+		"Exists": {
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(nil)},
+		},
+		"Deleting": {
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+				obj.(*v1beta1.ProviderConfig).SetDeletionTimestamp(&now)
+				return nil
+			})},
+			want: []string{"pc"},
+		},
+		"Deleted": {
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, "pc"))},
+			want: []string{"pc"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var released []string
+			reconciled := false
+			r := &cacheReleaser{
+				kube:    tc.kube,
+				release: func(pc string) { released = append(released, pc) },
+				Reconciler: reconcilerFn(func(_ context.Context, _ reconcile.Request) (reconcile.Result, error) {
+					reconciled = true
+					return reconcile.Result{}, nil
+				}),
+			}
+			if _, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: types.NamespacedName{Name: "pc"}}); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, released); diff != "" {
+				t.Errorf("Reconcile(...): -want released, +got released:\n%s", diff)
+			}
+			if !reconciled {
+				t.Errorf("Reconcile(...): expected the wrapped reconciler to be called")
+			}
+		})
+	}
+}
+
+type eventRecorder struct {
+	events []event.Type
+}
+
+func (r *eventRecorder) Event(_ runtime.Object, e event.Event) {
+	r.events = append(r.events, e.Type)
+}
+
+func (r *eventRecorder) WithAnnotations(_ ...string) event.Recorder {
+	return r
+}
+
+func TestCredentialsValidatorReconcile(t *testing.T) {
+	errBoom := errors.New("boom")
+	identity := &sts.GetCallerIdentityOutput{
+		Account: aws.String("123456789012"),
+		Arn:     aws.String("arn:aws:sts::123456789012:assumed-role/crossplane/session"),
+	}
+	valid := func(obj client.Object) error {
+		obj.(*v1beta1.ProviderConfig).SetConditions(credentialsValid())
+		return nil
+	}
+
+	type args struct {
+		kube     client.Client
+		wrapped  error
+		identity *sts.GetCallerIdentityOutput
+		err      error
+	}
+	type want struct {
+		result reconcile.Result
+		err    error
+		status *v1beta1.ProviderConfigStatus
+		events []event.Type
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"WrappedReconcilerFailed": {
+			args: args{
+				wrapped: errBoom,
+			},
+			want: want{
+				err: errBoom,
+			},
+		},
+		"Deleted": {
+			args: args{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, "pc"))},
+			},
+		},
+		"BecameValid": {
+			args: args{
+				identity: identity,
+			},
+			want: want{
+				result: reconcile.Result{RequeueAfter: credentialsValidationInterval},
+				status: func() *v1beta1.ProviderConfigStatus {
+					s := &v1beta1.ProviderConfigStatus{AccountID: *identity.Account, ARN: *identity.Arn}
+					s.SetConditions(credentialsValid(), xpv1.Available())
+					return s
+				}(),
+				events: []event.Type{event.TypeNormal},
+			},
+		},
+		"StillValid": {
+			args: args{
+				kube:     &test.MockClient{MockGet: test.NewMockGetFn(nil, valid)},
+				identity: identity,
+			},
+			want: want{
+				result: reconcile.Result{RequeueAfter: credentialsValidationInterval},
+				status: func() *v1beta1.ProviderConfigStatus {
+					s := &v1beta1.ProviderConfigStatus{AccountID: *identity.Account, ARN: *identity.Arn}
+					s.SetConditions(credentialsValid(), xpv1.Available())
+					return s
+				}(),
+			},
+		},
+		"BecameInvalid": {
+			args: args{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(nil, valid)},
+				err:  errBoom,
+			},
+			want: want{
+				result: reconcile.Result{RequeueAfter: credentialsValidationInterval},
+				status: func() *v1beta1.ProviderConfigStatus {
+					s := &v1beta1.ProviderConfigStatus{}
+					s.SetConditions(credentialsInvalid(errBoom), xpv1.Unavailable())
+					return s
+				}(),
+				events: []event.Type{event.TypeWarning},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var status *v1beta1.ProviderConfigStatus
+			kube := tc.args.kube
+			if kube == nil {
+				kube = &test.MockClient{MockGet: test.NewMockGetFn(nil)}
+			}
+			mc := kube.(*test.MockClient)
+			mc.MockStatusUpdate = func(_ context.Context, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+				status = obj.(*v1beta1.ProviderConfig).Status.DeepCopy()
+				return nil
+			}
+			rec := &eventRecorder{}
+			r := &credentialsValidator{
+				kube: mc,
+				validate: func(_ context.Context, _ client.Client, _ *v1beta1.ProviderConfig) (*sts.GetCallerIdentityOutput, error) {
+					return tc.args.identity, tc.args.err
+				},
+				record: rec,
+				Reconciler: reconcilerFn(func(_ context.Context, _ reconcile.Request) (reconcile.Result, error) {
+					return reconcile.Result{}, tc.args.wrapped
+				}),
+			}
+			got, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: types.NamespacedName{Name: "pc"}})
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Reconcile(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, got); diff != "" {
+				t.Errorf("Reconcile(...): -want result, +got result:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.status, status, test.EquateConditions()); diff != "" {
+				t.Errorf("Reconcile(...): -want status, +got status:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.events, rec.events); diff != "" {
+				t.Errorf("Reconcile(...): -want events, +got events:\n%s", diff)
+			}
+		})
+	}
+}
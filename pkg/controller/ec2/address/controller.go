@@ -248,6 +248,11 @@ func (t *tagger) Initialize(ctx context.Context, mgd resource.Managed) error {
 	for _, t := range cr.Spec.ForProvider.Tags {
 		tagMap[t.Key] = t.Value
 	}
+	defaultTags, err := awsclient.GetDefaultTags(ctx, t.kube, mgd)
+	if err != nil {
+		return err
+	}
+	tagMap = awsclient.ApplyDefaultTags(mgd, tagMap, defaultTags)
 	for k, v := range resource.GetExternalTags(mgd) {
 		tagMap[k] = v
 	}
@@ -18,7 +18,9 @@ package instance
 
 import (
 	"context"
+	"fmt"
 	"sort"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
@@ -32,6 +34,8 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -51,10 +55,64 @@ const (
 	errCreate                   = "failed to create the Instance resource"
 	errUpdate                   = "failed to update Instance resource"
 	errModifyInstanceAttributes = "failed to modify the Instance resource attributes"
+	errModifyMetadataOptions    = "failed to modify the Instance resource metadata options"
+	errModifyMonitoring         = "failed to modify the Instance resource monitoring"
+	errStop                     = "failed to stop the Instance resource"
+	errStart                    = "failed to start the Instance resource"
 	errCreateTags               = "failed to create tags for the Instance resource"
 	errDelete                   = "failed to delete the Instance resource"
 )
 
+// typeStopStartUpdate Instances have all changes applied that require the
+// instance to be stopped.
+const typeStopStartUpdate xpv1.ConditionType = "StopStartUpdate"
+
+// Reasons the changes that require a stopped Instance are or are not applied.
+const (
+	reasonStopRequired xpv1.ConditionReason = "StopRequired"
+	reasonStopping     xpv1.ConditionReason = "Stopping"
+	reasonStarting     xpv1.ConditionReason = "Starting"
+	reasonApplied      xpv1.ConditionReason = "Applied"
+)
+
+func stopRequired(attributes []string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               typeStopStartUpdate,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             reasonStopRequired,
+		Message:            fmt.Sprintf("instance must be stopped to modify %s, stop it or set updateStrategy to StopStart", strings.Join(attributes, ", ")),
+	}
+}
+
+func stopping(attributes []string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               typeStopStartUpdate,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             reasonStopping,
+		Message:            fmt.Sprintf("stopping instance to modify %s", strings.Join(attributes, ", ")),
+	}
+}
+
+func starting() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               typeStopStartUpdate,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             reasonStarting,
+	}
+}
+
+func applied() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               typeStopStartUpdate,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             reasonApplied,
+	}
+}
+
 // SetupInstance adds a controller that reconciles Instances.
 func SetupInstance(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName(svcapitypes.InstanceGroupKind)
@@ -151,6 +209,68 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 	// update the CRD spec for any new values from provider
 	current := cr.Spec.ForProvider.DeepCopy()
 
+	o, err := e.describeAttributes(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errDescribe)
+	}
+
+	ec2.LateInitializeInstance(&cr.Spec.ForProvider, &observed, &o)
+
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateFailed)
+		}
+	}
+
+	observation := ec2.GenerateInstanceObservation(observed)
+	condition := ec2.GenerateInstanceCondition(observation)
+	if cr.Spec.ForProvider.DesiredState != nil {
+		condition = ec2.GenerateInstanceDesiredStateCondition(*cr.Spec.ForProvider.DesiredState, observation)
+	}
+
+	switch condition {
+	case ec2.Creating:
+		cr.SetConditions(xpv1.Creating())
+	case ec2.Available:
+		cr.SetConditions(xpv1.Available())
+	case ec2.Unavailable:
+		cr.SetConditions(xpv1.Unavailable())
+	case ec2.Deleting:
+		cr.SetConditions(xpv1.Deleting())
+	case ec2.Deleted:
+		// Terminated instances remain visible on API calls for a time before
+		// being automatically deleted. Rather than having the delete command
+		// hang for that entire time, return an empty ExternalObservation in
+		// this case.
+		//
+		// ref: (https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/terminating-instances.html)
+		return managed.ExternalObservation{}, nil
+	}
+
+	cr.Status.AtProvider = observation
+
+	upToDate := ec2.IsInstanceUpToDate(cr.Spec.ForProvider, observed, o) &&
+		ec2.IsInstanceInDesiredState(awsclient.StringValue(cr.Spec.ForProvider.DesiredState), observation.State)
+	switch cr.GetCondition(typeStopStartUpdate).Reason {
+	case reasonStopping:
+		// The instance still has to be started again by Update.
+		upToDate = false
+	case reasonStarting:
+		if observation.State == string(types.InstanceStateNameRunning) {
+			cr.SetConditions(applied())
+		}
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        upToDate,
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+// describeAttributes returns all attributes of the given instance that are
+// compared against the spec.
+func (e *external) describeAttributes(ctx context.Context, id string) (awsec2.DescribeInstanceAttributeOutput, error) {
 	o := awsec2.DescribeInstanceAttributeOutput{}
 
 	for _, input := range []types.InstanceAttributeName{
@@ -163,12 +283,12 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 		types.InstanceAttributeNameUserData,
 	} {
 		r, err := e.client.DescribeInstanceAttribute(ctx, &awsec2.DescribeInstanceAttributeInput{
-			InstanceId: aws.String(meta.GetExternalName(cr)),
+			InstanceId: aws.String(id),
 			Attribute:  input,
 		})
 
 		if err != nil {
-			return managed.ExternalObservation{}, awsclient.Wrap(err, errDescribe)
+			return o, err
 		}
 
 		if r.DisableApiTermination != nil {
@@ -200,41 +320,7 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 		}
 	}
 
-	ec2.LateInitializeInstance(&cr.Spec.ForProvider, &observed, &o)
-
-	if !cmp.Equal(current, &cr.Spec.ForProvider) {
-		if err := e.kube.Update(ctx, cr); err != nil {
-			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateFailed)
-		}
-	}
-
-	observation := ec2.GenerateInstanceObservation(observed)
-	condition := ec2.GenerateInstanceCondition(observation)
-
-	switch condition {
-	case ec2.Creating:
-		cr.SetConditions(xpv1.Creating())
-	case ec2.Available:
-		cr.SetConditions(xpv1.Available())
-	case ec2.Deleting:
-		cr.SetConditions(xpv1.Deleting())
-	case ec2.Deleted:
-		// Terminated instances remain visible on API calls for a time before
-		// being automatically deleted. Rather than having the delete command
-		// hang for that entire time, return an empty ExternalObservation in
-		// this case.
-		//
-		// ref: (https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/terminating-instances.html)
-		return managed.ExternalObservation{}, nil
-	}
-
-	cr.Status.AtProvider = observation
-
-	return managed.ExternalObservation{
-		ResourceExists:          true,
-		ResourceUpToDate:        ec2.IsInstanceUpToDate(cr.Spec.ForProvider, observed, o),
-		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
-	}, nil
+	return o, nil
 }
 
 func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
@@ -270,9 +356,15 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
 	}
 
+	id := meta.GetExternalName(cr)
+	attributes, err := e.describeAttributes(ctx, id)
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errDescribe)
+	}
+
 	if cr.Spec.ForProvider.DisableAPITermination != nil {
 		modifyInput := &awsec2.ModifyInstanceAttributeInput{
-			InstanceId: aws.String(meta.GetExternalName(cr)),
+			InstanceId: aws.String(id),
 			DisableApiTermination: &types.AttributeBooleanValue{
 				Value: cr.Spec.ForProvider.DisableAPITermination,
 			},
@@ -286,7 +378,7 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 
 	if cr.Spec.ForProvider.InstanceInitiatedShutdownBehavior != "" {
 		modifyInput := &awsec2.ModifyInstanceAttributeInput{
-			InstanceId: aws.String(meta.GetExternalName(cr)),
+			InstanceId: aws.String(id),
 			InstanceInitiatedShutdownBehavior: &types.AttributeValue{
 				Value: aws.String(cr.Spec.ForProvider.InstanceInitiatedShutdownBehavior),
 			},
@@ -298,12 +390,10 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		}
 	}
 
-	if cr.Spec.ForProvider.KernelID != nil {
+	if len(cr.Spec.ForProvider.SecurityGroupIDs) != 0 && !securityGroupsUpToDate(cr.Spec.ForProvider.SecurityGroupIDs, cr.Status.AtProvider.SecurityGroups) {
 		modifyInput := &awsec2.ModifyInstanceAttributeInput{
-			InstanceId: aws.String(meta.GetExternalName(cr)),
-			Kernel: &types.AttributeValue{
-				Value: cr.Spec.ForProvider.KernelID,
-			},
+			InstanceId: aws.String(id),
+			Groups:     cr.Spec.ForProvider.SecurityGroupIDs,
 		}
 		_, err := e.client.ModifyInstanceAttribute(ctx, modifyInput)
 
@@ -312,42 +402,159 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		}
 	}
 
-	if cr.Spec.ForProvider.RAMDiskID != nil {
-		modifyInput := &awsec2.ModifyInstanceAttributeInput{
-			InstanceId: aws.String(meta.GetExternalName(cr)),
-			Ramdisk: &types.AttributeValue{
-				Value: cr.Spec.ForProvider.RAMDiskID,
-			},
-		}
-		_, err := e.client.ModifyInstanceAttribute(ctx, modifyInput)
+	if !ec2.IsInstanceMetadataOptionsUpToDate(cr.Spec.ForProvider.MetadataOptions, cr.Status.AtProvider.MetadataOptions) {
+		_, err := e.client.ModifyInstanceMetadataOptions(ctx, ec2.GenerateModifyInstanceMetadataOptionsInput(id, cr.Spec.ForProvider.MetadataOptions))
 
 		if err != nil {
-			return managed.ExternalUpdate{}, awsclient.Wrap(err, errModifyInstanceAttributes)
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errModifyMetadataOptions)
 		}
 	}
 
-	if cr.Spec.ForProvider.UserData != nil {
-		modifyInput := &awsec2.ModifyInstanceAttributeInput{
-			InstanceId: aws.String(meta.GetExternalName(cr)),
-			UserData: &types.BlobAttributeValue{
-				Value: []byte(*cr.Spec.ForProvider.UserData),
-			},
+	if !ec2.IsInstanceMonitoringUpToDate(cr.Spec.ForProvider.Monitoring, cr.Status.AtProvider.Monitoring) {
+		if err := e.updateMonitoring(ctx, id, awsclient.BoolValue(cr.Spec.ForProvider.Monitoring.Enabled)); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errModifyMonitoring)
 		}
-		_, err := e.client.ModifyInstanceAttribute(ctx, modifyInput)
+	}
 
-		if err != nil {
-			return managed.ExternalUpdate{}, awsclient.Wrap(err, errModifyInstanceAttributes)
-		}
+	if err := e.updateStopped(ctx, cr, ec2.GenerateStoppedInstanceModifications(id, cr.Spec.ForProvider, attributes)); err != nil {
+		return managed.ExternalUpdate{}, err
 	}
 
-	_, err := e.client.CreateTags(ctx, &awsec2.CreateTagsInput{
-		Resources: []string{meta.GetExternalName(cr)},
+	if err := e.updateState(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	_, err = e.client.CreateTags(ctx, &awsec2.CreateTagsInput{
+		Resources: []string{id},
 		Tags:      svcapitypes.GenerateEC2Tags(cr.Spec.ForProvider.Tags),
 	})
 
 	return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
 }
 
+func (e *external) updateMonitoring(ctx context.Context, id string, enabled bool) error {
+	if enabled {
+		_, err := e.client.MonitorInstances(ctx, &awsec2.MonitorInstancesInput{InstanceIds: []string{id}})
+		return err
+	}
+	_, err := e.client.UnmonitorInstances(ctx, &awsec2.UnmonitorInstancesInput{InstanceIds: []string{id}})
+	return err
+}
+
+// updateStopped applies the modifications that require a stopped instance.
+// A running instance is only stopped if the update strategy allows it, in
+// which case it is started again once the modifications are applied.
+func (e *external) updateStopped(ctx context.Context, cr *svcapitypes.Instance, mods []ec2.InstanceAttributeModification) error { //nolint:gocyclo
+	id := meta.GetExternalName(cr)
+	state := cr.Status.AtProvider.State
+	reason := cr.GetCondition(typeStopStartUpdate).Reason
+
+	if len(mods) == 0 {
+		switch {
+		case reason == reasonStopping && state == string(types.InstanceStateNameStopped) && !wantsStopped(cr):
+			return e.start(ctx, cr)
+		case reason == reasonStopping && state == string(types.InstanceStateNameStopped),
+			reason == reasonStopRequired:
+			cr.SetConditions(applied())
+		}
+		return nil
+	}
+
+	attributes := make([]string, len(mods))
+	for i, m := range mods {
+		attributes[i] = string(m.Attribute)
+	}
+
+	switch {
+	case state == string(types.InstanceStateNameStopped):
+		for _, m := range mods {
+			if _, err := e.client.ModifyInstanceAttribute(ctx, m.Input); err != nil {
+				return awsclient.Wrap(err, errModifyInstanceAttributes)
+			}
+		}
+		if reason == reasonStopping && !wantsStopped(cr) {
+			return e.start(ctx, cr)
+		}
+		cr.SetConditions(applied())
+	case wantsStopped(cr):
+		// updateState stops the instance, the modifications are applied
+		// once it is stopped.
+	case awsclient.StringValue(cr.Spec.ForProvider.UpdateStrategy) != svcapitypes.InstanceUpdateStrategyStopStart:
+		cr.SetConditions(stopRequired(attributes))
+	case state == string(types.InstanceStateNameRunning):
+		if _, err := e.client.StopInstances(ctx, &awsec2.StopInstancesInput{InstanceIds: []string{id}}); err != nil {
+			return awsclient.Wrap(err, errStop)
+		}
+		cr.SetConditions(stopping(attributes))
+	}
+	// Instances that are in transition between running and stopped are
+	// updated once they settled.
+	return nil
+}
+
+// updateState starts, stops or hibernates the instance if it is not in its
+// desired power state. Instances that are in transition or being stopped and
+// started to apply modifications are left alone until they settled.
+func (e *external) updateState(ctx context.Context, cr *svcapitypes.Instance) error {
+	switch cr.GetCondition(typeStopStartUpdate).Reason {
+	case reasonStopping, reasonStarting:
+		return nil
+	}
+
+	desired := awsclient.StringValue(cr.Spec.ForProvider.DesiredState)
+	state := cr.Status.AtProvider.State
+	id := meta.GetExternalName(cr)
+
+	switch {
+	case desired == svcapitypes.InstanceDesiredStateRunning && state == string(types.InstanceStateNameStopped):
+		_, err := e.client.StartInstances(ctx, &awsec2.StartInstancesInput{InstanceIds: []string{id}})
+		return awsclient.Wrap(err, errStart)
+	case wantsStopped(cr) && state == string(types.InstanceStateNameRunning):
+		_, err := e.client.StopInstances(ctx, &awsec2.StopInstancesInput{
+			InstanceIds: []string{id},
+			Hibernate:   aws.Bool(desired == svcapitypes.InstanceDesiredStateHibernated),
+		})
+		return awsclient.Wrap(err, errStop)
+	}
+	return nil
+}
+
+// wantsStopped returns true if the desired power state of the instance is
+// stopped or hibernated.
+func wantsStopped(cr *svcapitypes.Instance) bool {
+	switch awsclient.StringValue(cr.Spec.ForProvider.DesiredState) {
+	case svcapitypes.InstanceDesiredStateStopped, svcapitypes.InstanceDesiredStateHibernated:
+		return true
+	}
+	return false
+}
+
+func (e *external) start(ctx context.Context, cr *svcapitypes.Instance) error {
+	if _, err := e.client.StartInstances(ctx, &awsec2.StartInstancesInput{InstanceIds: []string{meta.GetExternalName(cr)}}); err != nil {
+		return awsclient.Wrap(err, errStart)
+	}
+	cr.SetConditions(starting())
+	return nil
+}
+
+// securityGroupsUpToDate returns true if the instance is in exactly the given
+// security groups.
+func securityGroupsUpToDate(ids []string, groups []svcapitypes.GroupIdentifier) bool {
+	if len(ids) != len(groups) {
+		return false
+	}
+	observed := make(map[string]bool, len(groups))
+	for _, g := range groups {
+		observed[g.GroupID] = true
+	}
+	for _, id := range ids {
+		if !observed[id] {
+			return false
+		}
+	}
+	return true
+}
+
 func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
 	cr, ok := mgd.(*svcapitypes.Instance)
 	if !ok {
@@ -376,6 +583,11 @@ func (t *tagger) Initialize(ctx context.Context, mgd resource.Managed) error {
 	for _, t := range cr.Spec.ForProvider.Tags {
 		tagMap[t.Key] = t.Value
 	}
+	defaultTags, err := awsclient.GetDefaultTags(ctx, t.kube, mgd)
+	if err != nil {
+		return err
+	}
+	tagMap = awsclient.ApplyDefaultTags(mgd, tagMap, defaultTags)
 	for k, v := range resource.GetExternalTags(mgd) {
 		tagMap[k] = v
 	}
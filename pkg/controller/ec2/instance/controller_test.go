@@ -79,6 +79,17 @@ func withTags(tagMaps ...map[string]string) instanceModifier {
 	return func(r *manualv1alpha1.Instance) { r.Spec.ForProvider.Tags = tagList }
 }
 
+func describeInstanceType(instanceType types.InstanceType) func(context.Context, *awsec2.DescribeInstanceAttributeInput, []func(*awsec2.Options)) (*awsec2.DescribeInstanceAttributeOutput, error) {
+	return func(ctx context.Context, input *awsec2.DescribeInstanceAttributeInput, opts []func(*awsec2.Options)) (*awsec2.DescribeInstanceAttributeOutput, error) {
+		return &awsec2.DescribeInstanceAttributeOutput{
+			InstanceId: &instanceID,
+			InstanceType: &types.AttributeValue{
+				Value: aws.String(string(instanceType)),
+			},
+		}, nil
+	}
+}
+
 func instance(m ...instanceModifier) *manualv1alpha1.Instance {
 	cr := &manualv1alpha1.Instance{}
 	for _, f := range m {
@@ -150,6 +161,178 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"StartedAfterStopStart": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockClient().Update,
+				},
+				instance: &fake.MockInstanceClient{
+					MockDescribeInstances: func(ctx context.Context, input *awsec2.DescribeInstancesInput, opts []func(*awsec2.Options)) (*awsec2.DescribeInstancesOutput, error) {
+						return &awsec2.DescribeInstancesOutput{
+							Reservations: []types.Reservation{{
+								Instances: []types.Instance{
+									{
+										InstanceId:   &instanceID,
+										InstanceType: types.InstanceTypeM5Large,
+										State: &types.InstanceState{
+											Name: types.InstanceStateNameRunning,
+										},
+									},
+								},
+							}},
+						}, nil
+					},
+					MockDescribeInstanceAttribute: describeInstanceType(types.InstanceTypeM5Large),
+				},
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					InstanceType: string(types.InstanceTypeM5Large),
+				}), withExternalName(instanceID), withConditions(starting())),
+			},
+			want: want{
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					InstanceType: string(types.InstanceTypeM5Large),
+				}), withStatus(manualv1alpha1.InstanceObservation{
+					InstanceID:   &instanceID,
+					InstanceType: string(types.InstanceTypeM5Large),
+					State:        "running",
+				}), withExternalName(instanceID),
+					withConditions(xpv1.Available(), applied())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"StoppedForStopStart": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockClient().Update,
+				},
+				instance: &fake.MockInstanceClient{
+					MockDescribeInstances: func(ctx context.Context, input *awsec2.DescribeInstancesInput, opts []func(*awsec2.Options)) (*awsec2.DescribeInstancesOutput, error) {
+						return &awsec2.DescribeInstancesOutput{
+							Reservations: []types.Reservation{{
+								Instances: []types.Instance{
+									{
+										InstanceId:   &instanceID,
+										InstanceType: types.InstanceTypeM5Large,
+										State: &types.InstanceState{
+											Name: types.InstanceStateNameStopped,
+										},
+									},
+								},
+							}},
+						}, nil
+					},
+					MockDescribeInstanceAttribute: describeInstanceType(types.InstanceTypeM5Large),
+				},
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					InstanceType: string(types.InstanceTypeM5Large),
+				}), withExternalName(instanceID), withConditions(stopping([]string{"instanceType"}))),
+			},
+			want: want{
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					InstanceType: string(types.InstanceTypeM5Large),
+				}), withStatus(manualv1alpha1.InstanceObservation{
+					InstanceID:   &instanceID,
+					InstanceType: string(types.InstanceTypeM5Large),
+					State:        "stopped",
+				}), withExternalName(instanceID),
+					withConditions(xpv1.Deleting(), stopping([]string{"instanceType"}))),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"DesiredStateStopped": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockClient().Update,
+				},
+				instance: &fake.MockInstanceClient{
+					MockDescribeInstances: func(ctx context.Context, input *awsec2.DescribeInstancesInput, opts []func(*awsec2.Options)) (*awsec2.DescribeInstancesOutput, error) {
+						return &awsec2.DescribeInstancesOutput{
+							Reservations: []types.Reservation{{
+								Instances: []types.Instance{
+									{
+										InstanceId:   &instanceID,
+										InstanceType: types.InstanceTypeM1Small,
+										State: &types.InstanceState{
+											Name: types.InstanceStateNameStopped,
+										},
+									},
+								},
+							}},
+						}, nil
+					},
+					MockDescribeInstanceAttribute: describeInstanceType(types.InstanceTypeM1Small),
+				},
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					InstanceType: string(types.InstanceTypeM1Small),
+					DesiredState: aws.String(manualv1alpha1.InstanceDesiredStateStopped),
+				}), withExternalName(instanceID)),
+			},
+			want: want{
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					InstanceType: string(types.InstanceTypeM1Small),
+					DesiredState: aws.String(manualv1alpha1.InstanceDesiredStateStopped),
+				}), withStatus(manualv1alpha1.InstanceObservation{
+					InstanceID:   &instanceID,
+					InstanceType: string(types.InstanceTypeM1Small),
+					State:        "stopped",
+				}), withExternalName(instanceID),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"DesiredStateRunningButStopped": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockClient().Update,
+				},
+				instance: &fake.MockInstanceClient{
+					MockDescribeInstances: func(ctx context.Context, input *awsec2.DescribeInstancesInput, opts []func(*awsec2.Options)) (*awsec2.DescribeInstancesOutput, error) {
+						return &awsec2.DescribeInstancesOutput{
+							Reservations: []types.Reservation{{
+								Instances: []types.Instance{
+									{
+										InstanceId:   &instanceID,
+										InstanceType: types.InstanceTypeM1Small,
+										State: &types.InstanceState{
+											Name: types.InstanceStateNameStopped,
+										},
+									},
+								},
+							}},
+						}, nil
+					},
+					MockDescribeInstanceAttribute: describeInstanceType(types.InstanceTypeM1Small),
+				},
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					InstanceType: string(types.InstanceTypeM1Small),
+					DesiredState: aws.String(manualv1alpha1.InstanceDesiredStateRunning),
+				}), withExternalName(instanceID)),
+			},
+			want: want{
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					InstanceType: string(types.InstanceTypeM1Small),
+					DesiredState: aws.String(manualv1alpha1.InstanceDesiredStateRunning),
+				}), withStatus(manualv1alpha1.InstanceObservation{
+					InstanceID:   &instanceID,
+					InstanceType: string(types.InstanceTypeM1Small),
+					State:        "stopped",
+				}), withExternalName(instanceID),
+					withConditions(xpv1.Unavailable())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
 		"MultipleInstances": {
 			args: args{
 				kube: &test.MockClient{
@@ -375,6 +558,7 @@ func TestUpdate(t *testing.T) {
 					MockModifyInstanceAttribute: func(ctx context.Context, input *awsec2.ModifyInstanceAttributeInput, opts []func(*awsec2.Options)) (*awsec2.ModifyInstanceAttributeOutput, error) {
 						return &awsec2.ModifyInstanceAttributeOutput{}, nil
 					},
+					MockDescribeInstanceAttribute: describeInstanceType(types.InstanceTypeM1Small),
 				},
 				cr: instance(withSpec(manualv1alpha1.InstanceParameters{})),
 			},
@@ -391,6 +575,7 @@ func TestUpdate(t *testing.T) {
 					MockModifyInstanceAttribute: func(ctx context.Context, input *awsec2.ModifyInstanceAttributeInput, opts []func(*awsec2.Options)) (*awsec2.ModifyInstanceAttributeOutput, error) {
 						return &awsec2.ModifyInstanceAttributeOutput{}, nil
 					},
+					MockDescribeInstanceAttribute: describeInstanceType(types.InstanceTypeM1Small),
 				},
 				cr: instance(withSpec(manualv1alpha1.InstanceParameters{})),
 			},
@@ -399,6 +584,320 @@ func TestUpdate(t *testing.T) {
 				err: awsclient.Wrap(errBoom, errUpdate),
 			},
 		},
+		"DescribeAttributeFailed": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockDescribeInstanceAttribute: func(ctx context.Context, input *awsec2.DescribeInstanceAttributeInput, opts []func(*awsec2.Options)) (*awsec2.DescribeInstanceAttributeOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{})),
+			},
+			want: want{
+				cr:  instance(withSpec(manualv1alpha1.InstanceParameters{})),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+		"HotChanges": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockCreateTags: func(ctx context.Context, input *awsec2.CreateTagsInput, opts []func(*awsec2.Options)) (*awsec2.CreateTagsOutput, error) {
+						return &awsec2.CreateTagsOutput{}, nil
+					},
+					MockModifyInstanceAttribute: func(ctx context.Context, input *awsec2.ModifyInstanceAttributeInput, opts []func(*awsec2.Options)) (*awsec2.ModifyInstanceAttributeOutput, error) {
+						if diff := cmp.Diff([]string{"sg-2"}, input.Groups); diff != "" {
+							return nil, errors.New(diff)
+						}
+						return &awsec2.ModifyInstanceAttributeOutput{}, nil
+					},
+					MockModifyInstanceMetadataOptions: func(ctx context.Context, input *awsec2.ModifyInstanceMetadataOptionsInput, opts []func(*awsec2.Options)) (*awsec2.ModifyInstanceMetadataOptionsOutput, error) {
+						if input.HttpTokens != types.HttpTokensStateRequired {
+							return nil, errBoom
+						}
+						return &awsec2.ModifyInstanceMetadataOptionsOutput{}, nil
+					},
+					MockMonitorInstances: func(ctx context.Context, input *awsec2.MonitorInstancesInput, opts []func(*awsec2.Options)) (*awsec2.MonitorInstancesOutput, error) {
+						return &awsec2.MonitorInstancesOutput{}, nil
+					},
+					MockDescribeInstanceAttribute: describeInstanceType(types.InstanceTypeM1Small),
+				},
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					SecurityGroupIDs: []string{"sg-2"},
+					MetadataOptions:  &manualv1alpha1.InstanceMetadataOptionsRequest{HTTPTokens: "required"},
+					Monitoring:       &manualv1alpha1.RunInstancesMonitoringEnabled{Enabled: aws.Bool(true)},
+				}), withStatus(manualv1alpha1.InstanceObservation{
+					State:           "running",
+					SecurityGroups:  []manualv1alpha1.GroupIdentifier{{GroupID: "sg-1"}},
+					MetadataOptions: &manualv1alpha1.InstanceMetadataOptionsRequest{HTTPTokens: "optional"},
+					Monitoring:      &manualv1alpha1.Monitoring{State: "disabled"},
+				})),
+			},
+			want: want{
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					SecurityGroupIDs: []string{"sg-2"},
+					MetadataOptions:  &manualv1alpha1.InstanceMetadataOptionsRequest{HTTPTokens: "required"},
+					Monitoring:       &manualv1alpha1.RunInstancesMonitoringEnabled{Enabled: aws.Bool(true)},
+				}), withStatus(manualv1alpha1.InstanceObservation{
+					State:           "running",
+					SecurityGroups:  []manualv1alpha1.GroupIdentifier{{GroupID: "sg-1"}},
+					MetadataOptions: &manualv1alpha1.InstanceMetadataOptionsRequest{HTTPTokens: "optional"},
+					Monitoring:      &manualv1alpha1.Monitoring{State: "disabled"},
+				})),
+			},
+		},
+		"MonitoringFailed": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockUnmonitorInstances: func(ctx context.Context, input *awsec2.UnmonitorInstancesInput, opts []func(*awsec2.Options)) (*awsec2.UnmonitorInstancesOutput, error) {
+						return nil, errBoom
+					},
+					MockDescribeInstanceAttribute: describeInstanceType(types.InstanceTypeM1Small),
+				},
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					Monitoring: &manualv1alpha1.RunInstancesMonitoringEnabled{Enabled: aws.Bool(false)},
+				}), withStatus(manualv1alpha1.InstanceObservation{
+					Monitoring: &manualv1alpha1.Monitoring{State: "enabled"},
+				})),
+			},
+			want: want{
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					Monitoring: &manualv1alpha1.RunInstancesMonitoringEnabled{Enabled: aws.Bool(false)},
+				}), withStatus(manualv1alpha1.InstanceObservation{
+					Monitoring: &manualv1alpha1.Monitoring{State: "enabled"},
+				})),
+				err: awsclient.Wrap(errBoom, errModifyMonitoring),
+			},
+		},
+		"StopRequired": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockCreateTags: func(ctx context.Context, input *awsec2.CreateTagsInput, opts []func(*awsec2.Options)) (*awsec2.CreateTagsOutput, error) {
+						return &awsec2.CreateTagsOutput{}, nil
+					},
+					MockDescribeInstanceAttribute: describeInstanceType(types.InstanceTypeM1Small),
+				},
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					InstanceType: string(types.InstanceTypeM5Large),
+				}), withStatus(manualv1alpha1.InstanceObservation{
+					State: "running",
+				})),
+			},
+			want: want{
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					InstanceType: string(types.InstanceTypeM5Large),
+				}), withStatus(manualv1alpha1.InstanceObservation{
+					State: "running",
+				}), withConditions(stopRequired([]string{"instanceType"}))),
+			},
+		},
+		"StopStartStopsRunningInstance": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockCreateTags: func(ctx context.Context, input *awsec2.CreateTagsInput, opts []func(*awsec2.Options)) (*awsec2.CreateTagsOutput, error) {
+						return &awsec2.CreateTagsOutput{}, nil
+					},
+					MockStopInstances: func(ctx context.Context, input *awsec2.StopInstancesInput, opts []func(*awsec2.Options)) (*awsec2.StopInstancesOutput, error) {
+						return &awsec2.StopInstancesOutput{}, nil
+					},
+					MockDescribeInstanceAttribute: describeInstanceType(types.InstanceTypeM1Small),
+				},
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					InstanceType:   string(types.InstanceTypeM5Large),
+					UpdateStrategy: aws.String(manualv1alpha1.InstanceUpdateStrategyStopStart),
+				}), withStatus(manualv1alpha1.InstanceObservation{
+					State: "running",
+				})),
+			},
+			want: want{
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					InstanceType:   string(types.InstanceTypeM5Large),
+					UpdateStrategy: aws.String(manualv1alpha1.InstanceUpdateStrategyStopStart),
+				}), withStatus(manualv1alpha1.InstanceObservation{
+					State: "running",
+				}), withConditions(stopping([]string{"instanceType"}))),
+			},
+		},
+		"StopStartStopFailed": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockStopInstances: func(ctx context.Context, input *awsec2.StopInstancesInput, opts []func(*awsec2.Options)) (*awsec2.StopInstancesOutput, error) {
+						return nil, errBoom
+					},
+					MockDescribeInstanceAttribute: describeInstanceType(types.InstanceTypeM1Small),
+				},
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					InstanceType:   string(types.InstanceTypeM5Large),
+					UpdateStrategy: aws.String(manualv1alpha1.InstanceUpdateStrategyStopStart),
+				}), withStatus(manualv1alpha1.InstanceObservation{
+					State: "running",
+				})),
+			},
+			want: want{
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					InstanceType:   string(types.InstanceTypeM5Large),
+					UpdateStrategy: aws.String(manualv1alpha1.InstanceUpdateStrategyStopStart),
+				}), withStatus(manualv1alpha1.InstanceObservation{
+					State: "running",
+				})),
+				err: awsclient.Wrap(errBoom, errStop),
+			},
+		},
+		"StopStartModifiesAndStartsStoppedInstance": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockCreateTags: func(ctx context.Context, input *awsec2.CreateTagsInput, opts []func(*awsec2.Options)) (*awsec2.CreateTagsOutput, error) {
+						return &awsec2.CreateTagsOutput{}, nil
+					},
+					MockModifyInstanceAttribute: func(ctx context.Context, input *awsec2.ModifyInstanceAttributeInput, opts []func(*awsec2.Options)) (*awsec2.ModifyInstanceAttributeOutput, error) {
+						if input.InstanceType == nil || aws.ToString(input.InstanceType.Value) != string(types.InstanceTypeM5Large) {
+							return nil, errBoom
+						}
+						return &awsec2.ModifyInstanceAttributeOutput{}, nil
+					},
+					MockStartInstances: func(ctx context.Context, input *awsec2.StartInstancesInput, opts []func(*awsec2.Options)) (*awsec2.StartInstancesOutput, error) {
+						return &awsec2.StartInstancesOutput{}, nil
+					},
+					MockDescribeInstanceAttribute: describeInstanceType(types.InstanceTypeM1Small),
+				},
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					InstanceType:   string(types.InstanceTypeM5Large),
+					UpdateStrategy: aws.String(manualv1alpha1.InstanceUpdateStrategyStopStart),
+				}), withStatus(manualv1alpha1.InstanceObservation{
+					State: "stopped",
+				}), withConditions(stopping([]string{"instanceType"}))),
+			},
+			want: want{
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					InstanceType:   string(types.InstanceTypeM5Large),
+					UpdateStrategy: aws.String(manualv1alpha1.InstanceUpdateStrategyStopStart),
+				}), withStatus(manualv1alpha1.InstanceObservation{
+					State: "stopped",
+				}), withConditions(starting())),
+			},
+		},
+		"ModifiesInstanceStoppedByUser": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockCreateTags: func(ctx context.Context, input *awsec2.CreateTagsInput, opts []func(*awsec2.Options)) (*awsec2.CreateTagsOutput, error) {
+						return &awsec2.CreateTagsOutput{}, nil
+					},
+					MockModifyInstanceAttribute: func(ctx context.Context, input *awsec2.ModifyInstanceAttributeInput, opts []func(*awsec2.Options)) (*awsec2.ModifyInstanceAttributeOutput, error) {
+						return &awsec2.ModifyInstanceAttributeOutput{}, nil
+					},
+					MockDescribeInstanceAttribute: describeInstanceType(types.InstanceTypeM1Small),
+				},
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					InstanceType: string(types.InstanceTypeM5Large),
+				}), withStatus(manualv1alpha1.InstanceObservation{
+					State: "stopped",
+				}), withConditions(stopRequired([]string{"instanceType"}))),
+			},
+			want: want{
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					InstanceType: string(types.InstanceTypeM5Large),
+				}), withStatus(manualv1alpha1.InstanceObservation{
+					State: "stopped",
+				}), withConditions(applied())),
+			},
+		},
+		"StartsStoppedInstance": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockCreateTags: func(ctx context.Context, input *awsec2.CreateTagsInput, opts []func(*awsec2.Options)) (*awsec2.CreateTagsOutput, error) {
+						return &awsec2.CreateTagsOutput{}, nil
+					},
+					MockStartInstances: func(ctx context.Context, input *awsec2.StartInstancesInput, opts []func(*awsec2.Options)) (*awsec2.StartInstancesOutput, error) {
+						return &awsec2.StartInstancesOutput{}, nil
+					},
+					MockDescribeInstanceAttribute: describeInstanceType(types.InstanceTypeM1Small),
+				},
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					DesiredState: aws.String(manualv1alpha1.InstanceDesiredStateRunning),
+				}), withStatus(manualv1alpha1.InstanceObservation{
+					State: "stopped",
+				})),
+			},
+			want: want{
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					DesiredState: aws.String(manualv1alpha1.InstanceDesiredStateRunning),
+				}), withStatus(manualv1alpha1.InstanceObservation{
+					State: "stopped",
+				})),
+			},
+		},
+		"StartFailed": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockStartInstances: func(ctx context.Context, input *awsec2.StartInstancesInput, opts []func(*awsec2.Options)) (*awsec2.StartInstancesOutput, error) {
+						return nil, errBoom
+					},
+					MockDescribeInstanceAttribute: describeInstanceType(types.InstanceTypeM1Small),
+				},
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					DesiredState: aws.String(manualv1alpha1.InstanceDesiredStateRunning),
+				}), withStatus(manualv1alpha1.InstanceObservation{
+					State: "stopped",
+				})),
+			},
+			want: want{
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					DesiredState: aws.String(manualv1alpha1.InstanceDesiredStateRunning),
+				}), withStatus(manualv1alpha1.InstanceObservation{
+					State: "stopped",
+				})),
+				err: awsclient.Wrap(errBoom, errStart),
+			},
+		},
+		"HibernatesRunningInstance": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockCreateTags: func(ctx context.Context, input *awsec2.CreateTagsInput, opts []func(*awsec2.Options)) (*awsec2.CreateTagsOutput, error) {
+						return &awsec2.CreateTagsOutput{}, nil
+					},
+					MockStopInstances: func(ctx context.Context, input *awsec2.StopInstancesInput, opts []func(*awsec2.Options)) (*awsec2.StopInstancesOutput, error) {
+						if !aws.ToBool(input.Hibernate) {
+							return nil, errBoom
+						}
+						return &awsec2.StopInstancesOutput{}, nil
+					},
+					MockDescribeInstanceAttribute: describeInstanceType(types.InstanceTypeM1Small),
+				},
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					DesiredState: aws.String(manualv1alpha1.InstanceDesiredStateHibernated),
+				}), withStatus(manualv1alpha1.InstanceObservation{
+					State: "running",
+				})),
+			},
+			want: want{
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					DesiredState: aws.String(manualv1alpha1.InstanceDesiredStateHibernated),
+				}), withStatus(manualv1alpha1.InstanceObservation{
+					State: "running",
+				})),
+			},
+		},
+		"WaitsForStoppingInstance": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockCreateTags: func(ctx context.Context, input *awsec2.CreateTagsInput, opts []func(*awsec2.Options)) (*awsec2.CreateTagsOutput, error) {
+						return &awsec2.CreateTagsOutput{}, nil
+					},
+					MockDescribeInstanceAttribute: describeInstanceType(types.InstanceTypeM1Small),
+				},
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					DesiredState: aws.String(manualv1alpha1.InstanceDesiredStateRunning),
+				}), withStatus(manualv1alpha1.InstanceObservation{
+					State: "stopping",
+				})),
+			},
+			want: want{
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					DesiredState: aws.String(manualv1alpha1.InstanceDesiredStateRunning),
+				}), withStatus(manualv1alpha1.InstanceObservation{
+					State: "stopping",
+				})),
+			},
+		},
 	}
 
 	for name, tc := range cases {
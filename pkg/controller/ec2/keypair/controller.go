@@ -0,0 +1,287 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package keypair
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a KeyPair resource"
+
+	errDescribe         = "failed to describe KeyPair"
+	errMultipleItems    = "retrieved multiple KeyPairs for the given key name"
+	errCreate           = "failed to create the KeyPair resource"
+	errImport           = "failed to import the KeyPair resource"
+	errDelete           = "failed to delete the KeyPair resource"
+	errCreateTags       = "failed to create tags for the KeyPair resource"
+	errDeleteTags       = "failed to delete tags for the KeyPair resource"
+	errPublicKeySources = "only one of publicKeyMaterial and publicKeySecretRef can be set"
+	errGetSecret        = "cannot get public key Secret"
+	errFmtKeyNotFound   = "key %s not found"
+
+	// ConnectionKeyPrivateKey is the key of the private key material of a
+	// created key pair in the connection secret.
+	ConnectionKeyPrivateKey = "privateKey"
+	// ConnectionKeyFingerprint is the key of the fingerprint of the key pair
+	// in the connection secret.
+	ConnectionKeyFingerprint = "fingerprint"
+)
+
+// SetupKeyPair adds a controller that reconciles KeyPairs.
+func SetupKeyPair(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(manualv1alpha1.KeyPairGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewKeyPairClient}),
+		managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(manualv1alpha1.KeyPairGroupVersionKind),
+		reconcilerOpts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&manualv1alpha1.KeyPair{}).
+		Complete(r)
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.KeyPairClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*manualv1alpha1.KeyPair)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{kube: c.kube, client: c.newClientFn(*cfg)}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client ec2.KeyPairClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*manualv1alpha1.KeyPair)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	response, err := e.client.DescribeKeyPairs(ctx, &awsec2.DescribeKeyPairsInput{
+		KeyNames: []string{meta.GetExternalName(cr)},
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(ec2.IsKeyPairNotFoundErr, err), errDescribe)
+	}
+
+	// in a successful response, there should be one and only one object
+	if len(response.KeyPairs) != 1 {
+		return managed.ExternalObservation{}, errors.New(errMultipleItems)
+	}
+
+	observed := response.KeyPairs[0]
+	current := cr.Spec.ForProvider.DeepCopy()
+	ec2.LateInitializeKeyPair(&cr.Spec.ForProvider, &observed)
+
+	cr.SetConditions(xpv1.Available())
+	cr.Status.AtProvider = ec2.GenerateKeyPairObservation(observed)
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        ec2.IsKeyPairUpToDate(cr.Spec.ForProvider, observed),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*manualv1alpha1.KeyPair)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	var tagSpecifications []awsec2types.TagSpecification
+	if len(cr.Spec.ForProvider.Tags) > 0 {
+		tagSpecifications = []awsec2types.TagSpecification{{
+			ResourceType: awsec2types.ResourceTypeKeyPair,
+			Tags:         manualv1alpha1.GenerateEC2Tags(cr.Spec.ForProvider.Tags),
+		}}
+	}
+
+	publicKey, err := e.publicKey(ctx, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	if publicKey != nil {
+		result, err := e.client.ImportKeyPair(ctx, &awsec2.ImportKeyPairInput{
+			KeyName:           aws.String(meta.GetExternalName(cr)),
+			PublicKeyMaterial: publicKey,
+			TagSpecifications: tagSpecifications,
+		})
+		if err != nil {
+			return managed.ExternalCreation{}, awsclient.Wrap(err, errImport)
+		}
+		return managed.ExternalCreation{
+			ConnectionDetails: managed.ConnectionDetails{
+				ConnectionKeyFingerprint: []byte(aws.ToString(result.KeyFingerprint)),
+			},
+		}, nil
+	}
+
+	input := &awsec2.CreateKeyPairInput{
+		KeyName:           aws.String(meta.GetExternalName(cr)),
+		TagSpecifications: tagSpecifications,
+	}
+	if cr.Spec.ForProvider.KeyType != nil {
+		input.KeyType = awsec2types.KeyType(aws.ToString(cr.Spec.ForProvider.KeyType))
+	}
+	result, err := e.client.CreateKeyPair(ctx, input)
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+	// The private key material is only returned once, when the key pair is
+	// created, so it has to be published right away.
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{
+			ConnectionKeyPrivateKey:  []byte(aws.ToString(result.KeyMaterial)),
+			ConnectionKeyFingerprint: []byte(aws.ToString(result.KeyFingerprint)),
+		},
+	}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*manualv1alpha1.KeyPair)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	response, err := e.client.DescribeKeyPairs(ctx, &awsec2.DescribeKeyPairsInput{
+		KeyNames: []string{meta.GetExternalName(cr)},
+	})
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errDescribe)
+	}
+	if len(response.KeyPairs) != 1 {
+		return managed.ExternalUpdate{}, errors.New(errMultipleItems)
+	}
+	kp := response.KeyPairs[0]
+
+	addTags, removeTags := ec2.DiffEC2Tags(manualv1alpha1.GenerateEC2Tags(cr.Spec.ForProvider.Tags), kp.Tags)
+	if len(addTags) > 0 {
+		if _, err := e.client.CreateTags(ctx, &awsec2.CreateTagsInput{
+			Resources: []string{aws.ToString(kp.KeyPairId)},
+			Tags:      addTags,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errCreateTags)
+		}
+	}
+	if len(removeTags) > 0 {
+		if _, err := e.client.DeleteTags(ctx, &awsec2.DeleteTagsInput{
+			Resources: []string{aws.ToString(kp.KeyPairId)},
+			Tags:      removeTags,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errDeleteTags)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*manualv1alpha1.KeyPair)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.DeleteKeyPair(ctx, &awsec2.DeleteKeyPairInput{
+		KeyName: aws.String(meta.GetExternalName(cr)),
+	})
+	return awsclient.Wrap(resource.Ignore(ec2.IsKeyPairNotFoundErr, err), errDelete)
+}
+
+// publicKey returns the public key to import or nil if a new key pair has to
+// be created.
+func (e *external) publicKey(ctx context.Context, p manualv1alpha1.KeyPairParameters) ([]byte, error) {
+	switch {
+	case p.PublicKeyMaterial != nil && p.PublicKeySecretRef != nil:
+		return nil, errors.New(errPublicKeySources)
+	case p.PublicKeyMaterial != nil:
+		return []byte(aws.ToString(p.PublicKeyMaterial)), nil
+	case p.PublicKeySecretRef != nil:
+		s := &corev1.Secret{}
+		nn := types.NamespacedName{Name: p.PublicKeySecretRef.Name, Namespace: p.PublicKeySecretRef.Namespace}
+		if err := e.kube.Get(ctx, nn, s); err != nil {
+			return nil, errors.Wrap(err, errGetSecret)
+		}
+		b, ok := s.Data[p.PublicKeySecretRef.Key]
+		if !ok {
+			return nil, errors.Errorf(errFmtKeyNotFound, p.PublicKeySecretRef.Key)
+		}
+		return b, nil
+	}
+	return nil, nil
+}
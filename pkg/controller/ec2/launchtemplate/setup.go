@@ -121,6 +121,11 @@ func (t *tagger) Initialize(ctx context.Context, mgd resource.Managed) error {
 	for _, t := range launchTemplateTags.Tags {
 		tagMap[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
 	}
+	defaultTags, err := aws.GetDefaultTags(ctx, t.kube, mgd)
+	if err != nil {
+		return err
+	}
+	tagMap = aws.ApplyDefaultTags(mgd, tagMap, defaultTags)
 	for k, v := range resource.GetExternalTags(mgd) {
 		tagMap[k] = v
 	}
@@ -0,0 +1,290 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managedprefixlist
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a ManagedPrefixList resource"
+
+	errDescribe      = "failed to describe ManagedPrefixList"
+	errMultipleItems = "retrieved multiple ManagedPrefixLists for the given prefixListId"
+	errGetEntries    = "failed to get the entries of the ManagedPrefixList"
+	errCreate        = "failed to create the ManagedPrefixList resource"
+	errModify        = "failed to modify the ManagedPrefixList resource"
+	errDelete        = "failed to delete the ManagedPrefixList resource"
+	errCreateTags    = "failed to create tags for the ManagedPrefixList resource"
+	errDeleteTags    = "failed to delete tags for the ManagedPrefixList resource"
+)
+
+// SetupManagedPrefixList adds a controller that reconciles ManagedPrefixLists.
+func SetupManagedPrefixList(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(manualv1alpha1.ManagedPrefixListGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewManagedPrefixListClient}),
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(manualv1alpha1.ManagedPrefixListGroupVersionKind),
+		reconcilerOpts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&manualv1alpha1.ManagedPrefixList{}).
+		Complete(r)
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.ManagedPrefixListClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*manualv1alpha1.ManagedPrefixList)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg)}, nil
+}
+
+type external struct {
+	client ec2.ManagedPrefixListClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*manualv1alpha1.ManagedPrefixList)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	response, err := e.client.DescribeManagedPrefixLists(ctx, &awsec2.DescribeManagedPrefixListsInput{
+		PrefixListIds: []string{meta.GetExternalName(cr)},
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(ec2.IsPrefixListNotFoundErr, err), errDescribe)
+	}
+
+	// in a successful response, there should be one and only one object
+	if len(response.PrefixLists) != 1 {
+		return managed.ExternalObservation{}, errors.New(errMultipleItems)
+	}
+
+	observed := response.PrefixLists[0]
+	if observed.State == awsec2types.PrefixListStateDeleteComplete {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	entries, err := e.getEntries(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	ec2.LateInitializeManagedPrefixList(&cr.Spec.ForProvider, &observed)
+
+	cr.Status.AtProvider = ec2.GenerateManagedPrefixListObservation(observed)
+
+	switch observed.State { //nolint:exhaustive
+	case awsec2types.PrefixListStateCreateInProgress:
+		cr.SetConditions(xpv1.Creating())
+	case awsec2types.PrefixListStateDeleteInProgress:
+		cr.SetConditions(xpv1.Deleting())
+	case awsec2types.PrefixListStateCreateFailed, awsec2types.PrefixListStateDeleteFailed,
+		awsec2types.PrefixListStateModifyFailed, awsec2types.PrefixListStateRestoreFailed:
+		cr.SetConditions(xpv1.Unavailable().WithMessage(aws.ToString(observed.StateMessage)))
+	default:
+		cr.SetConditions(xpv1.Available())
+	}
+
+	// Modifications are rejected while a previous operation is still in
+	// progress, they are picked up once it has completed.
+	upToDate := true
+	if ec2.IsManagedPrefixListModifiable(observed.State) {
+		upToDate = ec2.IsManagedPrefixListUpToDate(cr.Spec.ForProvider, observed, entries)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        upToDate,
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*manualv1alpha1.ManagedPrefixList)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	input := &awsec2.CreateManagedPrefixListInput{
+		PrefixListName: aws.String(cr.Spec.ForProvider.PrefixListName),
+		MaxEntries:     aws.Int32(cr.Spec.ForProvider.MaxEntries),
+		AddressFamily:  aws.String(cr.Spec.ForProvider.AddressFamily),
+		Entries:        ec2.GenerateAddPrefixListEntries(cr.Spec.ForProvider.Entries),
+	}
+	if len(cr.Spec.ForProvider.Tags) > 0 {
+		input.TagSpecifications = []awsec2types.TagSpecification{{
+			ResourceType: awsec2types.ResourceTypePrefixList,
+			Tags:         manualv1alpha1.GenerateEC2Tags(cr.Spec.ForProvider.Tags),
+		}}
+	}
+	result, err := e.client.CreateManagedPrefixList(ctx, input)
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, aws.ToString(result.PrefixList.PrefixListId))
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*manualv1alpha1.ManagedPrefixList)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	response, err := e.client.DescribeManagedPrefixLists(ctx, &awsec2.DescribeManagedPrefixListsInput{
+		PrefixListIds: []string{meta.GetExternalName(cr)},
+	})
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errDescribe)
+	}
+	if len(response.PrefixLists) != 1 {
+		return managed.ExternalUpdate{}, errors.New(errMultipleItems)
+	}
+	pl := response.PrefixLists[0]
+
+	if err := e.updateTags(ctx, meta.GetExternalName(cr), cr.Spec.ForProvider.Tags, pl.Tags); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	entries, err := e.getEntries(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	input := ec2.GenerateModifyManagedPrefixListInput(cr.Spec.ForProvider, pl, entries)
+	if input == nil {
+		return managed.ExternalUpdate{}, nil
+	}
+	_, err = e.client.ModifyManagedPrefixList(ctx, input)
+	return managed.ExternalUpdate{}, awsclient.Wrap(err, errModify)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*manualv1alpha1.ManagedPrefixList)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	if cr.Status.AtProvider.State == string(awsec2types.PrefixListStateDeleteInProgress) {
+		return nil
+	}
+
+	_, err := e.client.DeleteManagedPrefixList(ctx, &awsec2.DeleteManagedPrefixListInput{
+		PrefixListId: aws.String(meta.GetExternalName(cr)),
+	})
+	return awsclient.Wrap(resource.Ignore(ec2.IsPrefixListNotFoundErr, err), errDelete)
+}
+
+// getEntries returns all entries of the current version of the prefix list.
+func (e *external) getEntries(ctx context.Context, id string) ([]awsec2types.PrefixListEntry, error) {
+	var entries []awsec2types.PrefixListEntry
+	input := &awsec2.GetManagedPrefixListEntriesInput{
+		PrefixListId: aws.String(id),
+	}
+	for {
+		response, err := e.client.GetManagedPrefixListEntries(ctx, input)
+		if err != nil {
+			return nil, awsclient.Wrap(err, errGetEntries)
+		}
+		entries = append(entries, response.Entries...)
+		if response.NextToken == nil {
+			return entries, nil
+		}
+		input.NextToken = response.NextToken
+	}
+}
+
+func (e *external) updateTags(ctx context.Context, id string, desired []manualv1alpha1.Tag, observed []awsec2types.Tag) error {
+	addTags, removeTags := ec2.DiffEC2Tags(manualv1alpha1.GenerateEC2Tags(desired), observed)
+	if len(addTags) > 0 {
+		if _, err := e.client.CreateTags(ctx, &awsec2.CreateTagsInput{
+			Resources: []string{id},
+			Tags:      addTags,
+		}); err != nil {
+			return awsclient.Wrap(err, errCreateTags)
+		}
+	}
+	if len(removeTags) > 0 {
+		if _, err := e.client.DeleteTags(ctx, &awsec2.DeleteTagsInput{
+			Resources: []string{id},
+			Tags:      removeTags,
+		}); err != nil {
+			return awsclient.Wrap(err, errDeleteTags)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,443 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managedprefixlist
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	prefixListID   = "pl-1"
+	prefixListName = "some-list"
+	cidr1          = "10.0.0.0/16"
+	cidr2          = "10.1.0.0/16"
+	errBoom        = errors.New("boom")
+)
+
+type args struct {
+	pl ec2.ManagedPrefixListClient
+	cr *manualv1alpha1.ManagedPrefixList
+}
+
+type prefixListModifier func(*manualv1alpha1.ManagedPrefixList)
+
+func withExternalName(name string) prefixListModifier {
+	return func(r *manualv1alpha1.ManagedPrefixList) { meta.SetExternalName(r, name) }
+}
+
+func withEntries(cidrs ...string) prefixListModifier {
+	return func(r *manualv1alpha1.ManagedPrefixList) {
+		for _, c := range cidrs {
+			r.Spec.ForProvider.Entries = append(r.Spec.ForProvider.Entries, manualv1alpha1.PrefixListEntry{CIDR: c})
+		}
+	}
+}
+
+func withStatus(s manualv1alpha1.ManagedPrefixListObservation) prefixListModifier {
+	return func(r *manualv1alpha1.ManagedPrefixList) { r.Status.AtProvider = s }
+}
+
+func withConditions(c ...xpv1.Condition) prefixListModifier {
+	return func(r *manualv1alpha1.ManagedPrefixList) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func managedPrefixList(m ...prefixListModifier) *manualv1alpha1.ManagedPrefixList {
+	cr := &manualv1alpha1.ManagedPrefixList{
+		Spec: manualv1alpha1.ManagedPrefixListSpec{
+			ForProvider: manualv1alpha1.ManagedPrefixListParameters{
+				PrefixListName: prefixListName,
+				MaxEntries:     5,
+				AddressFamily:  "IPv4",
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func observedPrefixList(state awsec2types.PrefixListState) awsec2types.ManagedPrefixList {
+	return awsec2types.ManagedPrefixList{
+		PrefixListId:   aws.String(prefixListID),
+		PrefixListName: aws.String(prefixListName),
+		MaxEntries:     aws.Int32(5),
+		AddressFamily:  aws.String("IPv4"),
+		State:          state,
+		Version:        aws.Int64(2),
+	}
+}
+
+func describe(pl awsec2types.ManagedPrefixList) func(context.Context, *awsec2.DescribeManagedPrefixListsInput, []func(*awsec2.Options)) (*awsec2.DescribeManagedPrefixListsOutput, error) {
+	return func(context.Context, *awsec2.DescribeManagedPrefixListsInput, []func(*awsec2.Options)) (*awsec2.DescribeManagedPrefixListsOutput, error) {
+		return &awsec2.DescribeManagedPrefixListsOutput{PrefixLists: []awsec2types.ManagedPrefixList{pl}}, nil
+	}
+}
+
+func getEntries(cidrs ...string) func(context.Context, *awsec2.GetManagedPrefixListEntriesInput, []func(*awsec2.Options)) (*awsec2.GetManagedPrefixListEntriesOutput, error) {
+	return func(context.Context, *awsec2.GetManagedPrefixListEntriesInput, []func(*awsec2.Options)) (*awsec2.GetManagedPrefixListEntriesOutput, error) {
+		out := &awsec2.GetManagedPrefixListEntriesOutput{}
+		for _, c := range cidrs {
+			out.Entries = append(out.Entries, awsec2types.PrefixListEntry{Cidr: aws.String(c)})
+		}
+		return out, nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.ManagedPrefixList
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulAvailable": {
+			args: args{
+				pl: &fake.MockManagedPrefixListClient{
+					MockDescribe:   describe(observedPrefixList(awsec2types.PrefixListStateCreateComplete)),
+					MockGetEntries: getEntries(cidr1),
+				},
+				cr: managedPrefixList(withEntries(cidr1), withExternalName(prefixListID)),
+			},
+			want: want{
+				cr: managedPrefixList(withEntries(cidr1), withExternalName(prefixListID),
+					withStatus(manualv1alpha1.ManagedPrefixListObservation{
+						PrefixListID: prefixListID,
+						State:        string(awsec2types.PrefixListStateCreateComplete),
+						Version:      2,
+					}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"EntriesOutdated": {
+			args: args{
+				pl: &fake.MockManagedPrefixListClient{
+					MockDescribe:   describe(observedPrefixList(awsec2types.PrefixListStateModifyComplete)),
+					MockGetEntries: getEntries(cidr1),
+				},
+				cr: managedPrefixList(withEntries(cidr2), withExternalName(prefixListID)),
+			},
+			want: want{
+				cr: managedPrefixList(withEntries(cidr2), withExternalName(prefixListID),
+					withStatus(manualv1alpha1.ManagedPrefixListObservation{
+						PrefixListID: prefixListID,
+						State:        string(awsec2types.PrefixListStateModifyComplete),
+						Version:      2,
+					}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"ModifyInProgress": {
+			args: args{
+				pl: &fake.MockManagedPrefixListClient{
+					MockDescribe:   describe(observedPrefixList(awsec2types.PrefixListStateModifyInProgress)),
+					MockGetEntries: getEntries(cidr1),
+				},
+				cr: managedPrefixList(withEntries(cidr2), withExternalName(prefixListID)),
+			},
+			want: want{
+				cr: managedPrefixList(withEntries(cidr2), withExternalName(prefixListID),
+					withStatus(manualv1alpha1.ManagedPrefixListObservation{
+						PrefixListID: prefixListID,
+						State:        string(awsec2types.PrefixListStateModifyInProgress),
+						Version:      2,
+					}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"DeleteComplete": {
+			args: args{
+				pl: &fake.MockManagedPrefixListClient{
+					MockDescribe: describe(observedPrefixList(awsec2types.PrefixListStateDeleteComplete)),
+				},
+				cr: managedPrefixList(withExternalName(prefixListID)),
+			},
+			want: want{
+				cr: managedPrefixList(withExternalName(prefixListID)),
+			},
+		},
+		"NotFound": {
+			args: args{
+				pl: &fake.MockManagedPrefixListClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeManagedPrefixListsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeManagedPrefixListsOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.PrefixListIDNotFound}
+					},
+				},
+				cr: managedPrefixList(withExternalName(prefixListID)),
+			},
+			want: want{
+				cr: managedPrefixList(withExternalName(prefixListID)),
+			},
+		},
+		"DescribeFail": {
+			args: args{
+				pl: &fake.MockManagedPrefixListClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeManagedPrefixListsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeManagedPrefixListsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: managedPrefixList(withExternalName(prefixListID)),
+			},
+			want: want{
+				cr:  managedPrefixList(withExternalName(prefixListID)),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.pl}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.ManagedPrefixList
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				pl: &fake.MockManagedPrefixListClient{
+					MockCreate: func(ctx context.Context, input *awsec2.CreateManagedPrefixListInput, opts []func(*awsec2.Options)) (*awsec2.CreateManagedPrefixListOutput, error) {
+						if len(input.Entries) != 1 || aws.ToString(input.Entries[0].Cidr) != cidr1 {
+							return nil, errBoom
+						}
+						return &awsec2.CreateManagedPrefixListOutput{
+							PrefixList: &awsec2types.ManagedPrefixList{PrefixListId: aws.String(prefixListID)},
+						}, nil
+					},
+				},
+				cr: managedPrefixList(withEntries(cidr1)),
+			},
+			want: want{
+				cr: managedPrefixList(withEntries(cidr1), withExternalName(prefixListID)),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				pl: &fake.MockManagedPrefixListClient{
+					MockCreate: func(ctx context.Context, input *awsec2.CreateManagedPrefixListInput, opts []func(*awsec2.Options)) (*awsec2.CreateManagedPrefixListOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: managedPrefixList(),
+			},
+			want: want{
+				cr:  managedPrefixList(),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.pl}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ModifiesEntriesAtCurrentVersion": {
+			args: args{
+				pl: &fake.MockManagedPrefixListClient{
+					MockDescribe:   describe(observedPrefixList(awsec2types.PrefixListStateModifyComplete)),
+					MockGetEntries: getEntries(cidr1),
+					MockModify: func(ctx context.Context, input *awsec2.ModifyManagedPrefixListInput, opts []func(*awsec2.Options)) (*awsec2.ModifyManagedPrefixListOutput, error) {
+						if aws.ToInt64(input.CurrentVersion) != 2 ||
+							len(input.AddEntries) != 1 || aws.ToString(input.AddEntries[0].Cidr) != cidr2 ||
+							len(input.RemoveEntries) != 1 || aws.ToString(input.RemoveEntries[0].Cidr) != cidr1 {
+							return nil, errBoom
+						}
+						return &awsec2.ModifyManagedPrefixListOutput{}, nil
+					},
+				},
+				cr: managedPrefixList(withEntries(cidr2), withExternalName(prefixListID)),
+			},
+		},
+		"ModifyFailed": {
+			args: args{
+				pl: &fake.MockManagedPrefixListClient{
+					MockDescribe:   describe(observedPrefixList(awsec2types.PrefixListStateModifyComplete)),
+					MockGetEntries: getEntries(cidr1),
+					MockModify: func(ctx context.Context, input *awsec2.ModifyManagedPrefixListInput, opts []func(*awsec2.Options)) (*awsec2.ModifyManagedPrefixListOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: managedPrefixList(withEntries(cidr2), withExternalName(prefixListID)),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errModify),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.pl}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.ManagedPrefixList
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				pl: &fake.MockManagedPrefixListClient{
+					MockDelete: func(ctx context.Context, input *awsec2.DeleteManagedPrefixListInput, opts []func(*awsec2.Options)) (*awsec2.DeleteManagedPrefixListOutput, error) {
+						return &awsec2.DeleteManagedPrefixListOutput{}, nil
+					},
+				},
+				cr: managedPrefixList(withExternalName(prefixListID)),
+			},
+			want: want{
+				cr: managedPrefixList(withExternalName(prefixListID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				pl: &fake.MockManagedPrefixListClient{
+					MockDelete: func(ctx context.Context, input *awsec2.DeleteManagedPrefixListInput, opts []func(*awsec2.Options)) (*awsec2.DeleteManagedPrefixListOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.PrefixListIDNotFound}
+					},
+				},
+				cr: managedPrefixList(withExternalName(prefixListID)),
+			},
+			want: want{
+				cr: managedPrefixList(withExternalName(prefixListID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				pl: &fake.MockManagedPrefixListClient{
+					MockDelete: func(ctx context.Context, input *awsec2.DeleteManagedPrefixListInput, opts []func(*awsec2.Options)) (*awsec2.DeleteManagedPrefixListOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: managedPrefixList(withExternalName(prefixListID)),
+			},
+			want: want{
+				cr:  managedPrefixList(withExternalName(prefixListID), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.pl}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
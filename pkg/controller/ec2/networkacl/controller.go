@@ -0,0 +1,361 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networkacl
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a NetworkACL resource"
+
+	errDescribe           = "failed to describe NetworkACL"
+	errMultipleItems      = "retrieved multiple NetworkACLs for the given networkAclId"
+	errCreate             = "failed to create the NetworkACL resource"
+	errDelete             = "failed to delete the NetworkACL resource"
+	errCreateTags         = "failed to create tags for the NetworkACL resource"
+	errDeleteTags         = "failed to delete tags for the NetworkACL resource"
+	errDescribeAssociated = "failed to describe the NetworkACL the subnet is associated with"
+	errNoAssociation      = "cannot find the network ACL association of the subnet"
+	errAssociateSubnet    = "failed to associate subnet to the NetworkACL resource"
+	errDescribeDefault    = "failed to describe the default NetworkACL of the VPC"
+	errNoDefault          = "cannot find the default network ACL of the VPC"
+	errDisassociateSubnet = "failed to restore the default network ACL association of the subnet"
+	errCreateEntry        = "failed to create an entry in the NetworkACL resource"
+	errReplaceEntry       = "failed to replace an entry in the NetworkACL resource"
+	errDeleteEntry        = "failed to delete an entry in the NetworkACL resource"
+
+	filterAssociationSubnetID = "association.subnet-id"
+	filterVPCID               = "vpc-id"
+	filterDefault             = "default"
+)
+
+// SetupNetworkACL adds a controller that reconciles NetworkACLs.
+func SetupNetworkACL(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(manualv1alpha1.NetworkACLGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewNetworkACLClient}),
+		managed.WithCreationGracePeriod(3 * time.Minute),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(manualv1alpha1.NetworkACLGroupVersionKind),
+		reconcilerOpts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&manualv1alpha1.NetworkACL{}).
+		Complete(r)
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.NetworkACLClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*manualv1alpha1.NetworkACL)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg)}, nil
+}
+
+type external struct {
+	client ec2.NetworkACLClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*manualv1alpha1.NetworkACL)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	response, err := e.client.DescribeNetworkAcls(ctx, &awsec2.DescribeNetworkAclsInput{
+		NetworkAclIds: []string{meta.GetExternalName(cr)},
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(ec2.IsNetworkACLNotFoundErr, err), errDescribe)
+	}
+
+	// in a successful response, there should be one and only one object
+	if len(response.NetworkAcls) != 1 {
+		return managed.ExternalObservation{}, errors.New(errMultipleItems)
+	}
+
+	observed := response.NetworkAcls[0]
+	current := cr.Spec.ForProvider.DeepCopy()
+	ec2.LateInitializeNetworkACL(&cr.Spec.ForProvider, &observed)
+
+	cr.SetConditions(xpv1.Available())
+	cr.Status.AtProvider = ec2.GenerateNetworkACLObservation(observed)
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        ec2.IsNetworkACLUpToDate(cr.Spec.ForProvider, observed),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*manualv1alpha1.NetworkACL)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	input := &awsec2.CreateNetworkAclInput{
+		VpcId: cr.Spec.ForProvider.VPCID,
+	}
+	if len(cr.Spec.ForProvider.Tags) > 0 {
+		input.TagSpecifications = []awsec2types.TagSpecification{{
+			ResourceType: awsec2types.ResourceTypeNetworkAcl,
+			Tags:         manualv1alpha1.GenerateEC2Tags(cr.Spec.ForProvider.Tags),
+		}}
+	}
+	result, err := e.client.CreateNetworkAcl(ctx, input)
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, aws.ToString(result.NetworkAcl.NetworkAclId))
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*manualv1alpha1.NetworkACL)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	response, err := e.client.DescribeNetworkAcls(ctx, &awsec2.DescribeNetworkAclsInput{
+		NetworkAclIds: []string{meta.GetExternalName(cr)},
+	})
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errDescribe)
+	}
+	if len(response.NetworkAcls) != 1 {
+		return managed.ExternalUpdate{}, errors.New(errMultipleItems)
+	}
+	acl := response.NetworkAcls[0]
+
+	if err := e.updateTags(ctx, meta.GetExternalName(cr), cr.Spec.ForProvider.Tags, acl.Tags); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	associate, disassociate := ec2.DiffNetworkACLAssociations(cr.Spec.ForProvider.SubnetIDs, acl.Associations)
+	for _, subnetID := range associate {
+		if err := e.associate(ctx, meta.GetExternalName(cr), subnetID); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+	if err := e.restoreDefaultAssociations(ctx, aws.ToString(acl.VpcId), disassociate); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	if awsclient.BoolValue(cr.Spec.ForProvider.ManageEntries) {
+		return managed.ExternalUpdate{}, e.updateEntries(ctx, meta.GetExternalName(cr), cr.Spec.ForProvider.Entries, acl.Entries)
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*manualv1alpha1.NetworkACL)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	// A network ACL cannot be deleted while it is associated with subnets and
+	// a subnet must always be associated with a network ACL, so the subnets
+	// are handed back to the default network ACL of the VPC first.
+	associations := make([]awsec2types.NetworkAclAssociation, len(cr.Status.AtProvider.Associations))
+	for i, asc := range cr.Status.AtProvider.Associations {
+		associations[i] = awsec2types.NetworkAclAssociation{
+			NetworkAclAssociationId: aws.String(asc.AssociationID),
+			SubnetId:                aws.String(asc.SubnetID),
+		}
+	}
+	if err := e.restoreDefaultAssociations(ctx, aws.ToString(cr.Spec.ForProvider.VPCID), associations); err != nil {
+		return err
+	}
+
+	_, err := e.client.DeleteNetworkAcl(ctx, &awsec2.DeleteNetworkAclInput{
+		NetworkAclId: aws.String(meta.GetExternalName(cr)),
+	})
+	return awsclient.Wrap(resource.Ignore(ec2.IsNetworkACLNotFoundErr, err), errDelete)
+}
+
+func (e *external) updateTags(ctx context.Context, id string, desired []manualv1alpha1.Tag, observed []awsec2types.Tag) error {
+	addTags, removeTags := ec2.DiffEC2Tags(manualv1alpha1.GenerateEC2Tags(desired), observed)
+	if len(addTags) > 0 {
+		if _, err := e.client.CreateTags(ctx, &awsec2.CreateTagsInput{
+			Resources: []string{id},
+			Tags:      addTags,
+		}); err != nil {
+			return awsclient.Wrap(err, errCreateTags)
+		}
+	}
+	if len(removeTags) > 0 {
+		if _, err := e.client.DeleteTags(ctx, &awsec2.DeleteTagsInput{
+			Resources: []string{id},
+			Tags:      removeTags,
+		}); err != nil {
+			return awsclient.Wrap(err, errDeleteTags)
+		}
+	}
+	return nil
+}
+
+// associate replaces the current network ACL association of the subnet with
+// an association to the network ACL with the given ID.
+func (e *external) associate(ctx context.Context, aclID, subnetID string) error {
+	response, err := e.client.DescribeNetworkAcls(ctx, &awsec2.DescribeNetworkAclsInput{
+		Filters: []awsec2types.Filter{{
+			Name:   aws.String(filterAssociationSubnetID),
+			Values: []string{subnetID},
+		}},
+	})
+	if err != nil {
+		return awsclient.Wrap(err, errDescribeAssociated)
+	}
+	var associationID *string
+	for _, acl := range response.NetworkAcls {
+		for _, asc := range acl.Associations {
+			if aws.ToString(asc.SubnetId) == subnetID {
+				associationID = asc.NetworkAclAssociationId
+			}
+		}
+	}
+	if associationID == nil {
+		return errors.New(errNoAssociation)
+	}
+	_, err = e.client.ReplaceNetworkAclAssociation(ctx, &awsec2.ReplaceNetworkAclAssociationInput{
+		AssociationId: associationID,
+		NetworkAclId:  aws.String(aclID),
+	})
+	return awsclient.Wrap(err, errAssociateSubnet)
+}
+
+// restoreDefaultAssociations replaces the given associations with
+// associations to the default network ACL of the VPC.
+func (e *external) restoreDefaultAssociations(ctx context.Context, vpcID string, associations []awsec2types.NetworkAclAssociation) error {
+	if len(associations) == 0 {
+		return nil
+	}
+	response, err := e.client.DescribeNetworkAcls(ctx, &awsec2.DescribeNetworkAclsInput{
+		Filters: []awsec2types.Filter{
+			{
+				Name:   aws.String(filterVPCID),
+				Values: []string{vpcID},
+			},
+			{
+				Name:   aws.String(filterDefault),
+				Values: []string{"true"},
+			},
+		},
+	})
+	if err != nil {
+		return awsclient.Wrap(err, errDescribeDefault)
+	}
+	if len(response.NetworkAcls) == 0 {
+		return errors.New(errNoDefault)
+	}
+	defaultID := response.NetworkAcls[0].NetworkAclId
+	for _, asc := range associations {
+		if _, err := e.client.ReplaceNetworkAclAssociation(ctx, &awsec2.ReplaceNetworkAclAssociationInput{
+			AssociationId: asc.NetworkAclAssociationId,
+			NetworkAclId:  defaultID,
+		}); err != nil && !ec2.IsAssociationIDNotFoundErr(err) {
+			return awsclient.Wrap(err, errDisassociateSubnet)
+		}
+	}
+	return nil
+}
+
+func (e *external) updateEntries(ctx context.Context, aclID string, desired []manualv1alpha1.NetworkACLRule, observed []awsec2types.NetworkAclEntry) error {
+	create, replace, remove := ec2.DiffNetworkACLEntries(ec2.GenerateEC2NetworkACLEntries(desired), observed)
+
+	// Entries are removed first to free their rule numbers.
+	for _, entry := range remove {
+		_, err := e.client.DeleteNetworkAclEntry(ctx, &awsec2.DeleteNetworkAclEntryInput{
+			NetworkAclId: aws.String(aclID),
+			Egress:       entry.Egress,
+			RuleNumber:   entry.RuleNumber,
+		})
+		if err != nil && !ec2.IsNetworkACLEntryNotFoundErr(err) {
+			return awsclient.Wrap(err, errDeleteEntry)
+		}
+	}
+	for _, entry := range replace {
+		if _, err := e.client.ReplaceNetworkAclEntry(ctx, ec2.GenerateReplaceNetworkACLEntryInput(aclID, entry)); err != nil {
+			return awsclient.Wrap(err, errReplaceEntry)
+		}
+	}
+	for _, entry := range create {
+		if _, err := e.client.CreateNetworkAclEntry(ctx, ec2.GenerateCreateNetworkACLEntryInput(aclID, entry)); err != nil {
+			return awsclient.Wrap(err, errCreateEntry)
+		}
+	}
+	return nil
+}
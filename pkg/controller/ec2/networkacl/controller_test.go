@@ -0,0 +1,623 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networkacl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	aclID            = "acl-1"
+	defaultACLID     = "acl-default"
+	vpcID            = "vpc-1"
+	subnetID         = "subnet-1"
+	associationID    = "aclassoc-1"
+	newAssociationID = "aclassoc-2"
+	cidr             = "10.0.0.0/16"
+	errBoom          = errors.New("boom")
+)
+
+type args struct {
+	acl ec2.NetworkACLClient
+	cr  *manualv1alpha1.NetworkACL
+}
+
+type aclModifier func(*manualv1alpha1.NetworkACL)
+
+func withExternalName(name string) aclModifier {
+	return func(r *manualv1alpha1.NetworkACL) { meta.SetExternalName(r, name) }
+}
+
+func withSpec(p manualv1alpha1.NetworkACLParameters) aclModifier {
+	return func(r *manualv1alpha1.NetworkACL) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s manualv1alpha1.NetworkACLObservation) aclModifier {
+	return func(r *manualv1alpha1.NetworkACL) { r.Status.AtProvider = s }
+}
+
+func withConditions(c ...xpv1.Condition) aclModifier {
+	return func(r *manualv1alpha1.NetworkACL) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func networkACL(m ...aclModifier) *manualv1alpha1.NetworkACL {
+	cr := &manualv1alpha1.NetworkACL{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func entry(rule int32, port int32) awsec2types.NetworkAclEntry {
+	return awsec2types.NetworkAclEntry{
+		RuleNumber: aws.Int32(rule),
+		Egress:     aws.Bool(false),
+		Protocol:   aws.String("6"),
+		RuleAction: awsec2types.RuleActionAllow,
+		CidrBlock:  aws.String(cidr),
+		PortRange:  &awsec2types.PortRange{From: aws.Int32(port), To: aws.Int32(port)},
+	}
+}
+
+func rule(number int32, port int32) manualv1alpha1.NetworkACLRule {
+	return manualv1alpha1.NetworkACLRule{
+		RuleNumber: number,
+		Protocol:   "tcp",
+		RuleAction: "allow",
+		CIDRBlock:  aws.String(cidr),
+		PortRange:  &manualv1alpha1.PortRange{From: aws.Int32(port), To: aws.Int32(port)},
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.NetworkACL
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulAvailable": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeNetworkAclsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeNetworkAclsOutput, error) {
+						return &awsec2.DescribeNetworkAclsOutput{
+							NetworkAcls: []awsec2types.NetworkAcl{{
+								NetworkAclId: aws.String(aclID),
+								VpcId:        aws.String(vpcID),
+							}},
+						}, nil
+					},
+				},
+				cr: networkACL(withSpec(manualv1alpha1.NetworkACLParameters{
+					VPCID: aws.String(vpcID),
+				}), withExternalName(aclID)),
+			},
+			want: want{
+				cr: networkACL(withSpec(manualv1alpha1.NetworkACLParameters{
+					VPCID: aws.String(vpcID),
+				}), withExternalName(aclID),
+					withStatus(manualv1alpha1.NetworkACLObservation{NetworkACLID: aclID}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"SubnetNotAssociated": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeNetworkAclsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeNetworkAclsOutput, error) {
+						return &awsec2.DescribeNetworkAclsOutput{
+							NetworkAcls: []awsec2types.NetworkAcl{{
+								NetworkAclId: aws.String(aclID),
+								VpcId:        aws.String(vpcID),
+							}},
+						}, nil
+					},
+				},
+				cr: networkACL(withSpec(manualv1alpha1.NetworkACLParameters{
+					VPCID:     aws.String(vpcID),
+					SubnetIDs: []string{subnetID},
+				}), withExternalName(aclID)),
+			},
+			want: want{
+				cr: networkACL(withSpec(manualv1alpha1.NetworkACLParameters{
+					VPCID:     aws.String(vpcID),
+					SubnetIDs: []string{subnetID},
+				}), withExternalName(aclID),
+					withStatus(manualv1alpha1.NetworkACLObservation{NetworkACLID: aclID}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"EntriesOutdated": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeNetworkAclsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeNetworkAclsOutput, error) {
+						return &awsec2.DescribeNetworkAclsOutput{
+							NetworkAcls: []awsec2types.NetworkAcl{{
+								NetworkAclId: aws.String(aclID),
+								VpcId:        aws.String(vpcID),
+								Entries:      []awsec2types.NetworkAclEntry{entry(100, 80)},
+							}},
+						}, nil
+					},
+				},
+				cr: networkACL(withSpec(manualv1alpha1.NetworkACLParameters{
+					VPCID:         aws.String(vpcID),
+					ManageEntries: aws.Bool(true),
+					Entries:       []manualv1alpha1.NetworkACLRule{rule(100, 443)},
+				}), withExternalName(aclID)),
+			},
+			want: want{
+				cr: networkACL(withSpec(manualv1alpha1.NetworkACLParameters{
+					VPCID:         aws.String(vpcID),
+					ManageEntries: aws.Bool(true),
+					Entries:       []manualv1alpha1.NetworkACLRule{rule(100, 443)},
+				}), withExternalName(aclID),
+					withStatus(manualv1alpha1.NetworkACLObservation{
+						NetworkACLID: aclID,
+						Entries: []manualv1alpha1.NetworkACLRule{{
+							RuleNumber: 100,
+							Protocol:   "6",
+							RuleAction: "allow",
+							CIDRBlock:  aws.String(cidr),
+							PortRange:  &manualv1alpha1.PortRange{From: aws.Int32(80), To: aws.Int32(80)},
+						}},
+					}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeNetworkAclsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeNetworkAclsOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.NetworkACLIDNotFound}
+					},
+				},
+				cr: networkACL(withExternalName(aclID)),
+			},
+			want: want{
+				cr: networkACL(withExternalName(aclID)),
+			},
+		},
+		"DescribeFail": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeNetworkAclsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeNetworkAclsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: networkACL(withExternalName(aclID)),
+			},
+			want: want{
+				cr:  networkACL(withExternalName(aclID)),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.acl}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.NetworkACL
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockCreate: func(ctx context.Context, input *awsec2.CreateNetworkAclInput, opts []func(*awsec2.Options)) (*awsec2.CreateNetworkAclOutput, error) {
+						return &awsec2.CreateNetworkAclOutput{
+							NetworkAcl: &awsec2types.NetworkAcl{NetworkAclId: aws.String(aclID)},
+						}, nil
+					},
+				},
+				cr: networkACL(withSpec(manualv1alpha1.NetworkACLParameters{
+					VPCID: aws.String(vpcID),
+				})),
+			},
+			want: want{
+				cr: networkACL(withSpec(manualv1alpha1.NetworkACLParameters{
+					VPCID: aws.String(vpcID),
+				}), withExternalName(aclID)),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockCreate: func(ctx context.Context, input *awsec2.CreateNetworkAclInput, opts []func(*awsec2.Options)) (*awsec2.CreateNetworkAclOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: networkACL(withSpec(manualv1alpha1.NetworkACLParameters{
+					VPCID: aws.String(vpcID),
+				})),
+			},
+			want: want{
+				cr: networkACL(withSpec(manualv1alpha1.NetworkACLParameters{
+					VPCID: aws.String(vpcID),
+				})),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.acl}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ReplacesCurrentAssociation": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeNetworkAclsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeNetworkAclsOutput, error) {
+						if len(input.Filters) > 0 {
+							return &awsec2.DescribeNetworkAclsOutput{
+								NetworkAcls: []awsec2types.NetworkAcl{{
+									NetworkAclId: aws.String(defaultACLID),
+									Associations: []awsec2types.NetworkAclAssociation{{
+										NetworkAclAssociationId: aws.String(associationID),
+										SubnetId:                aws.String(subnetID),
+									}},
+								}},
+							}, nil
+						}
+						return &awsec2.DescribeNetworkAclsOutput{
+							NetworkAcls: []awsec2types.NetworkAcl{{NetworkAclId: aws.String(aclID)}},
+						}, nil
+					},
+					MockReplaceAssociation: func(ctx context.Context, input *awsec2.ReplaceNetworkAclAssociationInput, opts []func(*awsec2.Options)) (*awsec2.ReplaceNetworkAclAssociationOutput, error) {
+						if aws.ToString(input.AssociationId) != associationID || aws.ToString(input.NetworkAclId) != aclID {
+							return nil, errBoom
+						}
+						return &awsec2.ReplaceNetworkAclAssociationOutput{NewAssociationId: aws.String(newAssociationID)}, nil
+					},
+				},
+				cr: networkACL(withSpec(manualv1alpha1.NetworkACLParameters{
+					VPCID:     aws.String(vpcID),
+					SubnetIDs: []string{subnetID},
+				}), withExternalName(aclID)),
+			},
+		},
+		"RestoresDefaultAssociation": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeNetworkAclsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeNetworkAclsOutput, error) {
+						if len(input.Filters) > 0 {
+							return &awsec2.DescribeNetworkAclsOutput{
+								NetworkAcls: []awsec2types.NetworkAcl{{NetworkAclId: aws.String(defaultACLID)}},
+							}, nil
+						}
+						return &awsec2.DescribeNetworkAclsOutput{
+							NetworkAcls: []awsec2types.NetworkAcl{{
+								NetworkAclId: aws.String(aclID),
+								VpcId:        aws.String(vpcID),
+								Associations: []awsec2types.NetworkAclAssociation{{
+									NetworkAclAssociationId: aws.String(associationID),
+									SubnetId:                aws.String(subnetID),
+								}},
+							}},
+						}, nil
+					},
+					MockReplaceAssociation: func(ctx context.Context, input *awsec2.ReplaceNetworkAclAssociationInput, opts []func(*awsec2.Options)) (*awsec2.ReplaceNetworkAclAssociationOutput, error) {
+						if aws.ToString(input.AssociationId) != associationID || aws.ToString(input.NetworkAclId) != defaultACLID {
+							return nil, errBoom
+						}
+						return &awsec2.ReplaceNetworkAclAssociationOutput{NewAssociationId: aws.String(newAssociationID)}, nil
+					},
+				},
+				cr: networkACL(withSpec(manualv1alpha1.NetworkACLParameters{
+					VPCID: aws.String(vpcID),
+				}), withExternalName(aclID)),
+			},
+		},
+		"FailedAssociation": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeNetworkAclsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeNetworkAclsOutput, error) {
+						if len(input.Filters) > 0 {
+							return nil, errBoom
+						}
+						return &awsec2.DescribeNetworkAclsOutput{
+							NetworkAcls: []awsec2types.NetworkAcl{{NetworkAclId: aws.String(aclID)}},
+						}, nil
+					},
+				},
+				cr: networkACL(withSpec(manualv1alpha1.NetworkACLParameters{
+					VPCID:     aws.String(vpcID),
+					SubnetIDs: []string{subnetID},
+				}), withExternalName(aclID)),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errDescribeAssociated),
+			},
+		},
+		"ManagesEntries": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeNetworkAclsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeNetworkAclsOutput, error) {
+						return &awsec2.DescribeNetworkAclsOutput{
+							NetworkAcls: []awsec2types.NetworkAcl{{
+								NetworkAclId: aws.String(aclID),
+								Entries:      []awsec2types.NetworkAclEntry{entry(100, 80), entry(200, 22)},
+							}},
+						}, nil
+					},
+					MockDeleteEntry: func(ctx context.Context, input *awsec2.DeleteNetworkAclEntryInput, opts []func(*awsec2.Options)) (*awsec2.DeleteNetworkAclEntryOutput, error) {
+						if aws.ToInt32(input.RuleNumber) != 200 {
+							return nil, errBoom
+						}
+						return &awsec2.DeleteNetworkAclEntryOutput{}, nil
+					},
+					MockReplaceEntry: func(ctx context.Context, input *awsec2.ReplaceNetworkAclEntryInput, opts []func(*awsec2.Options)) (*awsec2.ReplaceNetworkAclEntryOutput, error) {
+						if aws.ToInt32(input.RuleNumber) != 100 || aws.ToInt32(input.PortRange.From) != 443 {
+							return nil, errBoom
+						}
+						return &awsec2.ReplaceNetworkAclEntryOutput{}, nil
+					},
+					MockCreateEntry: func(ctx context.Context, input *awsec2.CreateNetworkAclEntryInput, opts []func(*awsec2.Options)) (*awsec2.CreateNetworkAclEntryOutput, error) {
+						if aws.ToInt32(input.RuleNumber) != 300 {
+							return nil, errBoom
+						}
+						return &awsec2.CreateNetworkAclEntryOutput{}, nil
+					},
+				},
+				cr: networkACL(withSpec(manualv1alpha1.NetworkACLParameters{
+					ManageEntries: aws.Bool(true),
+					Entries:       []manualv1alpha1.NetworkACLRule{rule(100, 443), rule(300, 8080)},
+				}), withExternalName(aclID)),
+			},
+		},
+		"CreateEntryFailed": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeNetworkAclsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeNetworkAclsOutput, error) {
+						return &awsec2.DescribeNetworkAclsOutput{
+							NetworkAcls: []awsec2types.NetworkAcl{{NetworkAclId: aws.String(aclID)}},
+						}, nil
+					},
+					MockCreateEntry: func(ctx context.Context, input *awsec2.CreateNetworkAclEntryInput, opts []func(*awsec2.Options)) (*awsec2.CreateNetworkAclEntryOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: networkACL(withSpec(manualv1alpha1.NetworkACLParameters{
+					ManageEntries: aws.Bool(true),
+					Entries:       []manualv1alpha1.NetworkACLRule{rule(100, 443)},
+				}), withExternalName(aclID)),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errCreateEntry),
+			},
+		},
+		"IgnoresEntriesIfNotManaged": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeNetworkAclsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeNetworkAclsOutput, error) {
+						return &awsec2.DescribeNetworkAclsOutput{
+							NetworkAcls: []awsec2types.NetworkAcl{{
+								NetworkAclId: aws.String(aclID),
+								Entries:      []awsec2types.NetworkAclEntry{entry(100, 80)},
+							}},
+						}, nil
+					},
+				},
+				cr: networkACL(withExternalName(aclID)),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.acl}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.NetworkACL
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockDelete: func(ctx context.Context, input *awsec2.DeleteNetworkAclInput, opts []func(*awsec2.Options)) (*awsec2.DeleteNetworkAclOutput, error) {
+						return &awsec2.DeleteNetworkAclOutput{}, nil
+					},
+				},
+				cr: networkACL(withExternalName(aclID)),
+			},
+			want: want{
+				cr: networkACL(withExternalName(aclID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"RestoresDefaultAssociation": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeNetworkAclsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeNetworkAclsOutput, error) {
+						return &awsec2.DescribeNetworkAclsOutput{
+							NetworkAcls: []awsec2types.NetworkAcl{{NetworkAclId: aws.String(defaultACLID)}},
+						}, nil
+					},
+					MockReplaceAssociation: func(ctx context.Context, input *awsec2.ReplaceNetworkAclAssociationInput, opts []func(*awsec2.Options)) (*awsec2.ReplaceNetworkAclAssociationOutput, error) {
+						if aws.ToString(input.NetworkAclId) != defaultACLID {
+							return nil, errBoom
+						}
+						return &awsec2.ReplaceNetworkAclAssociationOutput{NewAssociationId: aws.String(newAssociationID)}, nil
+					},
+					MockDelete: func(ctx context.Context, input *awsec2.DeleteNetworkAclInput, opts []func(*awsec2.Options)) (*awsec2.DeleteNetworkAclOutput, error) {
+						return &awsec2.DeleteNetworkAclOutput{}, nil
+					},
+				},
+				cr: networkACL(withSpec(manualv1alpha1.NetworkACLParameters{
+					VPCID: aws.String(vpcID),
+				}), withStatus(manualv1alpha1.NetworkACLObservation{
+					Associations: []manualv1alpha1.NetworkACLAssociation{{AssociationID: associationID, SubnetID: subnetID}},
+				}), withExternalName(aclID)),
+			},
+			want: want{
+				cr: networkACL(withSpec(manualv1alpha1.NetworkACLParameters{
+					VPCID: aws.String(vpcID),
+				}), withStatus(manualv1alpha1.NetworkACLObservation{
+					Associations: []manualv1alpha1.NetworkACLAssociation{{AssociationID: associationID, SubnetID: subnetID}},
+				}), withExternalName(aclID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"RestoreDefaultAssociationFail": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeNetworkAclsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeNetworkAclsOutput, error) {
+						return &awsec2.DescribeNetworkAclsOutput{
+							NetworkAcls: []awsec2types.NetworkAcl{{NetworkAclId: aws.String(defaultACLID)}},
+						}, nil
+					},
+					MockReplaceAssociation: func(ctx context.Context, input *awsec2.ReplaceNetworkAclAssociationInput, opts []func(*awsec2.Options)) (*awsec2.ReplaceNetworkAclAssociationOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: networkACL(withStatus(manualv1alpha1.NetworkACLObservation{
+					Associations: []manualv1alpha1.NetworkACLAssociation{{AssociationID: associationID, SubnetID: subnetID}},
+				}), withExternalName(aclID)),
+			},
+			want: want{
+				cr: networkACL(withStatus(manualv1alpha1.NetworkACLObservation{
+					Associations: []manualv1alpha1.NetworkACLAssociation{{AssociationID: associationID, SubnetID: subnetID}},
+				}), withExternalName(aclID), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDisassociateSubnet),
+			},
+		},
+		"DeleteFail": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockDelete: func(ctx context.Context, input *awsec2.DeleteNetworkAclInput, opts []func(*awsec2.Options)) (*awsec2.DeleteNetworkAclOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: networkACL(withExternalName(aclID)),
+			},
+			want: want{
+				cr:  networkACL(withExternalName(aclID), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.acl}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,406 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networkaclentry
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	aclID   = "acl-1"
+	extName = "acl-1:egress:100"
+	cidr    = "10.0.0.0/16"
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	acl ec2.NetworkACLClient
+	cr  *manualv1alpha1.NetworkACLEntry
+}
+
+type entryModifier func(*manualv1alpha1.NetworkACLEntry)
+
+func withExternalName(name string) entryModifier {
+	return func(r *manualv1alpha1.NetworkACLEntry) { meta.SetExternalName(r, name) }
+}
+
+func withPort(port int32) entryModifier {
+	return func(r *manualv1alpha1.NetworkACLEntry) {
+		r.Spec.ForProvider.PortRange = &manualv1alpha1.PortRange{From: aws.Int32(port), To: aws.Int32(port)}
+	}
+}
+
+func withStatus(s manualv1alpha1.NetworkACLEntryObservation) entryModifier {
+	return func(r *manualv1alpha1.NetworkACLEntry) { r.Status.AtProvider = s }
+}
+
+func withConditions(c ...xpv1.Condition) entryModifier {
+	return func(r *manualv1alpha1.NetworkACLEntry) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func networkACLEntry(m ...entryModifier) *manualv1alpha1.NetworkACLEntry {
+	cr := &manualv1alpha1.NetworkACLEntry{
+		Spec: manualv1alpha1.NetworkACLEntrySpec{
+			ForProvider: manualv1alpha1.NetworkACLEntryParameters{
+				NetworkACLID: aws.String(aclID),
+				RuleNumber:   100,
+				Egress:       true,
+				Protocol:     "tcp",
+				RuleAction:   "allow",
+				CIDRBlock:    aws.String(cidr),
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describeWithEntry(port int32) func(ctx context.Context, input *awsec2.DescribeNetworkAclsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeNetworkAclsOutput, error) {
+	return func(ctx context.Context, input *awsec2.DescribeNetworkAclsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeNetworkAclsOutput, error) {
+		return &awsec2.DescribeNetworkAclsOutput{
+			NetworkAcls: []awsec2types.NetworkAcl{{
+				NetworkAclId: aws.String(aclID),
+				Entries: []awsec2types.NetworkAclEntry{
+					{
+						RuleNumber: aws.Int32(100),
+						Egress:     aws.Bool(false),
+						Protocol:   aws.String("-1"),
+						RuleAction: awsec2types.RuleActionDeny,
+						CidrBlock:  aws.String(cidr),
+					},
+					{
+						RuleNumber: aws.Int32(100),
+						Egress:     aws.Bool(true),
+						Protocol:   aws.String("6"),
+						RuleAction: awsec2types.RuleActionAllow,
+						CidrBlock:  aws.String(cidr),
+						PortRange:  &awsec2types.PortRange{From: aws.Int32(port), To: aws.Int32(port)},
+					},
+				},
+			}},
+		}, nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.NetworkACLEntry
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{},
+				cr:  networkACLEntry(),
+			},
+			want: want{
+				cr: networkACLEntry(),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{MockDescribe: describeWithEntry(80)},
+				cr:  networkACLEntry(withPort(80), withExternalName(extName)),
+			},
+			want: want{
+				cr: networkACLEntry(withPort(80), withExternalName(extName),
+					withStatus(manualv1alpha1.NetworkACLEntryObservation{NetworkACLID: aclID}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"PortChanged": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{MockDescribe: describeWithEntry(80)},
+				cr:  networkACLEntry(withPort(443), withExternalName(extName)),
+			},
+			want: want{
+				cr: networkACLEntry(withPort(443), withExternalName(extName),
+					withStatus(manualv1alpha1.NetworkACLEntryObservation{NetworkACLID: aclID}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"EntryNotFound": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeNetworkAclsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeNetworkAclsOutput, error) {
+						return &awsec2.DescribeNetworkAclsOutput{
+							NetworkAcls: []awsec2types.NetworkAcl{{NetworkAclId: aws.String(aclID)}},
+						}, nil
+					},
+				},
+				cr: networkACLEntry(withExternalName(extName)),
+			},
+			want: want{
+				cr: networkACLEntry(withExternalName(extName)),
+			},
+		},
+		"NetworkACLNotFound": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeNetworkAclsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeNetworkAclsOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.NetworkACLIDNotFound}
+					},
+				},
+				cr: networkACLEntry(withExternalName(extName)),
+			},
+			want: want{
+				cr: networkACLEntry(withExternalName(extName)),
+			},
+		},
+		"DescribeFail": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeNetworkAclsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeNetworkAclsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: networkACLEntry(withExternalName(extName)),
+			},
+			want: want{
+				cr:  networkACLEntry(withExternalName(extName)),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.acl}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.NetworkACLEntry
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockCreateEntry: func(ctx context.Context, input *awsec2.CreateNetworkAclEntryInput, opts []func(*awsec2.Options)) (*awsec2.CreateNetworkAclEntryOutput, error) {
+						return &awsec2.CreateNetworkAclEntryOutput{}, nil
+					},
+				},
+				cr: networkACLEntry(withPort(80)),
+			},
+			want: want{
+				cr: networkACLEntry(withPort(80), withExternalName(extName)),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockCreateEntry: func(ctx context.Context, input *awsec2.CreateNetworkAclEntryInput, opts []func(*awsec2.Options)) (*awsec2.CreateNetworkAclEntryOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: networkACLEntry(withPort(80)),
+			},
+			want: want{
+				cr:  networkACLEntry(withPort(80)),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.acl}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockReplaceEntry: func(ctx context.Context, input *awsec2.ReplaceNetworkAclEntryInput, opts []func(*awsec2.Options)) (*awsec2.ReplaceNetworkAclEntryOutput, error) {
+						return &awsec2.ReplaceNetworkAclEntryOutput{}, nil
+					},
+				},
+				cr: networkACLEntry(withPort(443), withExternalName(extName)),
+			},
+		},
+		"ReplaceFailed": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockReplaceEntry: func(ctx context.Context, input *awsec2.ReplaceNetworkAclEntryInput, opts []func(*awsec2.Options)) (*awsec2.ReplaceNetworkAclEntryOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: networkACLEntry(withPort(443), withExternalName(extName)),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.acl}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.NetworkACLEntry
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockDeleteEntry: func(ctx context.Context, input *awsec2.DeleteNetworkAclEntryInput, opts []func(*awsec2.Options)) (*awsec2.DeleteNetworkAclEntryOutput, error) {
+						return &awsec2.DeleteNetworkAclEntryOutput{}, nil
+					},
+				},
+				cr: networkACLEntry(withExternalName(extName)),
+			},
+			want: want{
+				cr: networkACLEntry(withExternalName(extName), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockDeleteEntry: func(ctx context.Context, input *awsec2.DeleteNetworkAclEntryInput, opts []func(*awsec2.Options)) (*awsec2.DeleteNetworkAclEntryOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.NetworkACLEntryNotFound}
+					},
+				},
+				cr: networkACLEntry(withExternalName(extName)),
+			},
+			want: want{
+				cr: networkACLEntry(withExternalName(extName), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteFail": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockDeleteEntry: func(ctx context.Context, input *awsec2.DeleteNetworkAclEntryInput, opts []func(*awsec2.Options)) (*awsec2.DeleteNetworkAclEntryOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: networkACLEntry(withExternalName(extName)),
+			},
+			want: want{
+				cr:  networkACLEntry(withExternalName(extName), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.acl}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -72,6 +72,7 @@ func preCreate(_ context.Context, cr *svcapitypes.Route, obj *svcsdk.CreateRoute
 	obj.RouteTableId = cr.Spec.ForProvider.RouteTableID
 	obj.InstanceId = cr.Spec.ForProvider.InstanceID
 	obj.GatewayId = cr.Spec.ForProvider.GatewayID
+	obj.DestinationPrefixListId = cr.Spec.ForProvider.DestinationPrefixListID
 	return nil
 }
 
@@ -104,10 +105,11 @@ func (e *external) observer(ctx context.Context, mg cpresource.Managed) (managed
 
 func preDelete(_ context.Context, cr *svcapitypes.Route, obj *svcsdk.DeleteRouteInput) (bool, error) {
 	obj.RouteTableId = cr.Spec.ForProvider.RouteTableID
+	obj.DestinationPrefixListId = cr.Spec.ForProvider.DestinationPrefixListID
 	return false, nil
 }
 
-// findRouteByDestination returns the route corresponding to the specified IPv4/IPv6 destination.
+// findRouteByDestination returns the route corresponding to the specified IPv4/IPv6 or prefix list destination.
 // Returns NotFoundError if no route is found.
 func (e *external) findRouteByDestination(ctx context.Context, cr *svcapitypes.Route) (*svcsdk.Route, error) {
 
@@ -126,6 +128,12 @@ func (e *external) findRouteByDestination(ctx context.Context, cr *svcapitypes.R
 
 	for _, route := range response.RouteTables[0].Routes {
 		if awsclients.StringValue(route.Origin) == svcsdk.RouteOriginCreateRoute {
+			if cr.Spec.ForProvider.DestinationPrefixListID != nil {
+				if awsclients.StringValue(route.DestinationPrefixListId) == awsclients.StringValue(cr.Spec.ForProvider.DestinationPrefixListID) {
+					return route, nil
+				}
+				continue
+			}
 			if ec2.CIDRBlocksEqual(awsclients.StringValue(route.DestinationCidrBlock), awsclients.StringValue(cr.Spec.ForProvider.DestinationCIDRBlock)) {
 				return route, nil
 			}
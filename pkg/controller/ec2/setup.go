@@ -24,9 +24,13 @@ import (
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/flowlog"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/instance"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/internetgateway"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/keypair"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/launchtemplate"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/launchtemplateversion"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/managedprefixlist"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/natgateway"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/networkacl"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/networkaclentry"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/route"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/routetable"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/securitygroup"
@@ -53,9 +57,13 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		flowlog.SetupFlowLog,
 		instance.SetupInstance,
 		internetgateway.SetupInternetGateway,
+		keypair.SetupKeyPair,
 		launchtemplate.SetupLaunchTemplate,
 		launchtemplateversion.SetupLaunchTemplateVersion,
+		managedprefixlist.SetupManagedPrefixList,
 		natgateway.SetupNatGateway,
+		networkacl.SetupNetworkACL,
+		networkaclentry.SetupNetworkACLEntry,
 		route.SetupRoute,
 		routetable.SetupRouteTable,
 		securitygroup.SetupSecurityGroup,
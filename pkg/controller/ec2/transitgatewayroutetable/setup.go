@@ -169,6 +169,11 @@ func (t *tagger) Initialize(ctx context.Context, mgd cpresource.Managed) error {
 	for _, t := range cr.Spec.ForProvider.Tags {
 		tagMap[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
 	}
+	defaultTags, err := aws.GetDefaultTags(ctx, t.kube, mgd)
+	if err != nil {
+		return err
+	}
+	tagMap = aws.ApplyDefaultTags(mgd, tagMap, defaultTags)
 	for k, v := range cpresource.GetExternalTags(mgd) {
 		tagMap[k] = v
 	}
@@ -34,6 +34,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane-contrib/provider-aws/apis/ec2/v1beta1"
+	awsv1beta1 "github.com/crossplane-contrib/provider-aws/apis/v1beta1"
 	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
 	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
 	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2/fake"
@@ -66,6 +67,14 @@ func withTags(tagMaps ...map[string]string) vpcModifier {
 	return func(r *v1beta1.VPC) { r.Spec.ForProvider.Tags = tagList }
 }
 
+func withProviderConfig(name string) vpcModifier {
+	return func(r *v1beta1.VPC) { r.SetProviderConfigReference(&xpv1.Reference{Name: name}) }
+}
+
+func withAnnotations(a map[string]string) vpcModifier {
+	return func(r *v1beta1.VPC) { meta.AddAnnotations(r, a) }
+}
+
 func withExternalName(name string) vpcModifier {
 	return func(r *v1beta1.VPC) { meta.SetExternalName(r, name) }
 }
@@ -476,6 +485,25 @@ func TestInitialize(t *testing.T) {
 				cr: vpc(withTags(resource.GetExternalTags(vpc()), map[string]string{"foo": "bar"})),
 			},
 		},
+		"DefaultTags": {
+			args: args{
+				cr: vpc(withProviderConfig("pc"), withTags(map[string]string{"foo": "bar"})),
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+						obj.(*awsv1beta1.ProviderConfig).Spec.DefaultTags = map[string]string{"foo": "default", "owner": "team"}
+						return nil
+					}),
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+			},
+			want: want{
+				cr: vpc(
+					withProviderConfig("pc"),
+					withAnnotations(map[string]string{awsclient.AnnotationKeyDefaultTags: `{"owner":"team"}`}),
+					withTags(resource.GetExternalTags(vpc(withProviderConfig("pc"))), map[string]string{"foo": "bar", "owner": "team"}),
+				),
+			},
+		},
 		"UpdateFailed": {
 			args: args{
 				cr:   vpc(),
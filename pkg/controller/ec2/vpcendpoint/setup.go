@@ -387,6 +387,11 @@ func (t *tagger) Initialize(ctx context.Context, mgd cpresource.Managed) error {
 	}
 
 	tagMap["Name"] = cr.Name
+	defaultTags, err := awsclients.GetDefaultTags(ctx, t.kube, mgd)
+	if err != nil {
+		return err
+	}
+	tagMap = awsclients.ApplyDefaultTags(mgd, tagMap, defaultTags)
 	for k, v := range cpresource.GetExternalTags(mgd) {
 		tagMap[k] = v
 	}
@@ -237,6 +237,11 @@ func (t *tagger) Initialize(ctx context.Context, mgd cpresource.Managed) error {
 	for _, t := range cr.Spec.ForProvider.Tags {
 		tagMap[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
 	}
+	defaultTags, err := awsclients.GetDefaultTags(ctx, t.kube, mgd)
+	if err != nil {
+		return err
+	}
+	tagMap = awsclients.ApplyDefaultTags(mgd, tagMap, defaultTags)
 	for k, v := range cpresource.GetExternalTags(mgd) {
 		tagMap[k] = v
 	}
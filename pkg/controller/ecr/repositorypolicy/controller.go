@@ -36,6 +36,7 @@ import (
 	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
 	ecr "github.com/crossplane-contrib/provider-aws/pkg/clients/ecr"
 	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	policyutils "github.com/crossplane-contrib/provider-aws/pkg/utils/policy"
 )
 
 const (
@@ -45,6 +46,8 @@ const (
 	errGet    = "failed to get repository policy"
 	errUpdate = "failed to update repository policy"
 	errDelete = "failed to delete the repository resource"
+
+	errInvalidPolicy = "invalid repository policy"
 )
 
 // SetupRepositoryPolicy adds a controller that reconciles ECR.
@@ -128,13 +131,27 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 
 	cr.SetConditions(xpv1.Available())
 
+	upToDate := awsclient.IsPolicyUpToDate(&policyData, response.PolicyText)
+	diff := ""
+	if !upToDate {
+		diff = policyutils.DiffPolicyStrings(policyData, awsclient.StringValue(response.PolicyText))
+	}
 	return managed.ExternalObservation{
 		ResourceExists:          true,
-		ResourceUpToDate:        awsclient.IsPolicyUpToDate(&policyData, response.PolicyText),
+		ResourceUpToDate:        upToDate,
 		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+		Diff:                    diff,
 	}, nil
 }
 
+// validateRepositoryPolicy checks the given repository policy for mistakes that
+// would make ECR reject it.
+func validateRepositoryPolicy(policy string) error {
+	return policyutils.ValidatePolicyString(policy,
+		policyutils.WithResourcePolicy(),
+		policyutils.WithMaxSize(policyutils.MaxRepositoryPolicySize))
+}
+
 func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
 	cr, ok := mgd.(*v1beta1.RepositoryPolicy)
 	if !ok {
@@ -145,6 +162,9 @@ func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.Ex
 	if err != nil {
 		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
 	}
+	if err := validateRepositoryPolicy(policyData); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errInvalidPolicy)
+	}
 	_, err = e.client.SetRepositoryPolicy(ctx, ecr.GenerateSetRepositoryPolicyInput(&cr.Spec.ForProvider, &policyData))
 
 	return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
@@ -160,6 +180,9 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 	if err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
 	}
+	if err := validateRepositoryPolicy(policyData); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errInvalidPolicy)
+	}
 	_, err = e.client.SetRepositoryPolicy(ctx, ecr.GenerateSetRepositoryPolicyInput(&cr.Spec.ForProvider, &policyData))
 	return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
 }
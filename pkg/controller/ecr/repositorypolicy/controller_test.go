@@ -34,6 +34,7 @@ import (
 	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
 	"github.com/crossplane-contrib/provider-aws/pkg/clients/ecr"
 	"github.com/crossplane-contrib/provider-aws/pkg/clients/ecr/fake"
+	policyutils "github.com/crossplane-contrib/provider-aws/pkg/utils/policy"
 )
 
 var (
@@ -59,6 +60,10 @@ var (
 		},
 	}
 
+	invalidParams = v1beta1.RepositoryPolicyParameters{
+		RawPolicy: awsclient.String(`{"Statement":[{"Action":"ListImages","Effect":"Allow","Principal":"*"}],"Version":"2012-10-17"}`),
+	}
+
 	errBoom = errors.New("boom")
 )
 
@@ -151,6 +156,7 @@ func TestObserve(t *testing.T) {
 				result: managed.ExternalObservation{
 					ResourceExists:   true,
 					ResourceUpToDate: false,
+					Diff:             policyutils.DiffPolicyStrings(policy, needUpdatePolicy),
 				},
 			},
 		},
@@ -258,6 +264,15 @@ func TestCreate(t *testing.T) {
 				err: awsclient.Wrap(errBoom, errCreate),
 			},
 		},
+		"InvalidPolicy": {
+			args: args{
+				cr: repositoryPolicy(withPolicy(&invalidParams)),
+			},
+			want: want{
+				cr:  repositoryPolicy(withPolicy(&invalidParams)),
+				err: errors.Wrap(errors.New(`statement 0: invalid action "ListImages", must be * or of the form <service>:<action>`), errInvalidPolicy),
+			},
+		},
 	}
 
 	for name, tc := range cases {
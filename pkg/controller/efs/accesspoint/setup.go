@@ -13,6 +13,7 @@ import (
 
 	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/efs/v1alpha1"
 	awsclients "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	svcutils "github.com/crossplane-contrib/provider-aws/pkg/controller/efs/utils"
 	"github.com/crossplane-contrib/provider-aws/pkg/features"
 )
 
@@ -29,7 +30,9 @@ func SetupAccessPoint(mgr ctrl.Manager, o controller.Options) error {
 	}
 
 	reconcilerOpts := []managed.ReconcilerOption{
-		managed.WithInitializers(),
+		managed.WithInitializers(svcutils.NewDefaultTagger(mgr.GetClient(), func(mg resource.Managed) *[]*svcapitypes.Tag {
+			return &mg.(*svcapitypes.AccessPoint).Spec.ForProvider.Tags
+		})),
 		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
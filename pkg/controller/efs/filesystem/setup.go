@@ -17,6 +17,7 @@ import (
 	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/efs/v1alpha1"
 	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 	awsclients "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	svcutils "github.com/crossplane-contrib/provider-aws/pkg/controller/efs/utils"
 	"github.com/crossplane-contrib/provider-aws/pkg/features"
 )
 
@@ -41,7 +42,9 @@ func SetupFileSystem(mgr ctrl.Manager, o controller.Options) error {
 	}
 
 	reconcilerOpts := []managed.ReconcilerOption{
-		managed.WithInitializers(),
+		managed.WithInitializers(svcutils.NewDefaultTagger(mgr.GetClient(), func(mg resource.Managed) *[]*svcapitypes.Tag {
+			return &mg.(*svcapitypes.FileSystem).Spec.ForProvider.Tags
+		})),
 		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
@@ -22,8 +22,10 @@ import (
 
 	svcsdk "github.com/aws/aws-sdk-go/service/efs"
 	"github.com/aws/aws-sdk-go/service/efs/efsiface"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/efs/v1alpha1"
 	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
@@ -170,3 +172,14 @@ func GetExternalTags(mg resource.Managed) []*svcapitypes.Tag {
 
 	return externalTags
 }
+
+// NewDefaultTagger returns an initializer that applies the default tags of the
+// ProviderConfig referenced by a managed resource to the tags returned by
+// tagsOf.
+func NewDefaultTagger(kube client.Client, tagsOf func(resource.Managed) *[]*svcapitypes.Tag) managed.InitializerFn {
+	return awsclient.NewDefaultTagger(kube, tagsOf, func(t *svcapitypes.Tag) (string, string) {
+		return awsclient.StringValue(t.Key), awsclient.StringValue(t.Value)
+	}, func(key, value string) *svcapitypes.Tag {
+		return &svcapitypes.Tag{Key: awsclient.String(key), Value: awsclient.String(value)}
+	})
+}
@@ -17,13 +17,19 @@ limitations under the License.
 package utils
 
 import (
+	"context"
 	"testing"
 
 	svcsdk "github.com/aws/aws-sdk-go/service/efs"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/efs/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1beta1"
 	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
 )
 
@@ -85,3 +91,31 @@ func TestDiffTags(t *testing.T) {
 		})
 	}
 }
+
+func TestNewDefaultTagger(t *testing.T) {
+	apiTag := func(k, v string) *svcapitypes.Tag {
+		return &svcapitypes.Tag{Key: &k, Value: &v}
+	}
+
+	fs := &svcapitypes.FileSystem{}
+	fs.SetProviderConfigReference(&xpv1.Reference{Name: "pc"})
+	fs.Spec.ForProvider.Tags = []*svcapitypes.Tag{apiTag("foo", "bar")}
+	kube := &test.MockClient{
+		MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+			obj.(*v1beta1.ProviderConfig).Spec.DefaultTags = map[string]string{"foo": "default", "owner": "team"}
+			return nil
+		}),
+		MockUpdate: test.NewMockUpdateFn(nil),
+	}
+
+	err := NewDefaultTagger(kube, func(mg resource.Managed) *[]*svcapitypes.Tag {
+		return &mg.(*svcapitypes.FileSystem).Spec.ForProvider.Tags
+	}).Initialize(context.Background(), fs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*svcapitypes.Tag{apiTag("foo", "bar"), apiTag("owner", "team")}
+	if diff := cmp.Diff(want, fs.Spec.ForProvider.Tags); diff != "" {
+		t.Errorf("Initialize(...): -want, +got:\n%s", diff)
+	}
+}
@@ -32,6 +32,7 @@ import (
 	"github.com/pkg/errors"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/crossplane-contrib/provider-aws/apis/eks/v1beta1"
 	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
@@ -54,6 +55,11 @@ const (
 	errUpToDateFailed      = "cannot check whether object is up-to-date"
 )
 
+// tokenRefreshInterval is the maximum time between two observations of a
+// Cluster that publishes a token kubeconfig. It leaves enough time to refresh
+// the token before it expires.
+const tokenRefreshInterval = eks.TokenLifetime / 2
+
 // SetupCluster adds a controller that reconciles Clusters.
 func SetupCluster(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName(v1beta1.ClusterGroupKind)
@@ -86,7 +92,47 @@ func SetupCluster(mgr ctrl.Manager, o controller.Options) error {
 		WithOptions(o.ForControllerRuntime()).
 		WithEventFilter(resource.DesiredStateChanged()).
 		For(&v1beta1.Cluster{}).
-		Complete(r)
+		Complete(&tokenRefresher{kube: mgr.GetClient(), Reconciler: r})
+}
+
+// tokenRefresher wraps the managed reconciler of Clusters so that Clusters
+// publishing a token kubeconfig are observed again before the token expires.
+// All other Clusters keep the configured poll interval.
+type tokenRefresher struct {
+	reconcile.Reconciler
+	kube client.Client
+}
+
+func (r *tokenRefresher) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	res, err := r.Reconciler.Reconcile(ctx, req)
+	if err != nil {
+		return res, err
+	}
+	cr := &v1beta1.Cluster{}
+	if err := r.kube.Get(ctx, req.NamespacedName, cr); err != nil {
+		return res, client.IgnoreNotFound(err)
+	}
+	return requeueForTokenRefresh(cr, res), nil
+}
+
+// requeueForTokenRefresh returns the supplied result, requeued no later than
+// tokenRefreshInterval if the supplied Cluster publishes a kubeconfig with a
+// token that needs to be refreshed.
+func requeueForTokenRefresh(cr *v1beta1.Cluster, res reconcile.Result) reconcile.Result {
+	if meta.WasDeleted(cr) || (cr.GetWriteConnectionSecretToReference() == nil && cr.GetPublishConnectionDetailsTo() == nil) {
+		return res
+	}
+	if auth := cr.Spec.ForProvider.KubeconfigAuthentication; auth != nil && auth.Mode == v1beta1.KubeconfigAuthenticationModeExec {
+		return res
+	}
+	if res.Requeue && res.RequeueAfter == 0 {
+		// The reconciler asked to be requeued with backoff.
+		return res
+	}
+	if res.RequeueAfter == 0 || res.RequeueAfter > tokenRefreshInterval {
+		res.RequeueAfter = tokenRefreshInterval
+	}
+	return res
 }
 
 type connector struct {
@@ -151,7 +197,7 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	return managed.ExternalObservation{
 		ResourceExists:    true,
 		ResourceUpToDate:  upToDate,
-		ConnectionDetails: eks.GetConnectionDetails(ctx, rsp.Cluster, e.sts),
+		ConnectionDetails: eks.GetConnectionDetails(ctx, rsp.Cluster, e.sts, &cr.Spec.ForProvider),
 	}, nil
 }
 
@@ -19,6 +19,7 @@ package cluster
 import (
 	"context"
 	"testing"
+	"time"
 
 	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
 	awsekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
@@ -28,7 +29,9 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/crossplane-contrib/provider-aws/apis/eks/v1beta1"
 	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
@@ -80,6 +83,10 @@ func withConfig(c v1beta1.VpcConfigRequest) clusterModifier {
 	return func(r *v1beta1.Cluster) { r.Spec.ForProvider.ResourcesVpcConfig = c }
 }
 
+func withConnectionSecret(ref *xpv1.SecretReference) clusterModifier {
+	return func(r *v1beta1.Cluster) { r.Spec.WriteConnectionSecretToReference = ref }
+}
+
 func cluster(m ...clusterModifier) *v1beta1.Cluster {
 	cr := &v1beta1.Cluster{}
 	for _, f := range m {
@@ -122,7 +129,7 @@ func TestObserve(t *testing.T) {
 				result: managed.ExternalObservation{
 					ResourceExists:    true,
 					ResourceUpToDate:  true,
-					ConnectionDetails: eks.GetConnectionDetails(context.TODO(), &awsekstypes.Cluster{}, &fake.MockSTSClient{}),
+					ConnectionDetails: eks.GetConnectionDetails(context.TODO(), &awsekstypes.Cluster{}, &fake.MockSTSClient{}, &v1beta1.ClusterParameters{}),
 				},
 			},
 		},
@@ -146,7 +153,7 @@ func TestObserve(t *testing.T) {
 				result: managed.ExternalObservation{
 					ResourceExists:    true,
 					ResourceUpToDate:  true,
-					ConnectionDetails: eks.GetConnectionDetails(context.TODO(), &awsekstypes.Cluster{}, &fake.MockSTSClient{}),
+					ConnectionDetails: eks.GetConnectionDetails(context.TODO(), &awsekstypes.Cluster{}, &fake.MockSTSClient{}, &v1beta1.ClusterParameters{}),
 				},
 			},
 		},
@@ -170,7 +177,7 @@ func TestObserve(t *testing.T) {
 				result: managed.ExternalObservation{
 					ResourceExists:    true,
 					ResourceUpToDate:  true,
-					ConnectionDetails: eks.GetConnectionDetails(context.TODO(), &awsekstypes.Cluster{}, &fake.MockSTSClient{}),
+					ConnectionDetails: eks.GetConnectionDetails(context.TODO(), &awsekstypes.Cluster{}, &fake.MockSTSClient{}, &v1beta1.ClusterParameters{}),
 				},
 			},
 		},
@@ -228,7 +235,7 @@ func TestObserve(t *testing.T) {
 				result: managed.ExternalObservation{
 					ResourceExists:    true,
 					ResourceUpToDate:  true,
-					ConnectionDetails: eks.GetConnectionDetails(context.TODO(), &awsekstypes.Cluster{}, &fake.MockSTSClient{}),
+					ConnectionDetails: eks.GetConnectionDetails(context.TODO(), &awsekstypes.Cluster{}, &fake.MockSTSClient{}, &v1beta1.ClusterParameters{}),
 				},
 			},
 		},
@@ -676,3 +683,78 @@ func TestInitialize(t *testing.T) {
 		})
 	}
 }
+
+func TestRequeueForTokenRefresh(t *testing.T) {
+	secretRef := &xpv1.SecretReference{Name: "kubeconfig", Namespace: "crossplane-system"}
+	now := metav1.Now()
+	type args struct {
+		cr  *v1beta1.Cluster
+		res reconcile.Result
+	}
+	cases := map[string]struct {
+		args args
+		want reconcile.Result
+	}{
+		"NoConnectionSecret": {
+			args: args{
+				cr:  cluster(),
+				res: reconcile.Result{RequeueAfter: time.Hour},
+			},
+			want: reconcile.Result{RequeueAfter: time.Hour},
+		},
+		"TokenMode": {
+			args: args{
+				cr:  cluster(withConnectionSecret(secretRef)),
+				res: reconcile.Result{RequeueAfter: time.Hour},
+			},
+			want: reconcile.Result{RequeueAfter: tokenRefreshInterval},
+		},
+		"TokenModeShorterPollInterval": {
+			args: args{
+				cr:  cluster(withConnectionSecret(secretRef)),
+				res: reconcile.Result{RequeueAfter: time.Minute},
+			},
+			want: reconcile.Result{RequeueAfter: time.Minute},
+		},
+		"TokenModeNoRequeue": {
+			args: args{
+				cr: cluster(withConnectionSecret(secretRef)),
+			},
+			want: reconcile.Result{RequeueAfter: tokenRefreshInterval},
+		},
+		"TokenModeBackoff": {
+			args: args{
+				cr:  cluster(withConnectionSecret(secretRef)),
+				res: reconcile.Result{Requeue: true},
+			},
+			want: reconcile.Result{Requeue: true},
+		},
+		"ExecMode": {
+			args: args{
+				cr: cluster(withConnectionSecret(secretRef), func(c *v1beta1.Cluster) {
+					c.Spec.ForProvider.KubeconfigAuthentication = &v1beta1.KubeconfigAuthentication{Mode: v1beta1.KubeconfigAuthenticationModeExec}
+				}),
+				res: reconcile.Result{RequeueAfter: time.Hour},
+			},
+			want: reconcile.Result{RequeueAfter: time.Hour},
+		},
+		"Deleted": {
+			args: args{
+				cr: cluster(withConnectionSecret(secretRef), func(c *v1beta1.Cluster) {
+					c.SetDeletionTimestamp(&now)
+				}),
+				res: reconcile.Result{RequeueAfter: time.Hour},
+			},
+			want: reconcile.Result{RequeueAfter: time.Hour},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := requeueForTokenRefresh(tc.args.cr, tc.args.res)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("requeueForTokenRefresh(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -18,6 +18,7 @@ package accesskey
 
 import (
 	"context"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
@@ -46,6 +47,14 @@ const (
 	errCreate           = "failed to create the AccessKey resource"
 	errDelete           = "failed to delete the AccessKey resource"
 	errUpdate           = "failed to update the AccessKey resource"
+	errGetLastUsed      = "failed to get the last usage of the AccessKey resource"
+	errRotate           = "failed to create a new access key to rotate the AccessKey resource"
+	errDeactivate       = "failed to deactivate the previous access key of the AccessKey resource"
+	errDeletePrevious   = "failed to delete the previous access key of the AccessKey resource"
+	errKubeUpdateFailed = "cannot update AccessKey custom resource"
+	errPublishRotated   = "cannot publish the connection details of the new access key"
+
+	errFmtRecordRotated = "cannot record the new access key %s, which is already published, in the AccessKey custom resource"
 )
 
 // SetupAccessKey adds a controller that reconciles AccessKeys.
@@ -58,7 +67,7 @@ func SetupAccessKey(mgr ctrl.Manager, o controller.Options) error {
 	}
 
 	reconcilerOpts := []managed.ReconcilerOption{
-		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: iam.NewAccessClient}),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: iam.NewAccessClient, publisher: managed.PublisherChain(cps)}),
 		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
@@ -85,6 +94,7 @@ func SetupAccessKey(mgr ctrl.Manager, o controller.Options) error {
 type connector struct {
 	kube        client.Client
 	newClientFn func(config aws.Config) iam.AccessClient
+	publisher   managed.ConnectionPublisher
 }
 
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -92,12 +102,13 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+	return &external{client: c.newClientFn(*cfg), kube: c.kube, publisher: c.publisher}, nil
 }
 
 type external struct {
-	client iam.AccessClient
-	kube   client.Client
+	client    iam.AccessClient
+	kube      client.Client
+	publisher managed.ConnectionPublisher
 }
 
 func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
@@ -114,17 +125,25 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 	if err != nil || len(keys.AccessKeyMetadata) == 0 {
 		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errList)
 	}
-	found := false
-	var accessKey awsiamtypes.AccessKeyMetadata
-	for _, key := range keys.AccessKeyMetadata {
-		if aws.ToString(key.AccessKeyId) == meta.GetExternalName(cr) {
-			found = true
-			accessKey = key
+	var accessKey, previous *awsiamtypes.AccessKeyMetadata
+	for i, key := range keys.AccessKeyMetadata {
+		switch aws.ToString(key.AccessKeyId) {
+		case meta.GetExternalName(cr):
+			accessKey = &keys.AccessKeyMetadata[i]
+		case cr.GetAnnotations()[v1beta1.AnnotationKeyPreviousAccessKey]:
+			previous = &keys.AccessKeyMetadata[i]
 		}
 	}
-	if !found {
+	if accessKey == nil {
 		return managed.ExternalObservation{ResourceExists: false}, nil
 	}
+
+	used, err := e.client.GetAccessKeyLastUsed(ctx, &awsiam.GetAccessKeyLastUsedInput{AccessKeyId: accessKey.AccessKeyId})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errGetLastUsed)
+	}
+	cr.Status.AtProvider = iam.GenerateAccessKeyObservation(*accessKey, used.AccessKeyLastUsed, previous)
+
 	switch accessKey.Status {
 	case awsiamtypes.StatusTypeActive:
 		cr.SetConditions(xpv1.Available())
@@ -135,7 +154,7 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 	cr.Spec.ForProvider.Status = awsclient.LateInitializeString(cr.Spec.ForProvider.Status, aws.String(string(accessKey.Status)))
 	return managed.ExternalObservation{
 		ResourceExists:          true,
-		ResourceUpToDate:        string(accessKey.Status) == cr.Spec.ForProvider.Status,
+		ResourceUpToDate:        string(accessKey.Status) == cr.Spec.ForProvider.Status && iam.NextAccessKeyRotationAction(cr, time.Now()) == iam.AccessKeyRotationNone,
 		ResourceLateInitialized: current != cr.Spec.ForProvider.Status,
 	}, nil
 }
@@ -151,15 +170,8 @@ func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.Ex
 		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
 	}
 
-	var conn managed.ConnectionDetails
-	if response != nil && response.AccessKey != nil {
-		conn = managed.ConnectionDetails{
-			xpv1.ResourceCredentialsSecretUserKey:     []byte(aws.ToString(response.AccessKey.AccessKeyId)),
-			xpv1.ResourceCredentialsSecretPasswordKey: []byte(aws.ToString(response.AccessKey.SecretAccessKey)),
-		}
-	}
 	meta.SetExternalName(cr, aws.ToString(response.AccessKey.AccessKeyId))
-	return managed.ExternalCreation{ConnectionDetails: conn}, nil
+	return managed.ExternalCreation{ConnectionDetails: connectionDetails(response.AccessKey)}, nil
 }
 
 func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
@@ -173,8 +185,72 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		Status:      awsiamtypes.StatusType(cr.Spec.ForProvider.Status),
 		UserName:    aws.String(cr.Spec.ForProvider.Username),
 	})
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+	}
+
+	switch iam.NextAccessKeyRotationAction(cr, time.Now()) {
+	case iam.AccessKeyRotationCreate:
+		return e.rotate(ctx, cr)
+	case iam.AccessKeyRotationDeactivate:
+		_, err := e.client.UpdateAccessKey(ctx, &awsiam.UpdateAccessKeyInput{
+			AccessKeyId: aws.String(cr.Status.AtProvider.PreviousAccessKeyID),
+			Status:      awsiamtypes.StatusTypeInactive,
+			UserName:    aws.String(cr.Spec.ForProvider.Username),
+		})
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errDeactivate)
+	case iam.AccessKeyRotationDelete:
+		_, err := e.client.DeleteAccessKey(ctx, &awsiam.DeleteAccessKeyInput{
+			AccessKeyId: aws.String(cr.Status.AtProvider.PreviousAccessKeyID),
+			UserName:    aws.String(cr.Spec.ForProvider.Username),
+		})
+		if resource.Ignore(iam.IsErrorNotFound, err) != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errDeletePrevious)
+		}
+		return managed.ExternalUpdate{}, e.forgetPrevious(ctx, cr)
+	case iam.AccessKeyRotationForget:
+		return managed.ExternalUpdate{}, e.forgetPrevious(ctx, cr)
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+// rotate creates a new access key, which replaces the current one in the
+// connection secret. The current access key is recorded as previous access
+// key, so that it can be deactivated and deleted after the grace period.
+//
+// The connection details of the new access key are published before the
+// rotation is recorded, because its secret cannot be retrieved again. The
+// previous access key is thus only deactivated once the new one is in use.
+func (e *external) rotate(ctx context.Context, cr *v1beta1.AccessKey) (managed.ExternalUpdate, error) {
+	response, err := e.client.CreateAccessKey(ctx, &awsiam.CreateAccessKeyInput{UserName: aws.String(cr.Spec.ForProvider.Username)})
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errRotate)
+	}
+
+	conn := connectionDetails(response.AccessKey)
+	if _, err := e.publisher.PublishConnection(ctx, cr, conn); err != nil {
+		// The new access key would be leaked since nobody knows its secret.
+		_, _ = e.client.DeleteAccessKey(ctx, &awsiam.DeleteAccessKeyInput{
+			AccessKeyId: response.AccessKey.AccessKeyId,
+			UserName:    aws.String(cr.Spec.ForProvider.Username),
+		})
+		return managed.ExternalUpdate{}, errors.Wrap(err, errPublishRotated)
+	}
+
+	meta.AddAnnotations(cr, map[string]string{v1beta1.AnnotationKeyPreviousAccessKey: meta.GetExternalName(cr)})
+	meta.RemoveAnnotations(cr, v1beta1.AnnotationKeyRotateAccessKey)
+	meta.SetExternalName(cr, aws.ToString(response.AccessKey.AccessKeyId))
+	if err := e.kube.Update(ctx, cr); err != nil {
+		// The new access key must not be deleted, since it is already in use
+		// by the connection secret.
+		return managed.ExternalUpdate{}, errors.Wrapf(err, errFmtRecordRotated, aws.ToString(response.AccessKey.AccessKeyId))
+	}
+	return managed.ExternalUpdate{ConnectionDetails: conn}, nil
+}
 
-	return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+func (e *external) forgetPrevious(ctx context.Context, cr *v1beta1.AccessKey) error {
+	meta.RemoveAnnotations(cr, v1beta1.AnnotationKeyPreviousAccessKey)
+	return errors.Wrap(e.kube.Update(ctx, cr), errKubeUpdateFailed)
 }
 
 func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
@@ -185,6 +261,16 @@ func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
 
 	cr.Status.SetConditions(xpv1.Deleting())
 
+	if previous := cr.GetAnnotations()[v1beta1.AnnotationKeyPreviousAccessKey]; previous != "" {
+		_, err := e.client.DeleteAccessKey(ctx, &awsiam.DeleteAccessKeyInput{
+			UserName:    aws.String(cr.Spec.ForProvider.Username),
+			AccessKeyId: aws.String(previous),
+		})
+		if resource.Ignore(iam.IsErrorNotFound, err) != nil {
+			return awsclient.Wrap(err, errDeletePrevious)
+		}
+	}
+
 	_, err := e.client.DeleteAccessKey(ctx, &awsiam.DeleteAccessKeyInput{
 		UserName:    aws.String(cr.Spec.ForProvider.Username),
 		AccessKeyId: aws.String(meta.GetExternalName(cr)),
@@ -192,3 +278,13 @@ func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
 
 	return awsclient.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errDelete)
 }
+
+func connectionDetails(key *awsiamtypes.AccessKey) managed.ConnectionDetails {
+	if key == nil {
+		return nil
+	}
+	return managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretUserKey:     []byte(aws.ToString(key.AccessKeyId)),
+		xpv1.ResourceCredentialsSecretPasswordKey: []byte(aws.ToString(key.SecretAccessKey)),
+	}
+}
@@ -19,6 +19,7 @@ package accesskey
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
@@ -30,6 +31,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
@@ -46,14 +48,18 @@ var (
 	inactiveStatus = awsiamtypes.StatusTypeInactive
 	accessKeyID    = "accessKeyID"
 	secretKeyID    = "secretKeyID"
+	newAccessKeyID = "newAccessKeyID"
+	newSecretKeyID = "newSecretKeyID"
+	createDate     = time.Now().Add(-48 * time.Hour)
 
 	errBoom = errors.New("boom")
 )
 
 type args struct {
-	iam  iam.AccessClient
-	cr   resource.Managed
-	kube client.Client
+	iam       iam.AccessClient
+	cr        resource.Managed
+	kube      client.Client
+	publisher managed.ConnectionPublisher
 }
 
 type accessModifier func(*v1beta1.AccessKey)
@@ -80,6 +86,33 @@ func withAccessKey(keyid string) accessModifier {
 	}
 }
 
+func withAnnotations(a map[string]string) accessModifier {
+	return func(r *v1beta1.AccessKey) {
+		meta.AddAnnotations(r, a)
+	}
+}
+
+func withRotationPolicy(maxAge, gracePeriod time.Duration) accessModifier {
+	return func(r *v1beta1.AccessKey) {
+		r.Spec.ForProvider.RotationPolicy = &v1beta1.AccessKeyRotationPolicy{
+			MaxAge:      &metav1.Duration{Duration: maxAge},
+			GracePeriod: &metav1.Duration{Duration: gracePeriod},
+		}
+	}
+}
+
+func withObservation(o v1beta1.AccessKeyObservation) accessModifier {
+	return func(r *v1beta1.AccessKey) {
+		r.Status.AtProvider = o
+	}
+}
+
+func lastUsed(ctx context.Context, input *awsiam.GetAccessKeyLastUsedInput, opts []func(*awsiam.Options)) (*awsiam.GetAccessKeyLastUsedOutput, error) {
+	return &awsiam.GetAccessKeyLastUsedOutput{
+		AccessKeyLastUsed: &awsiamtypes.AccessKeyLastUsed{ServiceName: aws.String("N/A")},
+	}, nil
+}
+
 func accesskey(m ...accessModifier) *v1beta1.AccessKey {
 	cr := &v1beta1.AccessKey{}
 	for _, f := range m {
@@ -111,6 +144,7 @@ func TestObserve(t *testing.T) {
 							}},
 						}, nil
 					},
+					MockGetAccessKeyLastUsed: lastUsed,
 				},
 				cr: accesskey(withUsername(userName), withAccessKey(accessKeyID), withStatus(string(activeStatus))),
 			},
@@ -118,6 +152,7 @@ func TestObserve(t *testing.T) {
 				cr: accesskey(withUsername(userName),
 					withAccessKey(accessKeyID),
 					withStatus(string(activeStatus)),
+					withObservation(v1beta1.AccessKeyObservation{AccessKeyID: accessKeyID}),
 					withConditions(xpv1.Available())),
 				result: managed.ExternalObservation{
 					ResourceExists:   true,
@@ -137,6 +172,7 @@ func TestObserve(t *testing.T) {
 							}},
 						}, nil
 					},
+					MockGetAccessKeyLastUsed: lastUsed,
 				},
 				cr: accesskey(withUsername(userName), withAccessKey(accessKeyID), withStatus(string(activeStatus))),
 			},
@@ -144,6 +180,7 @@ func TestObserve(t *testing.T) {
 				cr: accesskey(withUsername(userName),
 					withAccessKey(accessKeyID),
 					withStatus(string(activeStatus)),
+					withObservation(v1beta1.AccessKeyObservation{AccessKeyID: accessKeyID}),
 					withConditions(xpv1.Unavailable())),
 				result: managed.ExternalObservation{
 					ResourceExists:   true,
@@ -172,6 +209,93 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"RotationDue": {
+			args: args{
+				iam: &fake.MockAccessClient{
+					MockListAccessKeys: func(ctx context.Context, input *awsiam.ListAccessKeysInput, opts []func(*awsiam.Options)) (*awsiam.ListAccessKeysOutput, error) {
+						return &awsiam.ListAccessKeysOutput{
+							AccessKeyMetadata: []awsiamtypes.AccessKeyMetadata{{
+								AccessKeyId: aws.String(accessKeyID),
+								CreateDate:  &createDate,
+								Status:      activeStatus,
+								UserName:    aws.String(userName),
+							}},
+						}, nil
+					},
+					MockGetAccessKeyLastUsed: func(ctx context.Context, input *awsiam.GetAccessKeyLastUsedInput, opts []func(*awsiam.Options)) (*awsiam.GetAccessKeyLastUsedOutput, error) {
+						return &awsiam.GetAccessKeyLastUsedOutput{
+							AccessKeyLastUsed: &awsiamtypes.AccessKeyLastUsed{
+								LastUsedDate: &createDate,
+								ServiceName:  aws.String("s3"),
+							},
+						}, nil
+					},
+				},
+				cr: accesskey(withUsername(userName), withAccessKey(accessKeyID), withStatus(string(activeStatus)),
+					withRotationPolicy(24*time.Hour, time.Hour)),
+			},
+			want: want{
+				cr: accesskey(withUsername(userName),
+					withAccessKey(accessKeyID),
+					withStatus(string(activeStatus)),
+					withRotationPolicy(24*time.Hour, time.Hour),
+					withObservation(v1beta1.AccessKeyObservation{
+						AccessKeyID:         accessKeyID,
+						CreateDate:          &metav1.Time{Time: createDate},
+						LastUsedDate:        &metav1.Time{Time: createDate},
+						LastUsedServiceName: "s3",
+					}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"PreviousKeyInGracePeriod": {
+			args: args{
+				iam: &fake.MockAccessClient{
+					MockListAccessKeys: func(ctx context.Context, input *awsiam.ListAccessKeysInput, opts []func(*awsiam.Options)) (*awsiam.ListAccessKeysOutput, error) {
+						return &awsiam.ListAccessKeysOutput{
+							AccessKeyMetadata: []awsiamtypes.AccessKeyMetadata{
+								{
+									AccessKeyId: aws.String(accessKeyID),
+									CreateDate:  &createDate,
+									Status:      activeStatus,
+								},
+								{
+									AccessKeyId: aws.String(newAccessKeyID),
+									CreateDate:  &createDate,
+									Status:      activeStatus,
+								},
+							},
+						}, nil
+					},
+					MockGetAccessKeyLastUsed: lastUsed,
+				},
+				cr: accesskey(withUsername(userName), withAccessKey(newAccessKeyID), withStatus(string(activeStatus)),
+					withAnnotations(map[string]string{v1beta1.AnnotationKeyPreviousAccessKey: accessKeyID}),
+					withRotationPolicy(96*time.Hour, 72*time.Hour)),
+			},
+			want: want{
+				cr: accesskey(withUsername(userName),
+					withAccessKey(newAccessKeyID),
+					withStatus(string(activeStatus)),
+					withAnnotations(map[string]string{v1beta1.AnnotationKeyPreviousAccessKey: accessKeyID}),
+					withRotationPolicy(96*time.Hour, 72*time.Hour),
+					withObservation(v1beta1.AccessKeyObservation{
+						AccessKeyID:             newAccessKeyID,
+						CreateDate:              &metav1.Time{Time: createDate},
+						PreviousAccessKeyID:     accessKeyID,
+						PreviousAccessKeyStatus: string(activeStatus),
+					}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
 		"InValidInput": {
 			args: args{
 				cr: unexpectedItem,
@@ -181,6 +305,28 @@ func TestObserve(t *testing.T) {
 				err: errors.New(errUnexpectedObject),
 			},
 		},
+		"GetLastUsedError": {
+			args: args{
+				iam: &fake.MockAccessClient{
+					MockListAccessKeys: func(ctx context.Context, input *awsiam.ListAccessKeysInput, opts []func(*awsiam.Options)) (*awsiam.ListAccessKeysOutput, error) {
+						return &awsiam.ListAccessKeysOutput{
+							AccessKeyMetadata: []awsiamtypes.AccessKeyMetadata{{
+								AccessKeyId: aws.String(accessKeyID),
+								Status:      activeStatus,
+							}},
+						}, nil
+					},
+					MockGetAccessKeyLastUsed: func(ctx context.Context, input *awsiam.GetAccessKeyLastUsedInput, opts []func(*awsiam.Options)) (*awsiam.GetAccessKeyLastUsedOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: accesskey(withAccessKey(accessKeyID)),
+			},
+			want: want{
+				cr:  accesskey(withAccessKey(accessKeyID)),
+				err: awsclient.Wrap(errBoom, errGetLastUsed),
+			},
+		},
 		"ListError": {
 			args: args{
 				iam: &fake.MockAccessClient{
@@ -326,6 +472,25 @@ func TestDelete(t *testing.T) {
 					withConditions(xpv1.Deleting())),
 			},
 		},
+		"DeletesPreviousKey": {
+			args: args{
+				iam: &fake.MockAccessClient{
+					MockDeleteAccessKey: func(ctx context.Context, input *awsiam.DeleteAccessKeyInput, opts []func(*awsiam.Options)) (*awsiam.DeleteAccessKeyOutput, error) {
+						if aws.ToString(input.AccessKeyId) == accessKeyID {
+							return nil, &awsiamtypes.NoSuchEntityException{}
+						}
+						return &awsiam.DeleteAccessKeyOutput{}, nil
+					},
+				},
+				cr: accesskey(withAccessKey(newAccessKeyID), withUsername(userName),
+					withAnnotations(map[string]string{v1beta1.AnnotationKeyPreviousAccessKey: accessKeyID})),
+			},
+			want: want{
+				cr: accesskey(withAccessKey(newAccessKeyID), withUsername(userName),
+					withAnnotations(map[string]string{v1beta1.AnnotationKeyPreviousAccessKey: accessKeyID}),
+					withConditions(xpv1.Deleting())),
+			},
+		},
 		"InValidInput": {
 			args: args{
 				cr: unexpectedItem,
@@ -403,6 +568,198 @@ func TestUpdate(t *testing.T) {
 				cr: accesskey(withAccessKey(accessKeyID), withUsername(userName), withStatus(string(activeStatus))),
 			},
 		},
+		"Rotate": {
+			args: args{
+				iam: &fake.MockAccessClient{
+					MockUpdateAccessKey: func(ctx context.Context, input *awsiam.UpdateAccessKeyInput, opts []func(*awsiam.Options)) (*awsiam.UpdateAccessKeyOutput, error) {
+						return &awsiam.UpdateAccessKeyOutput{}, nil
+					},
+					MockCreateAccessKey: func(ctx context.Context, input *awsiam.CreateAccessKeyInput, opts []func(*awsiam.Options)) (*awsiam.CreateAccessKeyOutput, error) {
+						return &awsiam.CreateAccessKeyOutput{
+							AccessKey: &awsiamtypes.AccessKey{
+								AccessKeyId:     aws.String(newAccessKeyID),
+								SecretAccessKey: aws.String(newSecretKeyID),
+								Status:          activeStatus,
+								UserName:        aws.String(userName),
+							},
+						}, nil
+					},
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				publisher: managed.ConnectionPublisherFns{
+					PublishConnectionFn: func(_ context.Context, o resource.ConnectionSecretOwner, c managed.ConnectionDetails) (bool, error) {
+						if meta.GetExternalName(o) != accessKeyID {
+							return false, errors.New("connection details published after the rotation was recorded")
+						}
+						if string(c[xpv1.ResourceCredentialsSecretUserKey]) != newAccessKeyID {
+							return false, errors.Errorf("unexpected access key %s published", c[xpv1.ResourceCredentialsSecretUserKey])
+						}
+						return true, nil
+					},
+				},
+				cr: accesskey(withAccessKey(accessKeyID), withUsername(userName), withStatus(string(activeStatus)),
+					withAnnotations(map[string]string{v1beta1.AnnotationKeyRotateAccessKey: "now"})),
+			},
+			want: want{
+				cr: accesskey(withAccessKey(newAccessKeyID), withUsername(userName), withStatus(string(activeStatus)),
+					withAnnotations(map[string]string{v1beta1.AnnotationKeyPreviousAccessKey: accessKeyID})),
+				update: managed.ExternalUpdate{
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretUserKey:     []byte(newAccessKeyID),
+						xpv1.ResourceCredentialsSecretPasswordKey: []byte(newSecretKeyID),
+					},
+				},
+			},
+		},
+		"RotateKubeUpdateError": {
+			args: args{
+				iam: &fake.MockAccessClient{
+					MockUpdateAccessKey: func(ctx context.Context, input *awsiam.UpdateAccessKeyInput, opts []func(*awsiam.Options)) (*awsiam.UpdateAccessKeyOutput, error) {
+						return &awsiam.UpdateAccessKeyOutput{}, nil
+					},
+					MockCreateAccessKey: func(ctx context.Context, input *awsiam.CreateAccessKeyInput, opts []func(*awsiam.Options)) (*awsiam.CreateAccessKeyOutput, error) {
+						return &awsiam.CreateAccessKeyOutput{
+							AccessKey: &awsiamtypes.AccessKey{
+								AccessKeyId:     aws.String(newAccessKeyID),
+								SecretAccessKey: aws.String(newSecretKeyID),
+							},
+						}, nil
+					},
+					MockDeleteAccessKey: func(ctx context.Context, input *awsiam.DeleteAccessKeyInput, opts []func(*awsiam.Options)) (*awsiam.DeleteAccessKeyOutput, error) {
+						return nil, errors.Errorf("unexpected deletion of %s", aws.ToString(input.AccessKeyId))
+					},
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
+				publisher: managed.ConnectionPublisherFns{
+					PublishConnectionFn: func(_ context.Context, _ resource.ConnectionSecretOwner, _ managed.ConnectionDetails) (bool, error) {
+						return true, nil
+					},
+				},
+				cr: accesskey(withAccessKey(accessKeyID), withUsername(userName), withStatus(string(activeStatus)),
+					withRotationPolicy(24*time.Hour, time.Hour),
+					withObservation(v1beta1.AccessKeyObservation{AccessKeyID: accessKeyID, CreateDate: &metav1.Time{Time: createDate}})),
+			},
+			want: want{
+				cr: accesskey(withAccessKey(newAccessKeyID), withUsername(userName), withStatus(string(activeStatus)),
+					withAnnotations(map[string]string{v1beta1.AnnotationKeyPreviousAccessKey: accessKeyID}),
+					withRotationPolicy(24*time.Hour, time.Hour),
+					withObservation(v1beta1.AccessKeyObservation{AccessKeyID: accessKeyID, CreateDate: &metav1.Time{Time: createDate}})),
+				err: errors.Wrapf(errBoom, errFmtRecordRotated, newAccessKeyID),
+			},
+		},
+		"RotatePublishError": {
+			args: args{
+				iam: &fake.MockAccessClient{
+					MockUpdateAccessKey: func(ctx context.Context, input *awsiam.UpdateAccessKeyInput, opts []func(*awsiam.Options)) (*awsiam.UpdateAccessKeyOutput, error) {
+						return &awsiam.UpdateAccessKeyOutput{}, nil
+					},
+					MockCreateAccessKey: func(ctx context.Context, input *awsiam.CreateAccessKeyInput, opts []func(*awsiam.Options)) (*awsiam.CreateAccessKeyOutput, error) {
+						return &awsiam.CreateAccessKeyOutput{
+							AccessKey: &awsiamtypes.AccessKey{
+								AccessKeyId:     aws.String(newAccessKeyID),
+								SecretAccessKey: aws.String(newSecretKeyID),
+							},
+						}, nil
+					},
+					MockDeleteAccessKey: func(ctx context.Context, input *awsiam.DeleteAccessKeyInput, opts []func(*awsiam.Options)) (*awsiam.DeleteAccessKeyOutput, error) {
+						if aws.ToString(input.AccessKeyId) != newAccessKeyID {
+							return nil, errors.Errorf("unexpected deletion of %s", aws.ToString(input.AccessKeyId))
+						}
+						return &awsiam.DeleteAccessKeyOutput{}, nil
+					},
+				},
+				kube: &test.MockClient{
+					MockUpdate: func(_ context.Context, _ client.Object, _ ...client.UpdateOption) error {
+						return errors.New("rotation recorded although publishing failed")
+					},
+				},
+				publisher: managed.ConnectionPublisherFns{
+					PublishConnectionFn: func(_ context.Context, _ resource.ConnectionSecretOwner, _ managed.ConnectionDetails) (bool, error) {
+						return false, errBoom
+					},
+				},
+				cr: accesskey(withAccessKey(accessKeyID), withUsername(userName), withStatus(string(activeStatus)),
+					withAnnotations(map[string]string{v1beta1.AnnotationKeyRotateAccessKey: "now"})),
+			},
+			want: want{
+				cr: accesskey(withAccessKey(accessKeyID), withUsername(userName), withStatus(string(activeStatus)),
+					withAnnotations(map[string]string{v1beta1.AnnotationKeyRotateAccessKey: "now"})),
+				err: errors.Wrap(errBoom, errPublishRotated),
+			},
+		},
+		"DeactivatePrevious": {
+			args: args{
+				iam: &fake.MockAccessClient{
+					MockUpdateAccessKey: func(ctx context.Context, input *awsiam.UpdateAccessKeyInput, opts []func(*awsiam.Options)) (*awsiam.UpdateAccessKeyOutput, error) {
+						if aws.ToString(input.AccessKeyId) == accessKeyID && input.Status != inactiveStatus {
+							return nil, errors.Errorf("unexpected status %s of previous access key", input.Status)
+						}
+						return &awsiam.UpdateAccessKeyOutput{}, nil
+					},
+				},
+				cr: accesskey(withAccessKey(newAccessKeyID), withUsername(userName), withStatus(string(activeStatus)),
+					withAnnotations(map[string]string{v1beta1.AnnotationKeyPreviousAccessKey: accessKeyID}),
+					withRotationPolicy(96*time.Hour, 36*time.Hour),
+					withObservation(v1beta1.AccessKeyObservation{
+						AccessKeyID:             newAccessKeyID,
+						CreateDate:              &metav1.Time{Time: createDate},
+						PreviousAccessKeyID:     accessKeyID,
+						PreviousAccessKeyStatus: string(activeStatus),
+					})),
+			},
+			want: want{
+				cr: accesskey(withAccessKey(newAccessKeyID), withUsername(userName), withStatus(string(activeStatus)),
+					withAnnotations(map[string]string{v1beta1.AnnotationKeyPreviousAccessKey: accessKeyID}),
+					withRotationPolicy(96*time.Hour, 36*time.Hour),
+					withObservation(v1beta1.AccessKeyObservation{
+						AccessKeyID:             newAccessKeyID,
+						CreateDate:              &metav1.Time{Time: createDate},
+						PreviousAccessKeyID:     accessKeyID,
+						PreviousAccessKeyStatus: string(activeStatus),
+					})),
+			},
+		},
+		"DeletePrevious": {
+			args: args{
+				iam: &fake.MockAccessClient{
+					MockUpdateAccessKey: func(ctx context.Context, input *awsiam.UpdateAccessKeyInput, opts []func(*awsiam.Options)) (*awsiam.UpdateAccessKeyOutput, error) {
+						return &awsiam.UpdateAccessKeyOutput{}, nil
+					},
+					MockDeleteAccessKey: func(ctx context.Context, input *awsiam.DeleteAccessKeyInput, opts []func(*awsiam.Options)) (*awsiam.DeleteAccessKeyOutput, error) {
+						if aws.ToString(input.AccessKeyId) != accessKeyID {
+							return nil, errors.Errorf("unexpected deletion of %s", aws.ToString(input.AccessKeyId))
+						}
+						return &awsiam.DeleteAccessKeyOutput{}, nil
+					},
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				cr: accesskey(withAccessKey(newAccessKeyID), withUsername(userName), withStatus(string(activeStatus)),
+					withAnnotations(map[string]string{v1beta1.AnnotationKeyPreviousAccessKey: accessKeyID}),
+					withRotationPolicy(96*time.Hour, 12*time.Hour),
+					withObservation(v1beta1.AccessKeyObservation{
+						AccessKeyID:             newAccessKeyID,
+						CreateDate:              &metav1.Time{Time: createDate},
+						PreviousAccessKeyID:     accessKeyID,
+						PreviousAccessKeyStatus: string(inactiveStatus),
+					})),
+			},
+			want: want{
+				cr: accesskey(withAccessKey(newAccessKeyID), withUsername(userName), withStatus(string(activeStatus)),
+					withRotationPolicy(96*time.Hour, 12*time.Hour),
+					withObservation(v1beta1.AccessKeyObservation{
+						AccessKeyID:             newAccessKeyID,
+						CreateDate:              &metav1.Time{Time: createDate},
+						PreviousAccessKeyID:     accessKeyID,
+						PreviousAccessKeyStatus: string(inactiveStatus),
+					})),
+			},
+		},
 		"InValidInput": {
 			args: args{
 				cr: unexpectedItem,
@@ -430,7 +787,7 @@ func TestUpdate(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.iam}
+			e := &external{client: tc.iam, kube: tc.kube, publisher: tc.publisher}
 			update, err := e.Update(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
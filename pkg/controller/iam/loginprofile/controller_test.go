@@ -0,0 +1,351 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loginprofile
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	awsiamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/iam"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/iam/fake"
+)
+
+var (
+	// an arbitrary managed resource
+	unexpectedItem resource.Managed
+	userName       = "some arbitrary name"
+	createDate     = time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	iam iam.LoginProfileClient
+	cr  resource.Managed
+}
+
+type loginProfileModifier func(*v1beta1.LoginProfile)
+
+func withConditions(c ...xpv1.Condition) loginProfileModifier {
+	return func(r *v1beta1.LoginProfile) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withUsername(username string) loginProfileModifier {
+	return func(r *v1beta1.LoginProfile) {
+		r.Spec.ForProvider.Username = username
+	}
+}
+
+func withPasswordResetRequired(b bool) loginProfileModifier {
+	return func(r *v1beta1.LoginProfile) {
+		r.Spec.ForProvider.PasswordResetRequired = aws.Bool(b)
+	}
+}
+
+func withExternalName(name string) loginProfileModifier {
+	return func(r *v1beta1.LoginProfile) {
+		meta.SetExternalName(r, name)
+	}
+}
+
+func withCreateDate(t time.Time) loginProfileModifier {
+	return func(r *v1beta1.LoginProfile) {
+		mt := metav1.NewTime(t)
+		r.Status.AtProvider.CreateDate = &mt
+	}
+}
+
+func loginProfile(m ...loginProfileModifier) *v1beta1.LoginProfile {
+	cr := &v1beta1.LoginProfile{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInputExists": {
+			args: args{
+				iam: &fake.MockLoginProfileClient{
+					MockGetLoginProfile: func(ctx context.Context, input *awsiam.GetLoginProfileInput, opts []func(*awsiam.Options)) (*awsiam.GetLoginProfileOutput, error) {
+						return &awsiam.GetLoginProfileOutput{
+							LoginProfile: &awsiamtypes.LoginProfile{
+								CreateDate: &createDate,
+								UserName:   aws.String(userName),
+							},
+						}, nil
+					},
+				},
+				cr: loginProfile(withUsername(userName)),
+			},
+			want: want{
+				cr: loginProfile(withUsername(userName),
+					withCreateDate(createDate),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"ValidInputNotExists": {
+			args: args{
+				iam: &fake.MockLoginProfileClient{
+					MockGetLoginProfile: func(ctx context.Context, input *awsiam.GetLoginProfileInput, opts []func(*awsiam.Options)) (*awsiam.GetLoginProfileOutput, error) {
+						return nil, &awsiamtypes.NoSuchEntityException{}
+					},
+				},
+				cr: loginProfile(withUsername(userName)),
+			},
+			want: want{
+				cr: loginProfile(withUsername(userName)),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"GetError": {
+			args: args{
+				iam: &fake.MockLoginProfileClient{
+					MockGetLoginProfile: func(ctx context.Context, input *awsiam.GetLoginProfileInput, opts []func(*awsiam.Options)) (*awsiam.GetLoginProfileOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: loginProfile(withUsername(userName)),
+			},
+			want: want{
+				cr:  loginProfile(withUsername(userName)),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	// password records the password that was sent to the API, which is
+	// randomly generated by the controller.
+	var password string
+
+	type want struct {
+		cr   resource.Managed
+		user string
+		err  error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				iam: &fake.MockLoginProfileClient{
+					MockCreateLoginProfile: func(ctx context.Context, input *awsiam.CreateLoginProfileInput, opts []func(*awsiam.Options)) (*awsiam.CreateLoginProfileOutput, error) {
+						if !input.PasswordResetRequired {
+							return nil, errors.New("password reset is not required")
+						}
+						password = aws.ToString(input.Password)
+						return &awsiam.CreateLoginProfileOutput{}, nil
+					},
+				},
+				cr: loginProfile(withUsername(userName), withPasswordResetRequired(true)),
+			},
+			want: want{
+				cr: loginProfile(withUsername(userName),
+					withPasswordResetRequired(true),
+					withExternalName(userName)),
+				user: userName,
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockLoginProfileClient{
+					MockCreateLoginProfile: func(ctx context.Context, input *awsiam.CreateLoginProfileInput, opts []func(*awsiam.Options)) (*awsiam.CreateLoginProfileOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: loginProfile(withUsername(userName)),
+			},
+			want: want{
+				cr:  loginProfile(withUsername(userName)),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			password = ""
+			e := &external{client: tc.iam}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if tc.want.err != nil {
+				return
+			}
+			if password == "" {
+				t.Errorf("r: no password was sent")
+			}
+			want := managed.ConnectionDetails{
+				xpv1.ResourceCredentialsSecretUserKey:     []byte(tc.want.user),
+				xpv1.ResourceCredentialsSecretPasswordKey: []byte(password),
+			}
+			if diff := cmp.Diff(want, o.ConnectionDetails); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				iam: &fake.MockLoginProfileClient{
+					MockDeleteLoginProfile: func(ctx context.Context, input *awsiam.DeleteLoginProfileInput, opts []func(*awsiam.Options)) (*awsiam.DeleteLoginProfileOutput, error) {
+						return &awsiam.DeleteLoginProfileOutput{}, nil
+					},
+				},
+				cr: loginProfile(withUsername(userName)),
+			},
+			want: want{
+				cr: loginProfile(withUsername(userName),
+					withConditions(xpv1.Deleting())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockLoginProfileClient{
+					MockDeleteLoginProfile: func(ctx context.Context, input *awsiam.DeleteLoginProfileInput, opts []func(*awsiam.Options)) (*awsiam.DeleteLoginProfileOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: loginProfile(),
+			},
+			want: want{
+				cr:  loginProfile(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+		"ResourceDoesNotExist": {
+			args: args{
+				iam: &fake.MockLoginProfileClient{
+					MockDeleteLoginProfile: func(ctx context.Context, input *awsiam.DeleteLoginProfileInput, opts []func(*awsiam.Options)) (*awsiam.DeleteLoginProfileOutput, error) {
+						return nil, &awsiamtypes.NoSuchEntityException{}
+					},
+				},
+				cr: loginProfile(),
+			},
+			want: want{
+				cr: loginProfile(withConditions(xpv1.Deleting())),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -53,6 +53,7 @@ const (
 	errEmptyPolicy      = "empty IAM Policy received from IAM API"
 	errPolicyVersion    = "No version for policy received from IAM API"
 	errUpToDate         = "cannot check if policy is up to date"
+	errInvalidPolicy    = "invalid IAM Policy document"
 	errKubeUpdateFailed = "cannot late initialize IAM Policy"
 	errTag              = "cannot tag policy"
 	errUntag            = "cannot untag policy"
@@ -197,10 +198,18 @@ func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.Ex
 		}
 	}
 
+	document, err := iam.GetPolicyDocument(cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+	if err := iam.ValidatePolicyDocument(document); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errInvalidPolicy)
+	}
+
 	createOutput, err := e.client.CreatePolicy(ctx, &awsiam.CreatePolicyInput{
 		Description:    cr.Spec.ForProvider.Description,
 		Path:           cr.Spec.ForProvider.Path,
-		PolicyDocument: aws.String(cr.Spec.ForProvider.Document),
+		PolicyDocument: aws.String(document),
 		PolicyName:     aws.String(cr.Spec.ForProvider.Name),
 		Tags:           inputPolicyTags,
 	})
@@ -225,13 +234,21 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 	// for an update request when 5 versions already exist.
 	// The new version is set as default.
 
+	document, err := iam.GetPolicyDocument(cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+	}
+	if err := iam.ValidatePolicyDocument(document); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errInvalidPolicy)
+	}
+
 	if err := e.deleteOldestVersion(ctx, meta.GetExternalName(cr)); err != nil {
 		return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
 	}
 
-	_, err := e.client.CreatePolicyVersion(ctx, &awsiam.CreatePolicyVersionInput{
+	_, err = e.client.CreatePolicyVersion(ctx, &awsiam.CreatePolicyVersionInput{
 		PolicyArn:      aws.String(meta.GetExternalName(cr)),
-		PolicyDocument: aws.String(cr.Spec.ForProvider.Document),
+		PolicyDocument: aws.String(document),
 		SetAsDefault:   true,
 	})
 
@@ -57,6 +57,16 @@ var (
 		  }
 		]
 	  }`
+	invalidDocument = `{
+		"Version": "2012-10-17",
+		"Statement": [
+		  {
+			  "Effect": "Allow",
+			  "Action": "elastic-inference",
+			  "Resource": "*"
+		  }
+		]
+	  }`
 	documentURLEscaped = url.QueryEscape(document)
 	boolFalse          = false
 
@@ -128,6 +138,12 @@ func withSpec(spec v1beta1.PolicyParameters) policyModifier {
 	}
 }
 
+func withDocument(document string) policyModifier {
+	return func(r *v1beta1.Policy) {
+		r.Spec.ForProvider.Document = document
+	}
+}
+
 func withPath(path string) policyModifier {
 	return func(r *v1beta1.Policy) {
 		r.Spec.ForProvider.Path = awsclient.String(path)
@@ -522,13 +538,22 @@ func TestCreate(t *testing.T) {
 						return nil, errBoom
 					},
 				},
-				cr: policy(),
+				cr: policy(withDocument(document)),
 			},
 			want: want{
-				cr:  policy(),
+				cr:  policy(withDocument(document)),
 				err: awsclient.Wrap(errBoom, errCreate),
 			},
 		},
+		"InvalidDocument": {
+			args: args{
+				cr: policy(withDocument(invalidDocument)),
+			},
+			want: want{
+				cr:  policy(withDocument(invalidDocument)),
+				err: errors.Wrap(errors.New(`statement 0: invalid action "elastic-inference", must be * or of the form <service>:<action>`), errInvalidPolicy),
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -585,10 +610,10 @@ func TestUpdate(t *testing.T) {
 						}, nil
 					},
 				},
-				cr: policy(withExternalName(policyArn)),
+				cr: policy(withDocument(document), withExternalName(policyArn)),
 			},
 			want: want{
-				cr: policy(withExternalName(policyArn)),
+				cr: policy(withDocument(document), withExternalName(policyArn)),
 			},
 		},
 		"InValidInput": {
@@ -607,10 +632,10 @@ func TestUpdate(t *testing.T) {
 						return nil, errBoom
 					},
 				},
-				cr: policy(withExternalName(policyArn)),
+				cr: policy(withDocument(document), withExternalName(policyArn)),
 			},
 			want: want{
-				cr:  policy(withExternalName(policyArn)),
+				cr:  policy(withDocument(document), withExternalName(policyArn)),
 				err: awsclient.Wrap(errBoom, errUpdate),
 			},
 		},
@@ -627,10 +652,10 @@ func TestUpdate(t *testing.T) {
 						return nil, errBoom
 					},
 				},
-				cr: policy(withExternalName(policyArn)),
+				cr: policy(withDocument(document), withExternalName(policyArn)),
 			},
 			want: want{
-				cr:  policy(withExternalName(policyArn)),
+				cr:  policy(withDocument(document), withExternalName(policyArn)),
 				err: awsclient.Wrap(errBoom, errUpdate),
 			},
 		},
@@ -692,14 +717,14 @@ func TestUpdate_Tags(t *testing.T) {
 						return nil, errBoom
 					},
 				},
-				cr: policy(
+				cr: policy(withDocument(document),
 					withExternalName(policyArn),
 					withTags(map[string]string{
 						"key": "value",
 					})),
 			},
 			want: want{
-				cr: policy(
+				cr: policy(withDocument(document),
 					withExternalName(policyArn),
 					withTags(map[string]string{
 						"key": "value",
@@ -719,14 +744,14 @@ func TestUpdate_Tags(t *testing.T) {
 						return nil, nil
 					},
 				},
-				cr: policy(
+				cr: policy(withDocument(document),
 					withExternalName(policyArn),
 					withTags(map[string]string{
 						"key": "value",
 					})),
 			},
 			want: want{
-				cr: policy(
+				cr: policy(withDocument(document),
 					withExternalName(policyArn),
 					withTags(map[string]string{
 						"key": "value",
@@ -756,7 +781,7 @@ func TestUpdate_Tags(t *testing.T) {
 						return nil, nil
 					},
 				},
-				cr: policy(
+				cr: policy(withDocument(document),
 					withExternalName(policyArn),
 					withTags(map[string]string{
 						"key1": "value1",
@@ -764,7 +789,7 @@ func TestUpdate_Tags(t *testing.T) {
 					})),
 			},
 			want: want{
-				cr: policy(
+				cr: policy(withDocument(document),
 					withExternalName(policyArn),
 					withTags(map[string]string{
 						"key2": "value1",
@@ -795,14 +820,14 @@ func TestUpdate_Tags(t *testing.T) {
 						return nil, errBoom
 					},
 				},
-				cr: policy(
+				cr: policy(withDocument(document),
 					withExternalName(policyArn),
 					withTags(map[string]string{
 						"key2": "value2",
 					})),
 			},
 			want: want{
-				cr: policy(
+				cr: policy(withDocument(document),
 					withExternalName(policyArn),
 					withTags(map[string]string{
 						"key2": "value2",
@@ -827,14 +852,14 @@ func TestUpdate_Tags(t *testing.T) {
 						return nil, nil
 					},
 				},
-				cr: policy(
+				cr: policy(withDocument(document),
 					withExternalName(policyArn),
 					withTags(map[string]string{
 						"key2": "value2",
 					})),
 			},
 			want: want{
-				cr: policy(
+				cr: policy(withDocument(document),
 					withExternalName(policyArn),
 					withTags(map[string]string{
 						"key2": "value2",
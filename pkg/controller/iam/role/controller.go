@@ -49,6 +49,13 @@ const (
 	errSDK              = "empty Role received from IAM API"
 	errCreatePatch      = "failed to create patch object for comparison"
 
+	errPutRolePolicy    = "failed to put inline policy of the Role resource"
+	errDeleteRolePolicy = "failed to delete inline policy of the Role resource"
+	errInvalidPolicy    = "invalid trust policy document of the Role resource"
+	errFmtInvalidInline = "invalid inline policy %q of the Role resource"
+	errAttachPolicy     = "failed to attach managed policy to the Role resource"
+	errDetachPolicy     = "failed to detach managed policy from the Role resource"
+
 	errKubeUpdateFailed = "cannot late initialize Role"
 	errUpToDateFailed   = "cannot check whether object is up-to-date"
 )
@@ -142,6 +149,12 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errUpToDateFailed)
 	}
+	if upToDate {
+		upToDate, err = e.arePoliciesUpToDate(ctx, cr)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpToDateFailed)
+		}
+	}
 
 	return managed.ExternalObservation{
 		ResourceExists:   true,
@@ -161,7 +174,14 @@ func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.Ex
 
 	cr.Status.SetConditions(xpv1.Creating())
 
-	_, err := e.client.CreateRole(ctx, iam.GenerateCreateRoleInput(meta.GetExternalName(cr), &cr.Spec.ForProvider))
+	input, err := iam.GenerateCreateRoleInput(meta.GetExternalName(cr), &cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+	if err := iam.ValidateAssumeRolePolicyDocument(aws.ToString(input.AssumeRolePolicyDocument)); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errInvalidPolicy)
+	}
+	_, err = e.client.CreateRole(ctx, input)
 	return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
 }
 
@@ -243,16 +263,127 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		}
 	}
 
-	if patch.AssumeRolePolicyDocument != "" {
+	if patch.AssumeRolePolicyDocument != "" || cr.Spec.ForProvider.AssumeRolePolicy != nil {
+		doc, err := iam.GetAssumeRolePolicyDocument(cr.Spec.ForProvider)
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+		}
+		if err := iam.ValidateAssumeRolePolicyDocument(doc); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errInvalidPolicy)
+		}
 		_, err = e.client.UpdateAssumeRolePolicy(ctx, &awsiam.UpdateAssumeRolePolicyInput{
-			PolicyDocument: &cr.Spec.ForProvider.AssumeRolePolicyDocument,
+			PolicyDocument: aws.String(doc),
 			RoleName:       aws.String(meta.GetExternalName(cr)),
 		})
 		if err != nil {
 			return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
 		}
 	}
-	return managed.ExternalUpdate{}, nil
+
+	if err := e.updateInlinePolicies(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	return managed.ExternalUpdate{}, e.updateManagedPolicies(ctx, cr)
+}
+
+// arePoliciesUpToDate checks whether the inline policies and the attached
+// managed policies of the role match the ones in the spec, if managed at all.
+func (e *external) arePoliciesUpToDate(ctx context.Context, cr *v1beta1.Role) (bool, error) {
+	roleName := meta.GetExternalName(cr)
+	if aws.ToBool(cr.Spec.ForProvider.ManageInlinePolicies) {
+		observed, err := iam.GetRoleInlinePolicies(ctx, e.client, roleName)
+		if err != nil {
+			return false, err
+		}
+		put, remove, err := iam.DiffRoleInlinePolicies(cr.Spec.ForProvider.InlinePolicies, observed)
+		if err != nil || len(put) != 0 || len(remove) != 0 {
+			return false, err
+		}
+	}
+	if aws.ToBool(cr.Spec.ForProvider.ManageManagedPolicies) {
+		observed, err := iam.GetRoleManagedPolicyARNs(ctx, e.client, roleName)
+		if err != nil {
+			return false, err
+		}
+		attach, detach := iam.DiffRoleManagedPolicies(cr.Spec.ForProvider.ManagedPolicyARNs, observed)
+		if len(attach) != 0 || len(detach) != 0 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// updateInlinePolicies deletes the undesired inline policies of the role
+// before it puts the missing and changed ones, so that the size quota for
+// inline policies is not exceeded in between.
+func (e *external) updateInlinePolicies(ctx context.Context, cr *v1beta1.Role) error {
+	if !aws.ToBool(cr.Spec.ForProvider.ManageInlinePolicies) {
+		return nil
+	}
+	roleName := meta.GetExternalName(cr)
+	observed, err := iam.GetRoleInlinePolicies(ctx, e.client, roleName)
+	if err != nil {
+		return err
+	}
+	put, remove, err := iam.DiffRoleInlinePolicies(cr.Spec.ForProvider.InlinePolicies, observed)
+	if err != nil {
+		return err
+	}
+	for name, doc := range put {
+		if err := iam.ValidateInlinePolicyDocument(doc); err != nil {
+			return errors.Wrapf(err, errFmtInvalidInline, name)
+		}
+	}
+	for _, name := range remove {
+		if _, err := e.client.DeleteRolePolicy(ctx, &awsiam.DeleteRolePolicyInput{
+			RoleName:   aws.String(roleName),
+			PolicyName: aws.String(name),
+		}); err != nil && !iam.IsErrorNotFound(err) {
+			return awsclient.Wrap(err, errDeleteRolePolicy)
+		}
+	}
+	for name, doc := range put {
+		if _, err := e.client.PutRolePolicy(ctx, &awsiam.PutRolePolicyInput{
+			RoleName:       aws.String(roleName),
+			PolicyName:     aws.String(name),
+			PolicyDocument: aws.String(doc),
+		}); err != nil {
+			return awsclient.Wrap(err, errPutRolePolicy)
+		}
+	}
+	return nil
+}
+
+// updateManagedPolicies detaches the undesired managed policies from the role
+// before it attaches the missing ones, so that the quota for attached
+// policies is not exceeded in between.
+func (e *external) updateManagedPolicies(ctx context.Context, cr *v1beta1.Role) error {
+	if !aws.ToBool(cr.Spec.ForProvider.ManageManagedPolicies) {
+		return nil
+	}
+	roleName := meta.GetExternalName(cr)
+	observed, err := iam.GetRoleManagedPolicyARNs(ctx, e.client, roleName)
+	if err != nil {
+		return err
+	}
+	attach, detach := iam.DiffRoleManagedPolicies(cr.Spec.ForProvider.ManagedPolicyARNs, observed)
+	for _, arn := range detach {
+		if _, err := e.client.DetachRolePolicy(ctx, &awsiam.DetachRolePolicyInput{
+			RoleName:  aws.String(roleName),
+			PolicyArn: aws.String(arn),
+		}); err != nil && !iam.IsErrorNotFound(err) {
+			return awsclient.Wrap(err, errDetachPolicy)
+		}
+	}
+	for _, arn := range attach {
+		if _, err := e.client.AttachRolePolicy(ctx, &awsiam.AttachRolePolicyInput{
+			RoleName:  aws.String(roleName),
+			PolicyArn: aws.String(arn),
+		}); err != nil {
+			return awsclient.Wrap(err, errAttachPolicy)
+		}
+	}
+	return nil
 }
 
 func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
@@ -263,6 +394,12 @@ func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
 
 	cr.Status.SetConditions(xpv1.Deleting())
 
+	// A role can only be deleted once it has no policies, so the policies
+	// managed through this resource are removed first.
+	if err := e.deletePolicies(ctx, cr); err != nil {
+		return resource.Ignore(iam.IsErrorNotFound, err)
+	}
+
 	_, err := e.client.DeleteRole(ctx, &awsiam.DeleteRoleInput{
 		RoleName: aws.String(meta.GetExternalName(cr)),
 	})
@@ -270,6 +407,41 @@ func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
 	return awsclient.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errDelete)
 }
 
+// deletePolicies deletes all inline policies and detaches all managed
+// policies of the role if they are managed by the spec.
+func (e *external) deletePolicies(ctx context.Context, cr *v1beta1.Role) error {
+	roleName := meta.GetExternalName(cr)
+	if aws.ToBool(cr.Spec.ForProvider.ManageInlinePolicies) {
+		observed, err := iam.GetRoleInlinePolicies(ctx, e.client, roleName)
+		if err != nil {
+			return err
+		}
+		for name := range observed {
+			if _, err := e.client.DeleteRolePolicy(ctx, &awsiam.DeleteRolePolicyInput{
+				RoleName:   aws.String(roleName),
+				PolicyName: aws.String(name),
+			}); err != nil && !iam.IsErrorNotFound(err) {
+				return awsclient.Wrap(err, errDeleteRolePolicy)
+			}
+		}
+	}
+	if aws.ToBool(cr.Spec.ForProvider.ManageManagedPolicies) {
+		observed, err := iam.GetRoleManagedPolicyARNs(ctx, e.client, roleName)
+		if err != nil {
+			return err
+		}
+		for _, arn := range observed {
+			if _, err := e.client.DetachRolePolicy(ctx, &awsiam.DetachRolePolicyInput{
+				RoleName:  aws.String(roleName),
+				PolicyArn: aws.String(arn),
+			}); err != nil && !iam.IsErrorNotFound(err) {
+				return awsclient.Wrap(err, errDetachPolicy)
+			}
+		}
+	}
+	return nil
+}
+
 type tagger struct {
 	kube client.Client
 }
@@ -18,6 +18,7 @@ package role
 
 import (
 	"context"
+	"net/url"
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -58,9 +59,49 @@ var (
 		]
 	   }`
 
+	inlinePolicy = `{
+		"Version": "2012-10-17",
+		"Statement": [
+		  {
+			"Effect": "Allow",
+			"Action": "s3:GetObject",
+			"Resource": "arn:aws:s3:::some-bucket/*"
+		  }
+		]
+	   }`
+	policyARN      = "arn:aws:iam::aws:policy/ReadOnlyAccess"
+	otherPolicyARN = "arn:aws:iam::aws:policy/AdministratorAccess"
+
 	errBoom = errors.New("boom")
 )
 
+func mockInlinePolicies(policies map[string]string) (func(ctx context.Context, input *awsiam.ListRolePoliciesInput, opts []func(*awsiam.Options)) (*awsiam.ListRolePoliciesOutput, error), func(ctx context.Context, input *awsiam.GetRolePolicyInput, opts []func(*awsiam.Options)) (*awsiam.GetRolePolicyOutput, error)) {
+	list := func(ctx context.Context, input *awsiam.ListRolePoliciesInput, opts []func(*awsiam.Options)) (*awsiam.ListRolePoliciesOutput, error) {
+		out := &awsiam.ListRolePoliciesOutput{}
+		for name := range policies {
+			out.PolicyNames = append(out.PolicyNames, name)
+		}
+		return out, nil
+	}
+	get := func(ctx context.Context, input *awsiam.GetRolePolicyInput, opts []func(*awsiam.Options)) (*awsiam.GetRolePolicyOutput, error) {
+		return &awsiam.GetRolePolicyOutput{
+			PolicyName:     input.PolicyName,
+			PolicyDocument: aws.String(url.QueryEscape(policies[aws.ToString(input.PolicyName)])),
+		}, nil
+	}
+	return list, get
+}
+
+func mockAttachedPolicies(arns ...string) func(ctx context.Context, input *awsiam.ListAttachedRolePoliciesInput, opts []func(*awsiam.Options)) (*awsiam.ListAttachedRolePoliciesOutput, error) {
+	return func(ctx context.Context, input *awsiam.ListAttachedRolePoliciesInput, opts []func(*awsiam.Options)) (*awsiam.ListAttachedRolePoliciesOutput, error) {
+		out := &awsiam.ListAttachedRolePoliciesOutput{}
+		for i := range arns {
+			out.AttachedPolicies = append(out.AttachedPolicies, awsiamtypes.AttachedPolicy{PolicyArn: &arns[i]})
+		}
+		return out, nil
+	}
+}
+
 type args struct {
 	iam iam.RoleClient
 	cr  resource.Managed
@@ -80,12 +121,8 @@ func withArn(s string) roleModifier {
 	return func(r *v1beta1.Role) { r.Status.AtProvider.ARN = s }
 }
 
-func withPolicy() roleModifier {
+func withTrustPolicy(p string) roleModifier {
 	return func(r *v1beta1.Role) {
-		p, err := awsclient.CompactAndEscapeJSON(policy)
-		if err != nil {
-			return
-		}
 		r.Spec.ForProvider.AssumeRolePolicyDocument = p
 	}
 }
@@ -108,6 +145,20 @@ func withTags(tagMaps ...map[string]string) roleModifier {
 	}
 }
 
+func withInlinePolicies(p map[string]string) roleModifier {
+	return func(r *v1beta1.Role) {
+		r.Spec.ForProvider.ManageInlinePolicies = aws.Bool(true)
+		r.Spec.ForProvider.InlinePolicies = p
+	}
+}
+
+func withManagedPolicyARNs(arns ...string) roleModifier {
+	return func(r *v1beta1.Role) {
+		r.Spec.ForProvider.ManageManagedPolicies = aws.Bool(true)
+		r.Spec.ForProvider.ManagedPolicyARNs = arns
+	}
+}
+
 func withGroupVersionKind() roleModifier {
 	return func(iamRole *v1beta1.Role) {
 		iamRole.TypeMeta.SetGroupVersionKind(v1beta1.RoleGroupVersionKind)
@@ -162,6 +213,107 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"PoliciesUpToDate": {
+			args: args{
+				iam: func() *fake.MockRoleClient {
+					list, get := mockInlinePolicies(map[string]string{"read": inlinePolicy})
+					return &fake.MockRoleClient{
+						MockGetRole: func(ctx context.Context, input *awsiam.GetRoleInput, opts []func(*awsiam.Options)) (*awsiam.GetRoleOutput, error) {
+							return &awsiam.GetRoleOutput{
+								Role: &awsiamtypes.Role{
+									Arn: awsclient.String(arn),
+								},
+							}, nil
+						},
+						MockListRolePolicies:         list,
+						MockGetRolePolicy:            get,
+						MockListAttachedRolePolicies: mockAttachedPolicies(policyARN),
+					}
+				}(),
+				cr: role(withRoleName(&roleName),
+					withInlinePolicies(map[string]string{"read": inlinePolicy}),
+					withManagedPolicyARNs(policyARN)),
+			},
+			want: want{
+				cr: role(
+					withRoleName(&roleName),
+					withInlinePolicies(map[string]string{"read": inlinePolicy}),
+					withManagedPolicyARNs(policyARN),
+					withArn(arn),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: map[string][]byte{
+						"arn": []byte(arn),
+					},
+				},
+			},
+		},
+		"InlinePoliciesNotUpToDate": {
+			args: args{
+				iam: func() *fake.MockRoleClient {
+					list, get := mockInlinePolicies(map[string]string{"old": inlinePolicy})
+					return &fake.MockRoleClient{
+						MockGetRole: func(ctx context.Context, input *awsiam.GetRoleInput, opts []func(*awsiam.Options)) (*awsiam.GetRoleOutput, error) {
+							return &awsiam.GetRoleOutput{
+								Role: &awsiamtypes.Role{
+									Arn: awsclient.String(arn),
+								},
+							}, nil
+						},
+						MockListRolePolicies: list,
+						MockGetRolePolicy:    get,
+					}
+				}(),
+				cr: role(withRoleName(&roleName),
+					withInlinePolicies(map[string]string{"read": inlinePolicy})),
+			},
+			want: want{
+				cr: role(
+					withRoleName(&roleName),
+					withInlinePolicies(map[string]string{"read": inlinePolicy}),
+					withArn(arn),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+					ConnectionDetails: map[string][]byte{
+						"arn": []byte(arn),
+					},
+				},
+			},
+		},
+		"ManagedPoliciesNotUpToDate": {
+			args: args{
+				iam: &fake.MockRoleClient{
+					MockGetRole: func(ctx context.Context, input *awsiam.GetRoleInput, opts []func(*awsiam.Options)) (*awsiam.GetRoleOutput, error) {
+						return &awsiam.GetRoleOutput{
+							Role: &awsiamtypes.Role{
+								Arn: awsclient.String(arn),
+							},
+						}, nil
+					},
+					MockListAttachedRolePolicies: mockAttachedPolicies(policyARN, otherPolicyARN),
+				},
+				cr: role(withRoleName(&roleName),
+					withManagedPolicyARNs(policyARN)),
+			},
+			want: want{
+				cr: role(
+					withRoleName(&roleName),
+					withManagedPolicyARNs(policyARN),
+					withArn(arn),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+					ConnectionDetails: map[string][]byte{
+						"arn": []byte(arn),
+					},
+				},
+			},
+		},
 		"InValidInput": {
 			args: args{
 				cr: unexpectedItem,
@@ -237,11 +389,12 @@ func TestCreate(t *testing.T) {
 						return &awsiam.CreateRoleOutput{}, nil
 					},
 				},
-				cr: role(withRoleName(&roleName)),
+				cr: role(withRoleName(&roleName), withTrustPolicy(policy)),
 			},
 			want: want{
 				cr: role(
 					withRoleName(&roleName),
+					withTrustPolicy(policy),
 					withConditions(xpv1.Creating())),
 			},
 		},
@@ -261,13 +414,22 @@ func TestCreate(t *testing.T) {
 						return nil, errBoom
 					},
 				},
-				cr: role(),
+				cr: role(withTrustPolicy(policy)),
 			},
 			want: want{
-				cr:  role(withConditions(xpv1.Creating())),
+				cr:  role(withTrustPolicy(policy), withConditions(xpv1.Creating())),
 				err: awsclient.Wrap(errBoom, errCreate),
 			},
 		},
+		"InvalidTrustPolicy": {
+			args: args{
+				cr: role(withTrustPolicy(inlinePolicy)),
+			},
+			want: want{
+				cr:  role(withTrustPolicy(inlinePolicy), withConditions(xpv1.Creating())),
+				err: errors.Wrap(errors.New("statement 0: one of Principal and NotPrincipal must be specified in resource-based policies"), errInvalidPolicy),
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -361,13 +523,144 @@ func TestUpdate(t *testing.T) {
 						return nil, errBoom
 					},
 				},
-				cr: role(withPolicy()),
+				cr: role(withTrustPolicy(policy)),
 			},
 			want: want{
-				cr:  role(withPolicy()),
+				cr:  role(withTrustPolicy(policy)),
 				err: awsclient.Wrap(errBoom, errUpdate),
 			},
 		},
+		"UpdatePolicies": {
+			args: args{
+				iam: func() *fake.MockRoleClient {
+					list, get := mockInlinePolicies(map[string]string{"old": inlinePolicy})
+					return &fake.MockRoleClient{
+						MockGetRole: func(ctx context.Context, input *awsiam.GetRoleInput, opts []func(*awsiam.Options)) (*awsiam.GetRoleOutput, error) {
+							return &awsiam.GetRoleOutput{
+								Role: &awsiamtypes.Role{},
+							}, nil
+						},
+						MockListRolePolicies: list,
+						MockGetRolePolicy:    get,
+						MockDeleteRolePolicy: func(ctx context.Context, input *awsiam.DeleteRolePolicyInput, opts []func(*awsiam.Options)) (*awsiam.DeleteRolePolicyOutput, error) {
+							if aws.ToString(input.PolicyName) != "old" {
+								return nil, errBoom
+							}
+							return &awsiam.DeleteRolePolicyOutput{}, nil
+						},
+						MockPutRolePolicy: func(ctx context.Context, input *awsiam.PutRolePolicyInput, opts []func(*awsiam.Options)) (*awsiam.PutRolePolicyOutput, error) {
+							if aws.ToString(input.PolicyName) != "read" {
+								return nil, errBoom
+							}
+							return &awsiam.PutRolePolicyOutput{}, nil
+						},
+						MockListAttachedRolePolicies: mockAttachedPolicies(otherPolicyARN),
+						MockDetachRolePolicy: func(ctx context.Context, input *awsiam.DetachRolePolicyInput, opts []func(*awsiam.Options)) (*awsiam.DetachRolePolicyOutput, error) {
+							if aws.ToString(input.PolicyArn) != otherPolicyARN {
+								return nil, errBoom
+							}
+							return &awsiam.DetachRolePolicyOutput{}, nil
+						},
+						MockAttachRolePolicy: func(ctx context.Context, input *awsiam.AttachRolePolicyInput, opts []func(*awsiam.Options)) (*awsiam.AttachRolePolicyOutput, error) {
+							if aws.ToString(input.PolicyArn) != policyARN {
+								return nil, errBoom
+							}
+							return &awsiam.AttachRolePolicyOutput{}, nil
+						},
+					}
+				}(),
+				cr: role(withRoleName(&roleName),
+					withInlinePolicies(map[string]string{"read": inlinePolicy}),
+					withManagedPolicyARNs(policyARN)),
+			},
+			want: want{
+				cr: role(withRoleName(&roleName),
+					withInlinePolicies(map[string]string{"read": inlinePolicy}),
+					withManagedPolicyARNs(policyARN)),
+			},
+		},
+		"RemoveAllPolicies": {
+			args: args{
+				iam: func() *fake.MockRoleClient {
+					list, get := mockInlinePolicies(map[string]string{"old": inlinePolicy})
+					return &fake.MockRoleClient{
+						MockGetRole: func(ctx context.Context, input *awsiam.GetRoleInput, opts []func(*awsiam.Options)) (*awsiam.GetRoleOutput, error) {
+							return &awsiam.GetRoleOutput{
+								Role: &awsiamtypes.Role{},
+							}, nil
+						},
+						MockListRolePolicies: list,
+						MockGetRolePolicy:    get,
+						MockDeleteRolePolicy: func(ctx context.Context, input *awsiam.DeleteRolePolicyInput, opts []func(*awsiam.Options)) (*awsiam.DeleteRolePolicyOutput, error) {
+							if aws.ToString(input.PolicyName) != "old" {
+								return nil, errBoom
+							}
+							return &awsiam.DeleteRolePolicyOutput{}, nil
+						},
+						MockListAttachedRolePolicies: mockAttachedPolicies(otherPolicyARN),
+						MockDetachRolePolicy: func(ctx context.Context, input *awsiam.DetachRolePolicyInput, opts []func(*awsiam.Options)) (*awsiam.DetachRolePolicyOutput, error) {
+							if aws.ToString(input.PolicyArn) != otherPolicyARN {
+								return nil, errBoom
+							}
+							return &awsiam.DetachRolePolicyOutput{}, nil
+						},
+					}
+				}(),
+				cr: role(withRoleName(&roleName),
+					withInlinePolicies(map[string]string{}),
+					withManagedPolicyARNs()),
+			},
+			want: want{
+				cr: role(withRoleName(&roleName),
+					withInlinePolicies(map[string]string{}),
+					withManagedPolicyARNs()),
+			},
+		},
+		"PoliciesNotManaged": {
+			args: args{
+				iam: &fake.MockRoleClient{
+					MockGetRole: func(ctx context.Context, input *awsiam.GetRoleInput, opts []func(*awsiam.Options)) (*awsiam.GetRoleOutput, error) {
+						return &awsiam.GetRoleOutput{
+							Role: &awsiamtypes.Role{},
+						}, nil
+					},
+				},
+				cr: role(withRoleName(&roleName), func(r *v1beta1.Role) {
+					r.Spec.ForProvider.InlinePolicies = map[string]string{"read": inlinePolicy}
+					r.Spec.ForProvider.ManagedPolicyARNs = []string{policyARN}
+				}),
+			},
+			want: want{
+				cr: role(withRoleName(&roleName), func(r *v1beta1.Role) {
+					r.Spec.ForProvider.InlinePolicies = map[string]string{"read": inlinePolicy}
+					r.Spec.ForProvider.ManagedPolicyARNs = []string{policyARN}
+				}),
+			},
+		},
+		"ClientPutRolePolicyError": {
+			args: args{
+				iam: func() *fake.MockRoleClient {
+					list, get := mockInlinePolicies(nil)
+					return &fake.MockRoleClient{
+						MockGetRole: func(ctx context.Context, input *awsiam.GetRoleInput, opts []func(*awsiam.Options)) (*awsiam.GetRoleOutput, error) {
+							return &awsiam.GetRoleOutput{
+								Role: &awsiamtypes.Role{},
+							}, nil
+						},
+						MockListRolePolicies: list,
+						MockGetRolePolicy:    get,
+						MockPutRolePolicy: func(ctx context.Context, input *awsiam.PutRolePolicyInput, opts []func(*awsiam.Options)) (*awsiam.PutRolePolicyOutput, error) {
+							return nil, errBoom
+						},
+					}
+				}(),
+				cr: role(withInlinePolicies(map[string]string{"read": inlinePolicy})),
+			},
+			want: want{
+				cr:  role(withInlinePolicies(map[string]string{"read": inlinePolicy})),
+				err: awsclient.Wrap(errBoom, errPutRolePolicy),
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -413,6 +706,51 @@ func TestDelete(t *testing.T) {
 					withConditions(xpv1.Deleting())),
 			},
 		},
+		"DeletePolicies": {
+			args: args{
+				iam: func() *fake.MockRoleClient {
+					list, get := mockInlinePolicies(map[string]string{"read": inlinePolicy})
+					return &fake.MockRoleClient{
+						MockListRolePolicies: list,
+						MockGetRolePolicy:    get,
+						MockDeleteRolePolicy: func(ctx context.Context, input *awsiam.DeleteRolePolicyInput, opts []func(*awsiam.Options)) (*awsiam.DeleteRolePolicyOutput, error) {
+							return &awsiam.DeleteRolePolicyOutput{}, nil
+						},
+						MockListAttachedRolePolicies: mockAttachedPolicies(policyARN),
+						MockDetachRolePolicy: func(ctx context.Context, input *awsiam.DetachRolePolicyInput, opts []func(*awsiam.Options)) (*awsiam.DetachRolePolicyOutput, error) {
+							return &awsiam.DetachRolePolicyOutput{}, nil
+						},
+						MockDeleteRole: func(ctx context.Context, input *awsiam.DeleteRoleInput, opts []func(*awsiam.Options)) (*awsiam.DeleteRoleOutput, error) {
+							return &awsiam.DeleteRoleOutput{}, nil
+						},
+					}
+				}(),
+				cr: role(withRoleName(&roleName),
+					withInlinePolicies(map[string]string{"read": inlinePolicy}),
+					withManagedPolicyARNs(policyARN)),
+			},
+			want: want{
+				cr: role(withRoleName(&roleName),
+					withInlinePolicies(map[string]string{"read": inlinePolicy}),
+					withManagedPolicyARNs(policyARN),
+					withConditions(xpv1.Deleting())),
+			},
+		},
+		"ClientDetachPolicyError": {
+			args: args{
+				iam: &fake.MockRoleClient{
+					MockListAttachedRolePolicies: mockAttachedPolicies(policyARN),
+					MockDetachRolePolicy: func(ctx context.Context, input *awsiam.DetachRolePolicyInput, opts []func(*awsiam.Options)) (*awsiam.DetachRolePolicyOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: role(withManagedPolicyARNs(policyARN)),
+			},
+			want: want{
+				cr:  role(withManagedPolicyARNs(policyARN), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDetachPolicy),
+			},
+		},
 		"InValidInput": {
 			args: args{
 				cr: unexpectedItem,
@@ -0,0 +1,486 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicespecificcredential
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	awsiamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/iam"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/iam/fake"
+)
+
+var (
+	// an arbitrary managed resource
+	unexpectedItem  resource.Managed
+	userName        = "some arbitrary name"
+	activeStatus    = awsiamtypes.StatusTypeActive
+	inactiveStatus  = awsiamtypes.StatusTypeInactive
+	credentialID    = "ACCA12345ABCDEXAMPLE"
+	serviceUserName = "user-at-123456789012"
+	servicePassword = "servicePassword"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	iam iam.ServiceSpecificCredentialClient
+	cr  resource.Managed
+}
+
+type credentialModifier func(*v1beta1.ServiceSpecificCredential)
+
+func withConditions(c ...xpv1.Condition) credentialModifier {
+	return func(r *v1beta1.ServiceSpecificCredential) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withUsername(username string) credentialModifier {
+	return func(r *v1beta1.ServiceSpecificCredential) {
+		r.Spec.ForProvider.Username = username
+	}
+}
+
+func withStatus(status string) credentialModifier {
+	return func(r *v1beta1.ServiceSpecificCredential) {
+		r.Spec.ForProvider.Status = status
+	}
+}
+
+func withExternalName(id string) credentialModifier {
+	return func(r *v1beta1.ServiceSpecificCredential) {
+		meta.SetExternalName(r, id)
+	}
+}
+
+func withObservation() credentialModifier {
+	return func(r *v1beta1.ServiceSpecificCredential) {
+		r.Status.AtProvider.ServiceSpecificCredentialID = credentialID
+	}
+}
+
+func credential(m ...credentialModifier) *v1beta1.ServiceSpecificCredential {
+	cr := &v1beta1.ServiceSpecificCredential{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInputExists": {
+			args: args{
+				iam: &fake.MockServiceSpecificCredentialClient{
+					MockListServiceSpecificCredentials: func(ctx context.Context, input *awsiam.ListServiceSpecificCredentialsInput, opts []func(*awsiam.Options)) (*awsiam.ListServiceSpecificCredentialsOutput, error) {
+						return &awsiam.ListServiceSpecificCredentialsOutput{
+							ServiceSpecificCredentials: []awsiamtypes.ServiceSpecificCredentialMetadata{{
+								ServiceSpecificCredentialId: aws.String(credentialID),
+								Status:                      activeStatus,
+								UserName:                    aws.String(userName),
+							}},
+						}, nil
+					},
+				},
+				cr: credential(withUsername(userName), withExternalName(credentialID), withStatus(string(activeStatus))),
+			},
+			want: want{
+				cr: credential(withUsername(userName),
+					withExternalName(credentialID),
+					withStatus(string(activeStatus)),
+					withObservation(),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"ValidInputLateInitialize": {
+			args: args{
+				iam: &fake.MockServiceSpecificCredentialClient{
+					MockListServiceSpecificCredentials: func(ctx context.Context, input *awsiam.ListServiceSpecificCredentialsInput, opts []func(*awsiam.Options)) (*awsiam.ListServiceSpecificCredentialsOutput, error) {
+						return &awsiam.ListServiceSpecificCredentialsOutput{
+							ServiceSpecificCredentials: []awsiamtypes.ServiceSpecificCredentialMetadata{{
+								ServiceSpecificCredentialId: aws.String(credentialID),
+								Status:                      activeStatus,
+								UserName:                    aws.String(userName),
+							}},
+						}, nil
+					},
+				},
+				cr: credential(withUsername(userName), withExternalName(credentialID)),
+			},
+			want: want{
+				cr: credential(withUsername(userName),
+					withExternalName(credentialID),
+					withStatus(string(activeStatus)),
+					withObservation(),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"ValidInputNeedsUpdate": {
+			args: args{
+				iam: &fake.MockServiceSpecificCredentialClient{
+					MockListServiceSpecificCredentials: func(ctx context.Context, input *awsiam.ListServiceSpecificCredentialsInput, opts []func(*awsiam.Options)) (*awsiam.ListServiceSpecificCredentialsOutput, error) {
+						return &awsiam.ListServiceSpecificCredentialsOutput{
+							ServiceSpecificCredentials: []awsiamtypes.ServiceSpecificCredentialMetadata{{
+								ServiceSpecificCredentialId: aws.String(credentialID),
+								Status:                      inactiveStatus,
+								UserName:                    aws.String(userName),
+							}},
+						}, nil
+					},
+				},
+				cr: credential(withUsername(userName), withExternalName(credentialID), withStatus(string(activeStatus))),
+			},
+			want: want{
+				cr: credential(withUsername(userName),
+					withExternalName(credentialID),
+					withStatus(string(activeStatus)),
+					withObservation(),
+					withConditions(xpv1.Unavailable())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"ValidInputNotExists": {
+			args: args{
+				iam: &fake.MockServiceSpecificCredentialClient{
+					MockListServiceSpecificCredentials: func(ctx context.Context, input *awsiam.ListServiceSpecificCredentialsInput, opts []func(*awsiam.Options)) (*awsiam.ListServiceSpecificCredentialsOutput, error) {
+						return &awsiam.ListServiceSpecificCredentialsOutput{
+							ServiceSpecificCredentials: []awsiamtypes.ServiceSpecificCredentialMetadata{{
+								ServiceSpecificCredentialId: aws.String("other"),
+								Status:                      activeStatus,
+								UserName:                    aws.String(userName),
+							}},
+						}, nil
+					},
+				},
+				cr: credential(withUsername(userName), withExternalName(credentialID), withStatus(string(activeStatus))),
+			},
+			want: want{
+				cr: credential(withUsername(userName),
+					withExternalName(credentialID),
+					withStatus(string(activeStatus))),
+				result: managed.ExternalObservation{
+					ResourceExists: false,
+				},
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ListError": {
+			args: args{
+				iam: &fake.MockServiceSpecificCredentialClient{
+					MockListServiceSpecificCredentials: func(ctx context.Context, input *awsiam.ListServiceSpecificCredentialsInput, opts []func(*awsiam.Options)) (*awsiam.ListServiceSpecificCredentialsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: credential(withExternalName(credentialID)),
+			},
+			want: want{
+				cr:  credential(withExternalName(credentialID)),
+				err: awsclient.Wrap(errBoom, errList),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				iam: &fake.MockServiceSpecificCredentialClient{
+					MockCreateServiceSpecificCredential: func(ctx context.Context, input *awsiam.CreateServiceSpecificCredentialInput, opts []func(*awsiam.Options)) (*awsiam.CreateServiceSpecificCredentialOutput, error) {
+						return &awsiam.CreateServiceSpecificCredentialOutput{
+							ServiceSpecificCredential: &awsiamtypes.ServiceSpecificCredential{
+								ServiceSpecificCredentialId: aws.String(credentialID),
+								ServiceUserName:             aws.String(serviceUserName),
+								ServicePassword:             aws.String(servicePassword),
+								Status:                      activeStatus,
+								UserName:                    aws.String(userName),
+							},
+						}, nil
+					},
+				},
+				cr: credential(withUsername(userName)),
+			},
+			want: want{
+				cr: credential(
+					withUsername(userName),
+					withExternalName(credentialID)),
+				result: managed.ExternalCreation{
+					ConnectionDetails: map[string][]byte{
+						xpv1.ResourceCredentialsSecretUserKey:     []byte(serviceUserName),
+						xpv1.ResourceCredentialsSecretPasswordKey: []byte(servicePassword),
+					},
+				},
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockServiceSpecificCredentialClient{
+					MockCreateServiceSpecificCredential: func(ctx context.Context, input *awsiam.CreateServiceSpecificCredentialInput, opts []func(*awsiam.Options)) (*awsiam.CreateServiceSpecificCredentialOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: credential(),
+			},
+			want: want{
+				cr:  credential(),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		err    error
+		update managed.ExternalUpdate
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				iam: &fake.MockServiceSpecificCredentialClient{
+					MockUpdateServiceSpecificCredential: func(ctx context.Context, input *awsiam.UpdateServiceSpecificCredentialInput, opts []func(*awsiam.Options)) (*awsiam.UpdateServiceSpecificCredentialOutput, error) {
+						if input.Status != inactiveStatus {
+							return nil, errors.Errorf("unexpected status %q", input.Status)
+						}
+						return &awsiam.UpdateServiceSpecificCredentialOutput{}, nil
+					},
+				},
+				cr: credential(withExternalName(credentialID), withUsername(userName), withStatus(string(inactiveStatus))),
+			},
+			want: want{
+				cr: credential(withExternalName(credentialID), withUsername(userName), withStatus(string(inactiveStatus))),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockServiceSpecificCredentialClient{
+					MockUpdateServiceSpecificCredential: func(ctx context.Context, input *awsiam.UpdateServiceSpecificCredentialInput, opts []func(*awsiam.Options)) (*awsiam.UpdateServiceSpecificCredentialOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: credential(withStatus(string(activeStatus))),
+			},
+			want: want{
+				cr:  credential(withStatus(string(activeStatus))),
+				err: awsclient.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam}
+			update, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.update, update, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				iam: &fake.MockServiceSpecificCredentialClient{
+					MockDeleteServiceSpecificCredential: func(ctx context.Context, input *awsiam.DeleteServiceSpecificCredentialInput, opts []func(*awsiam.Options)) (*awsiam.DeleteServiceSpecificCredentialOutput, error) {
+						return &awsiam.DeleteServiceSpecificCredentialOutput{}, nil
+					},
+				},
+				cr: credential(withExternalName(credentialID), withUsername(userName)),
+			},
+			want: want{
+				cr: credential(withExternalName(credentialID), withUsername(userName),
+					withConditions(xpv1.Deleting())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockServiceSpecificCredentialClient{
+					MockDeleteServiceSpecificCredential: func(ctx context.Context, input *awsiam.DeleteServiceSpecificCredentialInput, opts []func(*awsiam.Options)) (*awsiam.DeleteServiceSpecificCredentialOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: credential(),
+			},
+			want: want{
+				cr:  credential(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+		"ResourceDoesNotExist": {
+			args: args{
+				iam: &fake.MockServiceSpecificCredentialClient{
+					MockDeleteServiceSpecificCredential: func(ctx context.Context, input *awsiam.DeleteServiceSpecificCredentialInput, opts []func(*awsiam.Options)) (*awsiam.DeleteServiceSpecificCredentialOutput, error) {
+						return nil, &awsiamtypes.NoSuchEntityException{}
+					},
+				},
+				cr: credential(),
+			},
+			want: want{
+				cr: credential(withConditions(xpv1.Deleting())),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -25,11 +25,15 @@ import (
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/iam/grouppolicyattachment"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/iam/groupusermembership"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/iam/instanceprofile"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/iam/loginprofile"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/iam/openidconnectprovider"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/iam/policy"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/iam/role"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/iam/rolepolicyattachment"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/iam/servicelinkedrole"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/iam/servicespecificcredential"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/iam/signingcertificate"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/iam/sshpublickey"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/iam/user"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/iam/userpolicyattachment"
 	"github.com/crossplane-contrib/provider-aws/pkg/utils/setup"
@@ -44,11 +48,15 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		grouppolicyattachment.SetupGroupPolicyAttachment,
 		groupusermembership.SetupGroupUserMembership,
 		instanceprofile.SetupInstanceProfile,
+		loginprofile.SetupLoginProfile,
 		openidconnectprovider.SetupOpenIDConnectProvider,
 		policy.SetupPolicy,
 		role.SetupRole,
 		rolepolicyattachment.SetupRolePolicyAttachment,
 		servicelinkedrole.SetupServiceLinkedRole,
+		servicespecificcredential.SetupServiceSpecificCredential,
+		signingcertificate.SetupSigningCertificate,
+		sshpublickey.SetupSSHPublicKey,
 		user.SetupUser,
 		userpolicyattachment.SetupUserPolicyAttachment,
 	)
@@ -0,0 +1,477 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signingcertificate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	awsiamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/iam"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/iam/fake"
+)
+
+var (
+	// an arbitrary managed resource
+	unexpectedItem resource.Managed
+	userName       = "some arbitrary name"
+	activeStatus   = awsiamtypes.StatusTypeActive
+	inactiveStatus = awsiamtypes.StatusTypeInactive
+	certificateID  = "TA7SMP42TDN5Z26OBPJE7EXAMPLE"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	iam iam.SigningCertificateClient
+	cr  resource.Managed
+}
+
+type certificateModifier func(*v1beta1.SigningCertificate)
+
+func withConditions(c ...xpv1.Condition) certificateModifier {
+	return func(r *v1beta1.SigningCertificate) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withUsername(username string) certificateModifier {
+	return func(r *v1beta1.SigningCertificate) {
+		r.Spec.ForProvider.Username = username
+	}
+}
+
+func withStatus(status string) certificateModifier {
+	return func(r *v1beta1.SigningCertificate) {
+		r.Spec.ForProvider.Status = status
+	}
+}
+
+func withExternalName(id string) certificateModifier {
+	return func(r *v1beta1.SigningCertificate) {
+		meta.SetExternalName(r, id)
+	}
+}
+
+func withObservation() certificateModifier {
+	return func(r *v1beta1.SigningCertificate) {
+		r.Status.AtProvider.CertificateID = certificateID
+	}
+}
+
+func certificate(m ...certificateModifier) *v1beta1.SigningCertificate {
+	cr := &v1beta1.SigningCertificate{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInputExists": {
+			args: args{
+				iam: &fake.MockSigningCertificateClient{
+					MockListSigningCertificates: func(ctx context.Context, input *awsiam.ListSigningCertificatesInput, opts []func(*awsiam.Options)) (*awsiam.ListSigningCertificatesOutput, error) {
+						return &awsiam.ListSigningCertificatesOutput{
+							Certificates: []awsiamtypes.SigningCertificate{{
+								CertificateId: aws.String(certificateID),
+								Status:        activeStatus,
+								UserName:      aws.String(userName),
+							}},
+						}, nil
+					},
+				},
+				cr: certificate(withUsername(userName), withExternalName(certificateID), withStatus(string(activeStatus))),
+			},
+			want: want{
+				cr: certificate(withUsername(userName),
+					withExternalName(certificateID),
+					withStatus(string(activeStatus)),
+					withObservation(),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"ValidInputLateInitialize": {
+			args: args{
+				iam: &fake.MockSigningCertificateClient{
+					MockListSigningCertificates: func(ctx context.Context, input *awsiam.ListSigningCertificatesInput, opts []func(*awsiam.Options)) (*awsiam.ListSigningCertificatesOutput, error) {
+						return &awsiam.ListSigningCertificatesOutput{
+							Certificates: []awsiamtypes.SigningCertificate{{
+								CertificateId: aws.String(certificateID),
+								Status:        activeStatus,
+								UserName:      aws.String(userName),
+							}},
+						}, nil
+					},
+				},
+				cr: certificate(withUsername(userName), withExternalName(certificateID)),
+			},
+			want: want{
+				cr: certificate(withUsername(userName),
+					withExternalName(certificateID),
+					withStatus(string(activeStatus)),
+					withObservation(),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"ValidInputNeedsUpdate": {
+			args: args{
+				iam: &fake.MockSigningCertificateClient{
+					MockListSigningCertificates: func(ctx context.Context, input *awsiam.ListSigningCertificatesInput, opts []func(*awsiam.Options)) (*awsiam.ListSigningCertificatesOutput, error) {
+						return &awsiam.ListSigningCertificatesOutput{
+							Certificates: []awsiamtypes.SigningCertificate{{
+								CertificateId: aws.String(certificateID),
+								Status:        inactiveStatus,
+								UserName:      aws.String(userName),
+							}},
+						}, nil
+					},
+				},
+				cr: certificate(withUsername(userName), withExternalName(certificateID), withStatus(string(activeStatus))),
+			},
+			want: want{
+				cr: certificate(withUsername(userName),
+					withExternalName(certificateID),
+					withStatus(string(activeStatus)),
+					withObservation(),
+					withConditions(xpv1.Unavailable())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"ValidInputNotExists": {
+			args: args{
+				iam: &fake.MockSigningCertificateClient{
+					MockListSigningCertificates: func(ctx context.Context, input *awsiam.ListSigningCertificatesInput, opts []func(*awsiam.Options)) (*awsiam.ListSigningCertificatesOutput, error) {
+						return &awsiam.ListSigningCertificatesOutput{
+							Certificates: []awsiamtypes.SigningCertificate{{
+								CertificateId: aws.String("other"),
+								Status:        activeStatus,
+								UserName:      aws.String(userName),
+							}},
+						}, nil
+					},
+				},
+				cr: certificate(withUsername(userName), withExternalName(certificateID), withStatus(string(activeStatus))),
+			},
+			want: want{
+				cr: certificate(withUsername(userName),
+					withExternalName(certificateID),
+					withStatus(string(activeStatus))),
+				result: managed.ExternalObservation{
+					ResourceExists: false,
+				},
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ListError": {
+			args: args{
+				iam: &fake.MockSigningCertificateClient{
+					MockListSigningCertificates: func(ctx context.Context, input *awsiam.ListSigningCertificatesInput, opts []func(*awsiam.Options)) (*awsiam.ListSigningCertificatesOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: certificate(withExternalName(certificateID)),
+			},
+			want: want{
+				cr:  certificate(withExternalName(certificateID)),
+				err: awsclient.Wrap(errBoom, errList),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				iam: &fake.MockSigningCertificateClient{
+					MockUploadSigningCertificate: func(ctx context.Context, input *awsiam.UploadSigningCertificateInput, opts []func(*awsiam.Options)) (*awsiam.UploadSigningCertificateOutput, error) {
+						return &awsiam.UploadSigningCertificateOutput{
+							Certificate: &awsiamtypes.SigningCertificate{
+								CertificateId: aws.String(certificateID),
+								Status:        activeStatus,
+								UserName:      aws.String(userName),
+							},
+						}, nil
+					},
+				},
+				cr: certificate(withUsername(userName)),
+			},
+			want: want{
+				cr: certificate(
+					withUsername(userName),
+					withExternalName(certificateID)),
+				result: managed.ExternalCreation{},
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockSigningCertificateClient{
+					MockUploadSigningCertificate: func(ctx context.Context, input *awsiam.UploadSigningCertificateInput, opts []func(*awsiam.Options)) (*awsiam.UploadSigningCertificateOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: certificate(),
+			},
+			want: want{
+				cr:  certificate(),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		err    error
+		update managed.ExternalUpdate
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				iam: &fake.MockSigningCertificateClient{
+					MockUpdateSigningCertificate: func(ctx context.Context, input *awsiam.UpdateSigningCertificateInput, opts []func(*awsiam.Options)) (*awsiam.UpdateSigningCertificateOutput, error) {
+						if input.Status != inactiveStatus {
+							return nil, errors.Errorf("unexpected status %q", input.Status)
+						}
+						return &awsiam.UpdateSigningCertificateOutput{}, nil
+					},
+				},
+				cr: certificate(withExternalName(certificateID), withUsername(userName), withStatus(string(inactiveStatus))),
+			},
+			want: want{
+				cr: certificate(withExternalName(certificateID), withUsername(userName), withStatus(string(inactiveStatus))),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockSigningCertificateClient{
+					MockUpdateSigningCertificate: func(ctx context.Context, input *awsiam.UpdateSigningCertificateInput, opts []func(*awsiam.Options)) (*awsiam.UpdateSigningCertificateOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: certificate(withStatus(string(activeStatus))),
+			},
+			want: want{
+				cr:  certificate(withStatus(string(activeStatus))),
+				err: awsclient.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam}
+			update, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.update, update, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				iam: &fake.MockSigningCertificateClient{
+					MockDeleteSigningCertificate: func(ctx context.Context, input *awsiam.DeleteSigningCertificateInput, opts []func(*awsiam.Options)) (*awsiam.DeleteSigningCertificateOutput, error) {
+						return &awsiam.DeleteSigningCertificateOutput{}, nil
+					},
+				},
+				cr: certificate(withExternalName(certificateID), withUsername(userName)),
+			},
+			want: want{
+				cr: certificate(withExternalName(certificateID), withUsername(userName),
+					withConditions(xpv1.Deleting())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockSigningCertificateClient{
+					MockDeleteSigningCertificate: func(ctx context.Context, input *awsiam.DeleteSigningCertificateInput, opts []func(*awsiam.Options)) (*awsiam.DeleteSigningCertificateOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: certificate(),
+			},
+			want: want{
+				cr:  certificate(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+		"ResourceDoesNotExist": {
+			args: args{
+				iam: &fake.MockSigningCertificateClient{
+					MockDeleteSigningCertificate: func(ctx context.Context, input *awsiam.DeleteSigningCertificateInput, opts []func(*awsiam.Options)) (*awsiam.DeleteSigningCertificateOutput, error) {
+						return nil, &awsiamtypes.NoSuchEntityException{}
+					},
+				},
+				cr: certificate(),
+			},
+			want: want{
+				cr: certificate(withConditions(xpv1.Deleting())),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
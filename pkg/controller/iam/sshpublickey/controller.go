@@ -0,0 +1,213 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sshpublickey
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	awsiamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/iam"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not an SSHPublicKey resource"
+	errList             = "failed to list SSHPublicKeys"
+	errGet              = "failed to get the SSHPublicKey resource"
+	errCreate           = "failed to create the SSHPublicKey resource"
+	errDelete           = "failed to delete the SSHPublicKey resource"
+	errUpdate           = "failed to update the SSHPublicKey resource"
+)
+
+// SetupSSHPublicKey adds a controller that reconciles SSHPublicKeys.
+func SetupSSHPublicKey(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1beta1.SSHPublicKeyGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: iam.NewSSHPublicKeyClient}),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1beta1.SSHPublicKeyGroupVersionKind),
+		reconcilerOpts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1beta1.SSHPublicKey{}).
+		Complete(r)
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) iam.SSHPublicKeyClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, awsclient.GlobalRegion)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	client iam.SSHPublicKeyClient
+	kube   client.Client
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1beta1.SSHPublicKey)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	keys, err := e.client.ListSSHPublicKeys(ctx, &awsiam.ListSSHPublicKeysInput{UserName: aws.String(cr.Spec.ForProvider.Username)})
+	if err != nil || len(keys.SSHPublicKeys) == 0 {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errList)
+	}
+	found := false
+	for _, key := range keys.SSHPublicKeys {
+		if aws.ToString(key.SSHPublicKeyId) == meta.GetExternalName(cr) {
+			found = true
+		}
+	}
+	if !found {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// The fingerprint is only returned when getting a single key.
+	resp, err := e.client.GetSSHPublicKey(ctx, &awsiam.GetSSHPublicKeyInput{
+		Encoding:       awsiamtypes.EncodingTypeSsh,
+		SSHPublicKeyId: aws.String(meta.GetExternalName(cr)),
+		UserName:       aws.String(cr.Spec.ForProvider.Username),
+	})
+	if err != nil || resp.SSHPublicKey == nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errGet)
+	}
+	key := resp.SSHPublicKey
+
+	cr.Status.AtProvider = v1beta1.SSHPublicKeyObservation{
+		SSHPublicKeyID: aws.ToString(key.SSHPublicKeyId),
+		Fingerprint:    aws.ToString(key.Fingerprint),
+		UploadDate:     awsclient.TimeToMetaTime(key.UploadDate),
+	}
+	switch key.Status {
+	case awsiamtypes.StatusTypeActive:
+		cr.SetConditions(xpv1.Available())
+	case awsiamtypes.StatusTypeInactive:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+	current := cr.Spec.ForProvider.Status
+	cr.Spec.ForProvider.Status = awsclient.LateInitializeString(cr.Spec.ForProvider.Status, aws.String(string(key.Status)))
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        string(key.Status) == cr.Spec.ForProvider.Status,
+		ResourceLateInitialized: current != cr.Spec.ForProvider.Status,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1beta1.SSHPublicKey)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	resp, err := e.client.UploadSSHPublicKey(ctx, &awsiam.UploadSSHPublicKeyInput{
+		SSHPublicKeyBody: aws.String(cr.Spec.ForProvider.SSHPublicKeyBody),
+		UserName:         aws.String(cr.Spec.ForProvider.Username),
+	})
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+
+	// The ID of the key is the SSH user name for CodeCommit.
+	var conn managed.ConnectionDetails
+	if resp != nil && resp.SSHPublicKey != nil {
+		meta.SetExternalName(cr, aws.ToString(resp.SSHPublicKey.SSHPublicKeyId))
+		conn = managed.ConnectionDetails{
+			xpv1.ResourceCredentialsSecretUserKey: []byte(aws.ToString(resp.SSHPublicKey.SSHPublicKeyId)),
+		}
+	}
+	return managed.ExternalCreation{ConnectionDetails: conn}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1beta1.SSHPublicKey)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	_, err := e.client.UpdateSSHPublicKey(ctx, &awsiam.UpdateSSHPublicKeyInput{
+		SSHPublicKeyId: aws.String(meta.GetExternalName(cr)),
+		Status:         awsiamtypes.StatusType(cr.Spec.ForProvider.Status),
+		UserName:       aws.String(cr.Spec.ForProvider.Username),
+	})
+
+	return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1beta1.SSHPublicKey)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.DeleteSSHPublicKey(ctx, &awsiam.DeleteSSHPublicKeyInput{
+		SSHPublicKeyId: aws.String(meta.GetExternalName(cr)),
+		UserName:       aws.String(cr.Spec.ForProvider.Username),
+	})
+
+	return awsclient.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errDelete)
+}
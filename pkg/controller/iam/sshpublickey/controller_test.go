@@ -0,0 +1,536 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sshpublickey
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	awsiamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/iam"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/iam/fake"
+)
+
+var (
+	// an arbitrary managed resource
+	unexpectedItem resource.Managed
+	userName       = "some arbitrary name"
+	activeStatus   = awsiamtypes.StatusTypeActive
+	inactiveStatus = awsiamtypes.StatusTypeInactive
+	keyID          = "APKAEIBAERJR2EXAMPLE"
+	fingerprint    = "1e:9a:1a:b7:2a:c3:f6:b5:96:8a:04:7f:7f:c1:6d:0a"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	iam iam.SSHPublicKeyClient
+	cr  resource.Managed
+}
+
+type sshPublicKeyModifier func(*v1beta1.SSHPublicKey)
+
+func withConditions(c ...xpv1.Condition) sshPublicKeyModifier {
+	return func(r *v1beta1.SSHPublicKey) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withUsername(username string) sshPublicKeyModifier {
+	return func(r *v1beta1.SSHPublicKey) {
+		r.Spec.ForProvider.Username = username
+	}
+}
+
+func withStatus(status string) sshPublicKeyModifier {
+	return func(r *v1beta1.SSHPublicKey) {
+		r.Spec.ForProvider.Status = status
+	}
+}
+
+func withExternalName(id string) sshPublicKeyModifier {
+	return func(r *v1beta1.SSHPublicKey) {
+		meta.SetExternalName(r, id)
+	}
+}
+
+func withObservation() sshPublicKeyModifier {
+	return func(r *v1beta1.SSHPublicKey) {
+		r.Status.AtProvider.SSHPublicKeyID = keyID
+		r.Status.AtProvider.Fingerprint = fingerprint
+	}
+}
+
+func sshPublicKey(m ...sshPublicKeyModifier) *v1beta1.SSHPublicKey {
+	cr := &v1beta1.SSHPublicKey{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInputExists": {
+			args: args{
+				iam: &fake.MockSSHPublicKeyClient{
+					MockListSSHPublicKeys: func(ctx context.Context, input *awsiam.ListSSHPublicKeysInput, opts []func(*awsiam.Options)) (*awsiam.ListSSHPublicKeysOutput, error) {
+						return &awsiam.ListSSHPublicKeysOutput{
+							SSHPublicKeys: []awsiamtypes.SSHPublicKeyMetadata{{
+								SSHPublicKeyId: aws.String(keyID),
+								Status:         activeStatus,
+								UserName:       aws.String(userName),
+							}},
+						}, nil
+					},
+					MockGetSSHPublicKey: func(ctx context.Context, input *awsiam.GetSSHPublicKeyInput, opts []func(*awsiam.Options)) (*awsiam.GetSSHPublicKeyOutput, error) {
+						return &awsiam.GetSSHPublicKeyOutput{
+							SSHPublicKey: &awsiamtypes.SSHPublicKey{
+								Fingerprint:    aws.String(fingerprint),
+								SSHPublicKeyId: aws.String(keyID),
+								Status:         activeStatus,
+								UserName:       aws.String(userName),
+							},
+						}, nil
+					},
+				},
+				cr: sshPublicKey(withUsername(userName), withExternalName(keyID), withStatus(string(activeStatus))),
+			},
+			want: want{
+				cr: sshPublicKey(withUsername(userName),
+					withExternalName(keyID),
+					withStatus(string(activeStatus)),
+					withObservation(),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"ValidInputLateInitialize": {
+			args: args{
+				iam: &fake.MockSSHPublicKeyClient{
+					MockListSSHPublicKeys: func(ctx context.Context, input *awsiam.ListSSHPublicKeysInput, opts []func(*awsiam.Options)) (*awsiam.ListSSHPublicKeysOutput, error) {
+						return &awsiam.ListSSHPublicKeysOutput{
+							SSHPublicKeys: []awsiamtypes.SSHPublicKeyMetadata{{
+								SSHPublicKeyId: aws.String(keyID),
+								Status:         activeStatus,
+								UserName:       aws.String(userName),
+							}},
+						}, nil
+					},
+					MockGetSSHPublicKey: func(ctx context.Context, input *awsiam.GetSSHPublicKeyInput, opts []func(*awsiam.Options)) (*awsiam.GetSSHPublicKeyOutput, error) {
+						return &awsiam.GetSSHPublicKeyOutput{
+							SSHPublicKey: &awsiamtypes.SSHPublicKey{
+								Fingerprint:    aws.String(fingerprint),
+								SSHPublicKeyId: aws.String(keyID),
+								Status:         activeStatus,
+								UserName:       aws.String(userName),
+							},
+						}, nil
+					},
+				},
+				cr: sshPublicKey(withUsername(userName), withExternalName(keyID)),
+			},
+			want: want{
+				cr: sshPublicKey(withUsername(userName),
+					withExternalName(keyID),
+					withStatus(string(activeStatus)),
+					withObservation(),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"ValidInputNeedsUpdate": {
+			args: args{
+				iam: &fake.MockSSHPublicKeyClient{
+					MockListSSHPublicKeys: func(ctx context.Context, input *awsiam.ListSSHPublicKeysInput, opts []func(*awsiam.Options)) (*awsiam.ListSSHPublicKeysOutput, error) {
+						return &awsiam.ListSSHPublicKeysOutput{
+							SSHPublicKeys: []awsiamtypes.SSHPublicKeyMetadata{{
+								SSHPublicKeyId: aws.String(keyID),
+								Status:         inactiveStatus,
+								UserName:       aws.String(userName),
+							}},
+						}, nil
+					},
+					MockGetSSHPublicKey: func(ctx context.Context, input *awsiam.GetSSHPublicKeyInput, opts []func(*awsiam.Options)) (*awsiam.GetSSHPublicKeyOutput, error) {
+						return &awsiam.GetSSHPublicKeyOutput{
+							SSHPublicKey: &awsiamtypes.SSHPublicKey{
+								Fingerprint:    aws.String(fingerprint),
+								SSHPublicKeyId: aws.String(keyID),
+								Status:         inactiveStatus,
+								UserName:       aws.String(userName),
+							},
+						}, nil
+					},
+				},
+				cr: sshPublicKey(withUsername(userName), withExternalName(keyID), withStatus(string(activeStatus))),
+			},
+			want: want{
+				cr: sshPublicKey(withUsername(userName),
+					withExternalName(keyID),
+					withStatus(string(activeStatus)),
+					withObservation(),
+					withConditions(xpv1.Unavailable())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"ValidInputNotExists": {
+			args: args{
+				iam: &fake.MockSSHPublicKeyClient{
+					MockListSSHPublicKeys: func(ctx context.Context, input *awsiam.ListSSHPublicKeysInput, opts []func(*awsiam.Options)) (*awsiam.ListSSHPublicKeysOutput, error) {
+						return &awsiam.ListSSHPublicKeysOutput{
+							SSHPublicKeys: []awsiamtypes.SSHPublicKeyMetadata{{
+								SSHPublicKeyId: aws.String("other"),
+								Status:         activeStatus,
+								UserName:       aws.String(userName),
+							}},
+						}, nil
+					},
+				},
+				cr: sshPublicKey(withUsername(userName), withExternalName(keyID), withStatus(string(activeStatus))),
+			},
+			want: want{
+				cr: sshPublicKey(withUsername(userName),
+					withExternalName(keyID),
+					withStatus(string(activeStatus))),
+				result: managed.ExternalObservation{
+					ResourceExists: false,
+				},
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ListError": {
+			args: args{
+				iam: &fake.MockSSHPublicKeyClient{
+					MockListSSHPublicKeys: func(ctx context.Context, input *awsiam.ListSSHPublicKeysInput, opts []func(*awsiam.Options)) (*awsiam.ListSSHPublicKeysOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: sshPublicKey(withExternalName(keyID)),
+			},
+			want: want{
+				cr:  sshPublicKey(withExternalName(keyID)),
+				err: awsclient.Wrap(errBoom, errList),
+			},
+		},
+		"GetError": {
+			args: args{
+				iam: &fake.MockSSHPublicKeyClient{
+					MockListSSHPublicKeys: func(ctx context.Context, input *awsiam.ListSSHPublicKeysInput, opts []func(*awsiam.Options)) (*awsiam.ListSSHPublicKeysOutput, error) {
+						return &awsiam.ListSSHPublicKeysOutput{
+							SSHPublicKeys: []awsiamtypes.SSHPublicKeyMetadata{{
+								SSHPublicKeyId: aws.String(keyID),
+								Status:         activeStatus,
+								UserName:       aws.String(userName),
+							}},
+						}, nil
+					},
+					MockGetSSHPublicKey: func(ctx context.Context, input *awsiam.GetSSHPublicKeyInput, opts []func(*awsiam.Options)) (*awsiam.GetSSHPublicKeyOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: sshPublicKey(withUsername(userName), withExternalName(keyID)),
+			},
+			want: want{
+				cr:  sshPublicKey(withUsername(userName), withExternalName(keyID)),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				iam: &fake.MockSSHPublicKeyClient{
+					MockUploadSSHPublicKey: func(ctx context.Context, input *awsiam.UploadSSHPublicKeyInput, opts []func(*awsiam.Options)) (*awsiam.UploadSSHPublicKeyOutput, error) {
+						return &awsiam.UploadSSHPublicKeyOutput{
+							SSHPublicKey: &awsiamtypes.SSHPublicKey{
+								SSHPublicKeyId: aws.String(keyID),
+								Status:         activeStatus,
+								UserName:       aws.String(userName),
+							},
+						}, nil
+					},
+				},
+				cr: sshPublicKey(withUsername(userName)),
+			},
+			want: want{
+				cr: sshPublicKey(
+					withUsername(userName),
+					withExternalName(keyID)),
+				result: managed.ExternalCreation{
+					ConnectionDetails: map[string][]byte{
+						xpv1.ResourceCredentialsSecretUserKey: []byte(keyID),
+					},
+				},
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockSSHPublicKeyClient{
+					MockUploadSSHPublicKey: func(ctx context.Context, input *awsiam.UploadSSHPublicKeyInput, opts []func(*awsiam.Options)) (*awsiam.UploadSSHPublicKeyOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: sshPublicKey(),
+			},
+			want: want{
+				cr:  sshPublicKey(),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		err    error
+		update managed.ExternalUpdate
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				iam: &fake.MockSSHPublicKeyClient{
+					MockUpdateSSHPublicKey: func(ctx context.Context, input *awsiam.UpdateSSHPublicKeyInput, opts []func(*awsiam.Options)) (*awsiam.UpdateSSHPublicKeyOutput, error) {
+						if input.Status != inactiveStatus {
+							return nil, errors.Errorf("unexpected status %q", input.Status)
+						}
+						return &awsiam.UpdateSSHPublicKeyOutput{}, nil
+					},
+				},
+				cr: sshPublicKey(withExternalName(keyID), withUsername(userName), withStatus(string(inactiveStatus))),
+			},
+			want: want{
+				cr: sshPublicKey(withExternalName(keyID), withUsername(userName), withStatus(string(inactiveStatus))),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockSSHPublicKeyClient{
+					MockUpdateSSHPublicKey: func(ctx context.Context, input *awsiam.UpdateSSHPublicKeyInput, opts []func(*awsiam.Options)) (*awsiam.UpdateSSHPublicKeyOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: sshPublicKey(withStatus(string(activeStatus))),
+			},
+			want: want{
+				cr:  sshPublicKey(withStatus(string(activeStatus))),
+				err: awsclient.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam}
+			update, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.update, update, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				iam: &fake.MockSSHPublicKeyClient{
+					MockDeleteSSHPublicKey: func(ctx context.Context, input *awsiam.DeleteSSHPublicKeyInput, opts []func(*awsiam.Options)) (*awsiam.DeleteSSHPublicKeyOutput, error) {
+						return &awsiam.DeleteSSHPublicKeyOutput{}, nil
+					},
+				},
+				cr: sshPublicKey(withExternalName(keyID), withUsername(userName)),
+			},
+			want: want{
+				cr: sshPublicKey(withExternalName(keyID), withUsername(userName),
+					withConditions(xpv1.Deleting())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockSSHPublicKeyClient{
+					MockDeleteSSHPublicKey: func(ctx context.Context, input *awsiam.DeleteSSHPublicKeyInput, opts []func(*awsiam.Options)) (*awsiam.DeleteSSHPublicKeyOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: sshPublicKey(),
+			},
+			want: want{
+				cr:  sshPublicKey(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+		"ResourceDoesNotExist": {
+			args: args{
+				iam: &fake.MockSSHPublicKeyClient{
+					MockDeleteSSHPublicKey: func(ctx context.Context, input *awsiam.DeleteSSHPublicKeyInput, opts []func(*awsiam.Options)) (*awsiam.DeleteSSHPublicKeyOutput, error) {
+						return nil, &awsiamtypes.NoSuchEntityException{}
+					},
+				},
+				cr: sshPublicKey(),
+			},
+			want: want{
+				cr: sshPublicKey(withConditions(xpv1.Deleting())),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
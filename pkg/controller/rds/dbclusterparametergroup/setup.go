@@ -61,6 +61,9 @@ func SetupDBClusterParameterGroup(mgr ctrl.Manager, o controller.Options) error
 
 	reconcilerOpts := []managed.ReconcilerOption{
 		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+		managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), svcutils.NewDefaultTagger(mgr.GetClient(), func(mg resource.Managed) *[]*svcapitypes.Tag {
+			return &mg.(*svcapitypes.DBClusterParameterGroup).Spec.ForProvider.Tags
+		})),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
@@ -81,6 +81,9 @@ func SetupDBInstance(mgr ctrl.Manager, o controller.Options) error {
 
 	reconcilerOpts := []managed.ReconcilerOption{
 		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+		managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), utils.NewDefaultTagger(mgr.GetClient(), func(mg resource.Managed) *[]*svcapitypes.Tag {
+			return &mg.(*svcapitypes.DBInstance).Spec.ForProvider.Tags
+		})),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
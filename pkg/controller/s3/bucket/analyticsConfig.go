@@ -0,0 +1,257 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+
+	"github.com/crossplane-contrib/provider-aws/apis/s3/v1beta1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3"
+)
+
+const (
+	analyticsListFailed   = "cannot list Bucket analytics configurations"
+	analyticsPutFailed    = "cannot put Bucket analytics configuration"
+	analyticsDeleteFailed = "cannot delete Bucket analytics configuration"
+)
+
+// AnalyticsConfigurationClient is the client for API methods and reconciling the
+// AnalyticsConfigurations
+type AnalyticsConfigurationClient struct {
+	client s3.BucketClient
+}
+
+// NewAnalyticsConfigurationClient creates the client for Analytics Configurations
+func NewAnalyticsConfigurationClient(client s3.BucketClient) *AnalyticsConfigurationClient {
+	return &AnalyticsConfigurationClient{client: client}
+}
+
+// Observe checks if the resource exists and if it matches the local configuration
+func (in *AnalyticsConfigurationClient) Observe(ctx context.Context, bucket *v1beta1.Bucket) (ResourceStatus, error) {
+	external, err := in.list(ctx, bucket)
+	if err != nil {
+		return NeedsUpdate, awsclient.Wrap(err, analyticsListFailed)
+	}
+	desired := GenerateAnalyticsConfigurations(bucket.Spec.ForProvider.AnalyticsConfigurations)
+	put, remove := diffAnalyticsConfigurations(desired, external)
+	switch {
+	case len(desired) == 0 && len(external) != 0:
+		return NeedsDeletion, nil
+	case len(put) == 0 && len(remove) == 0:
+		return Updated, nil
+	default:
+		return NeedsUpdate, nil
+	}
+}
+
+// CreateOrUpdate puts the configurations that are missing or differ and
+// deletes the configurations whose ID is not in the local configuration.
+func (in *AnalyticsConfigurationClient) CreateOrUpdate(ctx context.Context, bucket *v1beta1.Bucket) error {
+	external, err := in.list(ctx, bucket)
+	if err != nil {
+		return awsclient.Wrap(err, analyticsListFailed)
+	}
+	desired := GenerateAnalyticsConfigurations(bucket.Spec.ForProvider.AnalyticsConfigurations)
+	put, remove := diffAnalyticsConfigurations(desired, external)
+	for i := range put {
+		_, err := in.client.PutBucketAnalyticsConfiguration(ctx, &awss3.PutBucketAnalyticsConfigurationInput{
+			Bucket:                 awsclient.String(meta.GetExternalName(bucket)),
+			Id:                     put[i].Id,
+			AnalyticsConfiguration: &put[i],
+		})
+		if err != nil {
+			return awsclient.Wrap(err, analyticsPutFailed)
+		}
+	}
+	return in.delete(ctx, bucket, remove)
+}
+
+// Delete removes all analytics configurations of the bucket.
+func (in *AnalyticsConfigurationClient) Delete(ctx context.Context, bucket *v1beta1.Bucket) error {
+	external, err := in.list(ctx, bucket)
+	if err != nil {
+		return awsclient.Wrap(err, analyticsListFailed)
+	}
+	ids := make([]string, len(external))
+	for i := range external {
+		ids[i] = awsclient.StringValue(external[i].Id)
+	}
+	return in.delete(ctx, bucket, ids)
+}
+
+// LateInitialize fills the empty fields in the bucket spec with the
+// configurations found on the bucket.
+func (in *AnalyticsConfigurationClient) LateInitialize(ctx context.Context, bucket *v1beta1.Bucket) error {
+	if bucket.Spec.ForProvider.AnalyticsConfigurations != nil {
+		return nil
+	}
+	external, err := in.list(ctx, bucket)
+	if err != nil {
+		return awsclient.Wrap(err, analyticsListFailed)
+	}
+	if len(external) != 0 {
+		bucket.Spec.ForProvider.AnalyticsConfigurations = GenerateLocalAnalyticsConfigurations(external)
+	}
+	return nil
+}
+
+// SubresourceExists checks if the subresource this controller manages currently exists
+func (in *AnalyticsConfigurationClient) SubresourceExists(bucket *v1beta1.Bucket) bool {
+	return len(bucket.Spec.ForProvider.AnalyticsConfigurations) != 0
+}
+
+func (in *AnalyticsConfigurationClient) list(ctx context.Context, bucket *v1beta1.Bucket) ([]types.AnalyticsConfiguration, error) {
+	var result []types.AnalyticsConfiguration
+	input := &awss3.ListBucketAnalyticsConfigurationsInput{Bucket: awsclient.String(meta.GetExternalName(bucket))}
+	for {
+		response, err := in.client.ListBucketAnalyticsConfigurations(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, response.AnalyticsConfigurationList...)
+		if !response.IsTruncated {
+			return result, nil
+		}
+		input.ContinuationToken = response.NextContinuationToken
+	}
+}
+
+func (in *AnalyticsConfigurationClient) delete(ctx context.Context, bucket *v1beta1.Bucket, ids []string) error {
+	for _, id := range ids {
+		_, err := in.client.DeleteBucketAnalyticsConfiguration(ctx, &awss3.DeleteBucketAnalyticsConfigurationInput{
+			Bucket: awsclient.String(meta.GetExternalName(bucket)),
+			Id:     awsclient.String(id),
+		})
+		if err != nil {
+			return awsclient.Wrap(err, analyticsDeleteFailed)
+		}
+	}
+	return nil
+}
+
+func diffAnalyticsConfigurations(desired, external []types.AnalyticsConfiguration) ([]types.AnalyticsConfiguration, []string) {
+	for i := range external {
+		if f, ok := external[i].Filter.(*types.AnalyticsFilterMemberAnd); ok {
+			f.Value.Tags = s3.SortS3TagSet(f.Value.Tags)
+		}
+	}
+	return diffConfigurationsByID(desired, external, func(c types.AnalyticsConfiguration) string {
+		return awsclient.StringValue(c.Id)
+	})
+}
+
+// generateAnalyticsFilter creates the AnalyticsFilter union for the AWS SDK
+func generateAnalyticsFilter(in *v1beta1.AnalyticsFilter) types.AnalyticsFilter {
+	switch {
+	case in == nil:
+		return nil
+	case in.And != nil:
+		return &types.AnalyticsFilterMemberAnd{Value: types.AnalyticsAndOperator{
+			Prefix: in.And.Prefix,
+			Tags:   s3.SortS3TagSet(s3.CopyTags(in.And.Tags)),
+		}}
+	case in.Tag != nil:
+		return &types.AnalyticsFilterMemberTag{Value: types.Tag{Key: awsclient.String(in.Tag.Key), Value: awsclient.String(in.Tag.Value)}}
+	case in.Prefix != nil:
+		return &types.AnalyticsFilterMemberPrefix{Value: *in.Prefix}
+	default:
+		return nil
+	}
+}
+
+// generateLocalAnalyticsFilter creates the local AnalyticsFilter from the AWS SDK
+// union
+func generateLocalAnalyticsFilter(in types.AnalyticsFilter) *v1beta1.AnalyticsFilter {
+	switch v := in.(type) {
+	case *types.AnalyticsFilterMemberAnd:
+		return &v1beta1.AnalyticsFilter{And: &v1beta1.AnalyticsAndOperator{
+			Prefix: v.Value.Prefix,
+			Tags:   s3.CopyAWSTags(v.Value.Tags),
+		}}
+	case *types.AnalyticsFilterMemberTag:
+		return &v1beta1.AnalyticsFilter{Tag: &v1beta1.Tag{Key: awsclient.StringValue(v.Value.Key), Value: awsclient.StringValue(v.Value.Value)}}
+	case *types.AnalyticsFilterMemberPrefix:
+		return &v1beta1.AnalyticsFilter{Prefix: awsclient.String(v.Value)}
+	default:
+		return nil
+	}
+}
+
+// GenerateAnalyticsConfigurations creates the list of AnalyticsConfigurations
+// for the AWS SDK
+func GenerateAnalyticsConfigurations(in []v1beta1.AnalyticsConfiguration) []types.AnalyticsConfiguration {
+	if in == nil {
+		return nil
+	}
+	result := make([]types.AnalyticsConfiguration, len(in))
+	for i, local := range in {
+		result[i] = types.AnalyticsConfiguration{
+			Id:                   awsclient.String(local.ID),
+			Filter:               generateAnalyticsFilter(local.Filter),
+			StorageClassAnalysis: &types.StorageClassAnalysis{},
+		}
+		if export := local.StorageClassAnalysis.DataExport; export != nil {
+			dst := export.Destination.S3BucketDestination
+			result[i].StorageClassAnalysis.DataExport = &types.StorageClassAnalysisDataExport{
+				Destination: &types.AnalyticsExportDestination{
+					S3BucketDestination: &types.AnalyticsS3BucketDestination{
+						Bucket:          awsclient.String(dst.Bucket),
+						BucketAccountId: dst.BucketAccountID,
+						Format:          types.AnalyticsS3ExportFileFormat(dst.Format),
+						Prefix:          dst.Prefix,
+					},
+				},
+				OutputSchemaVersion: types.StorageClassAnalysisSchemaVersion(export.OutputSchemaVersion),
+			}
+		}
+	}
+	return result
+}
+
+// GenerateLocalAnalyticsConfigurations creates the list of local
+// AnalyticsConfigurations from the AWS SDK ones
+func GenerateLocalAnalyticsConfigurations(in []types.AnalyticsConfiguration) []v1beta1.AnalyticsConfiguration {
+	result := make([]v1beta1.AnalyticsConfiguration, len(in))
+	for i, external := range in {
+		result[i] = v1beta1.AnalyticsConfiguration{
+			ID:     awsclient.StringValue(external.Id),
+			Filter: generateLocalAnalyticsFilter(external.Filter),
+		}
+		if external.StorageClassAnalysis == nil || external.StorageClassAnalysis.DataExport == nil {
+			continue
+		}
+		export := external.StorageClassAnalysis.DataExport
+		result[i].StorageClassAnalysis.DataExport = &v1beta1.StorageClassAnalysisDataExport{
+			OutputSchemaVersion: string(export.OutputSchemaVersion),
+		}
+		if export.Destination != nil && export.Destination.S3BucketDestination != nil {
+			dst := export.Destination.S3BucketDestination
+			result[i].StorageClassAnalysis.DataExport.Destination.S3BucketDestination = v1beta1.AnalyticsS3BucketDestination{
+				Bucket:          awsclient.StringValue(dst.Bucket),
+				BucketAccountID: dst.BucketAccountId,
+				Format:          string(dst.Format),
+				Prefix:          dst.Prefix,
+			}
+		}
+	}
+	return result
+}
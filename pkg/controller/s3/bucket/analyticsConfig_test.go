@@ -0,0 +1,192 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go/document"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane-contrib/provider-aws/apis/s3/v1beta1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3/fake"
+	s3testing "github.com/crossplane-contrib/provider-aws/pkg/controller/s3/testing"
+)
+
+var (
+	_ SubresourceClient = &AnalyticsConfigurationClient{}
+
+	analyticsDestinationARN = "arn:aws:s3:::analytics"
+)
+
+func generateAnalyticsConfig(id string, filter *v1beta1.AnalyticsFilter) v1beta1.AnalyticsConfiguration {
+	return v1beta1.AnalyticsConfiguration{
+		ID:     id,
+		Filter: filter,
+		StorageClassAnalysis: v1beta1.StorageClassAnalysis{
+			DataExport: &v1beta1.StorageClassAnalysisDataExport{
+				Destination: v1beta1.AnalyticsExportDestination{
+					S3BucketDestination: v1beta1.AnalyticsS3BucketDestination{
+						Bucket:          analyticsDestinationARN,
+						BucketAccountID: awsclient.String(accountID),
+						Format:          "CSV",
+					},
+				},
+				OutputSchemaVersion: "V_1",
+			},
+		},
+	}
+}
+
+func generateAWSAnalyticsConfig(id string, filter types.AnalyticsFilter) types.AnalyticsConfiguration {
+	return types.AnalyticsConfiguration{
+		Id:     awsclient.String(id),
+		Filter: filter,
+		StorageClassAnalysis: &types.StorageClassAnalysis{
+			DataExport: &types.StorageClassAnalysisDataExport{
+				Destination: &types.AnalyticsExportDestination{
+					S3BucketDestination: &types.AnalyticsS3BucketDestination{
+						Bucket:          awsclient.String(analyticsDestinationARN),
+						BucketAccountId: awsclient.String(accountID),
+						Format:          types.AnalyticsS3ExportFileFormatCsv,
+					},
+				},
+				OutputSchemaVersion: types.StorageClassAnalysisSchemaVersionV1,
+			},
+		},
+	}
+}
+
+func analyticsList(configs ...types.AnalyticsConfiguration) func(ctx context.Context, input *s3.ListBucketAnalyticsConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketAnalyticsConfigurationsOutput, error) {
+	return func(ctx context.Context, input *s3.ListBucketAnalyticsConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketAnalyticsConfigurationsOutput, error) {
+		return &s3.ListBucketAnalyticsConfigurationsOutput{AnalyticsConfigurationList: configs}, nil
+	}
+}
+
+func TestAnalyticsObserve(t *testing.T) {
+	type args struct {
+		cl *AnalyticsConfigurationClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		status ResourceStatus
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithAnalyticsConfigs(generateAnalyticsConfig("a", nil))),
+				cl: NewAnalyticsConfigurationClient(fake.MockBucketClient{
+					MockListBucketAnalyticsConfigurations: func(ctx context.Context, input *s3.ListBucketAnalyticsConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketAnalyticsConfigurationsOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+				err:    awsclient.Wrap(errBoom, analyticsListFailed),
+			},
+		},
+		"NeedsUpdate": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithAnalyticsConfigs(generateAnalyticsConfig("a", &v1beta1.AnalyticsFilter{Prefix: awsclient.String(prefix)}))),
+				cl: NewAnalyticsConfigurationClient(fake.MockBucketClient{
+					MockListBucketAnalyticsConfigurations: analyticsList(generateAWSAnalyticsConfig("a", nil)),
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"NeedsDelete": {
+			args: args{
+				b: s3testing.Bucket(),
+				cl: NewAnalyticsConfigurationClient(fake.MockBucketClient{
+					MockListBucketAnalyticsConfigurations: analyticsList(generateAWSAnalyticsConfig("a", nil)),
+				}),
+			},
+			want: want{
+				status: NeedsDeletion,
+			},
+		},
+		"NoUpdateExists": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithAnalyticsConfigs(generateAnalyticsConfig("a", &v1beta1.AnalyticsFilter{
+					And: &v1beta1.AnalyticsAndOperator{Prefix: awsclient.String(prefix), Tags: tags},
+				}))),
+				cl: NewAnalyticsConfigurationClient(fake.MockBucketClient{
+					MockListBucketAnalyticsConfigurations: analyticsList(generateAWSAnalyticsConfig("a", &types.AnalyticsFilterMemberAnd{
+						Value: types.AnalyticsAndOperator{Prefix: awsclient.String(prefix), Tags: []types.Tag{awsTag2, awsTag1, awsTag}},
+					})),
+				}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			status, err := tc.args.cl.Observe(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.status, status); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateAnalyticsConfigurations(t *testing.T) {
+	cases := map[string]struct {
+		filter types.AnalyticsFilter
+	}{
+		"NoFilter": {},
+		"Prefix": {
+			filter: &types.AnalyticsFilterMemberPrefix{Value: prefix},
+		},
+		"Tag": {
+			filter: &types.AnalyticsFilterMemberTag{Value: awsTag},
+		},
+		"And": {
+			filter: &types.AnalyticsFilterMemberAnd{Value: types.AnalyticsAndOperator{Prefix: awsclient.String(prefix), Tags: []types.Tag{awsTag2, awsTag, awsTag1}}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			want := []types.AnalyticsConfiguration{generateAWSAnalyticsConfig("a", tc.filter)}
+			got := GenerateAnalyticsConfigurations(GenerateLocalAnalyticsConfigurations(want))
+			if diff := cmp.Diff(want, got, cmpopts.EquateEmpty(), cmpopts.IgnoreTypes(document.NoSerde{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
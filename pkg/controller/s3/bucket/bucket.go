@@ -18,6 +18,7 @@ package bucket
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
@@ -48,9 +49,16 @@ const (
 	errCreate           = "failed to create the Bucket"
 	errCreateOrUpdate   = "cannot create or update"
 	errDelete           = "cannot delete"
+	errEmpty            = "cannot empty the Bucket"
 	errKubeUpdateFailed = "cannot update S3 custom resource"
 )
 
+// maxEmptyBatches is the maximum number of batches of objects that are
+// deleted per reconcile when a bucket is force destroyed.
+const maxEmptyBatches = 10
+
+const msgEmptyingFmt = "Emptying bucket before deletion: deleted %d object versions and delete markers, aborted %d multipart uploads"
+
 // SetupBucket adds a controller that reconciles Buckets.
 func SetupBucket(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName(v1beta1.BucketGroupKind)
@@ -258,6 +266,20 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 	}
 
 	cr.Status.SetConditions(xpv1.Deleting())
+	if aws.ToBool(cr.Spec.ForProvider.ForceDestroy) {
+		res, err := s3.EmptyBucket(ctx, e.s3client, meta.GetExternalName(cr), aws.ToBool(cr.Spec.ForProvider.BypassGovernanceRetention), maxEmptyBatches)
+		if res.DeletedObjects > 0 || res.AbortedUploads > 0 {
+			cr.Status.SetConditions(xpv1.Deleting().WithMessage(fmt.Sprintf(msgEmptyingFmt, res.DeletedObjects, res.AbortedUploads)))
+		}
+		if err != nil {
+			return awsclient.Wrap(resource.Ignore(s3.IsNoSuchBucket, err), errEmpty)
+		}
+		// The bucket is deleted by a later reconcile once all of its objects
+		// are deleted.
+		if !res.Empty {
+			return nil
+		}
+	}
 	_, err := e.s3client.DeleteBucket(ctx, &awss3.DeleteBucketInput{Bucket: aws.String(meta.GetExternalName(cr))})
 	return resource.Ignore(s3.IsNotFound, err)
 }
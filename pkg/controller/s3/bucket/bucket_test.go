@@ -733,6 +733,89 @@ func TestDelete(t *testing.T) {
 				cr: s3Testing.Bucket(s3Testing.WithConditions(xpv1.Deleting())),
 			},
 		},
+		"ForceDestroy": {
+			args: args{
+				s3: &fake.MockBucketClient{
+					MockListMultipartUploads: func(ctx context.Context, input *awss3.ListMultipartUploadsInput, opts []func(*awss3.Options)) (*awss3.ListMultipartUploadsOutput, error) {
+						return &awss3.ListMultipartUploadsOutput{Uploads: []awss3types.MultipartUpload{{Key: aws.String("upload"), UploadId: aws.String("id")}}}, nil
+					},
+					MockAbortMultipartUpload: func(ctx context.Context, input *awss3.AbortMultipartUploadInput, opts []func(*awss3.Options)) (*awss3.AbortMultipartUploadOutput, error) {
+						return &awss3.AbortMultipartUploadOutput{}, nil
+					},
+					MockListObjectVersions: func(ctx context.Context, input *awss3.ListObjectVersionsInput, opts []func(*awss3.Options)) (*awss3.ListObjectVersionsOutput, error) {
+						return &awss3.ListObjectVersionsOutput{
+							Versions:      []awss3types.ObjectVersion{{Key: aws.String("object"), VersionId: aws.String("v1")}},
+							DeleteMarkers: []awss3types.DeleteMarkerEntry{{Key: aws.String("object"), VersionId: aws.String("v2")}},
+						}, nil
+					},
+					MockDeleteObjects: func(ctx context.Context, input *awss3.DeleteObjectsInput, opts []func(*awss3.Options)) (*awss3.DeleteObjectsOutput, error) {
+						if len(input.Delete.Objects) != 2 {
+							return nil, errBoom
+						}
+						return &awss3.DeleteObjectsOutput{}, nil
+					},
+					MockDeleteBucket: func(ctx context.Context, input *awss3.DeleteBucketInput, opts []func(*awss3.Options)) (*awss3.DeleteBucketOutput, error) {
+						return &awss3.DeleteBucketOutput{}, nil
+					},
+				},
+				cr: s3Testing.Bucket(s3Testing.WithForceDestroy(true)),
+			},
+			want: want{
+				cr: s3Testing.Bucket(s3Testing.WithForceDestroy(true),
+					s3Testing.WithConditions(xpv1.Deleting().WithMessage(fmt.Sprintf(msgEmptyingFmt, 2, 1)))),
+			},
+		},
+		"ForceDestroyNotEmptied": {
+			args: args{
+				s3: &fake.MockBucketClient{
+					MockListMultipartUploads: func(ctx context.Context, input *awss3.ListMultipartUploadsInput, opts []func(*awss3.Options)) (*awss3.ListMultipartUploadsOutput, error) {
+						return &awss3.ListMultipartUploadsOutput{}, nil
+					},
+					MockListObjectVersions: func(ctx context.Context, input *awss3.ListObjectVersionsInput, opts []func(*awss3.Options)) (*awss3.ListObjectVersionsOutput, error) {
+						return &awss3.ListObjectVersionsOutput{
+							Versions:    []awss3types.ObjectVersion{{Key: aws.String("object"), VersionId: aws.String("v1")}},
+							IsTruncated: true,
+						}, nil
+					},
+					MockDeleteObjects: func(ctx context.Context, input *awss3.DeleteObjectsInput, opts []func(*awss3.Options)) (*awss3.DeleteObjectsOutput, error) {
+						return &awss3.DeleteObjectsOutput{}, nil
+					},
+				},
+				cr: s3Testing.Bucket(s3Testing.WithForceDestroy(true)),
+			},
+			want: want{
+				cr: s3Testing.Bucket(s3Testing.WithForceDestroy(true),
+					s3Testing.WithConditions(xpv1.Deleting().WithMessage(fmt.Sprintf(msgEmptyingFmt, maxEmptyBatches, 0)))),
+			},
+		},
+		"ForceDestroyObjectLocked": {
+			args: args{
+				s3: &fake.MockBucketClient{
+					MockListMultipartUploads: func(ctx context.Context, input *awss3.ListMultipartUploadsInput, opts []func(*awss3.Options)) (*awss3.ListMultipartUploadsOutput, error) {
+						return &awss3.ListMultipartUploadsOutput{}, nil
+					},
+					MockListObjectVersions: func(ctx context.Context, input *awss3.ListObjectVersionsInput, opts []func(*awss3.Options)) (*awss3.ListObjectVersionsOutput, error) {
+						return &awss3.ListObjectVersionsOutput{
+							Versions: []awss3types.ObjectVersion{{Key: aws.String("object"), VersionId: aws.String("v1")}},
+						}, nil
+					},
+					MockDeleteObjects: func(ctx context.Context, input *awss3.DeleteObjectsInput, opts []func(*awss3.Options)) (*awss3.DeleteObjectsOutput, error) {
+						return &awss3.DeleteObjectsOutput{Errors: []awss3types.Error{{
+							Key:       aws.String("object"),
+							VersionId: aws.String("v1"),
+							Code:      aws.String("AccessDenied"),
+							Message:   aws.String("Access Denied because object protected by object lock."),
+						}}}, nil
+					},
+				},
+				cr: s3Testing.Bucket(s3Testing.WithForceDestroy(true)),
+			},
+			want: want{
+				cr: s3Testing.Bucket(s3Testing.WithForceDestroy(true), s3Testing.WithConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errors.Errorf("cannot delete %d objects, e.g. version %s of %s: %s: %s",
+					1, "v1", "object", "AccessDenied", "Access Denied because object protected by object lock."), errEmpty),
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -0,0 +1,226 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+
+	"github.com/crossplane-contrib/provider-aws/apis/s3/v1beta1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3"
+)
+
+const (
+	intelligentTieringListFailed   = "cannot list Bucket intelligent-tiering configurations"
+	intelligentTieringPutFailed    = "cannot put Bucket intelligent-tiering configuration"
+	intelligentTieringDeleteFailed = "cannot delete Bucket intelligent-tiering configuration"
+)
+
+// IntelligentTieringConfigurationClient is the client for API methods and
+// reconciling the IntelligentTieringConfigurations
+type IntelligentTieringConfigurationClient struct {
+	client s3.BucketClient
+}
+
+// NewIntelligentTieringConfigurationClient creates the client for
+// Intelligent-Tiering Configurations
+func NewIntelligentTieringConfigurationClient(client s3.BucketClient) *IntelligentTieringConfigurationClient {
+	return &IntelligentTieringConfigurationClient{client: client}
+}
+
+// Observe checks if the resource exists and if it matches the local configuration
+func (in *IntelligentTieringConfigurationClient) Observe(ctx context.Context, bucket *v1beta1.Bucket) (ResourceStatus, error) {
+	external, err := in.list(ctx, bucket)
+	if err != nil {
+		return NeedsUpdate, awsclient.Wrap(err, intelligentTieringListFailed)
+	}
+	desired := GenerateIntelligentTieringConfigurations(bucket.Spec.ForProvider.IntelligentTieringConfigurations)
+	put, remove := diffIntelligentTieringConfigurations(desired, external)
+	switch {
+	case len(desired) == 0 && len(external) != 0:
+		return NeedsDeletion, nil
+	case len(put) == 0 && len(remove) == 0:
+		return Updated, nil
+	default:
+		return NeedsUpdate, nil
+	}
+}
+
+// CreateOrUpdate puts the configurations that are missing or differ and
+// deletes the configurations whose ID is not in the local configuration.
+func (in *IntelligentTieringConfigurationClient) CreateOrUpdate(ctx context.Context, bucket *v1beta1.Bucket) error {
+	external, err := in.list(ctx, bucket)
+	if err != nil {
+		return awsclient.Wrap(err, intelligentTieringListFailed)
+	}
+	desired := GenerateIntelligentTieringConfigurations(bucket.Spec.ForProvider.IntelligentTieringConfigurations)
+	put, remove := diffIntelligentTieringConfigurations(desired, external)
+	for i := range put {
+		_, err := in.client.PutBucketIntelligentTieringConfiguration(ctx, &awss3.PutBucketIntelligentTieringConfigurationInput{
+			Bucket:                          awsclient.String(meta.GetExternalName(bucket)),
+			Id:                              put[i].Id,
+			IntelligentTieringConfiguration: &put[i],
+		})
+		if err != nil {
+			return awsclient.Wrap(err, intelligentTieringPutFailed)
+		}
+	}
+	return in.delete(ctx, bucket, remove)
+}
+
+// Delete removes all Intelligent-Tiering configurations of the bucket.
+func (in *IntelligentTieringConfigurationClient) Delete(ctx context.Context, bucket *v1beta1.Bucket) error {
+	external, err := in.list(ctx, bucket)
+	if err != nil {
+		return awsclient.Wrap(err, intelligentTieringListFailed)
+	}
+	ids := make([]string, len(external))
+	for i := range external {
+		ids[i] = awsclient.StringValue(external[i].Id)
+	}
+	return in.delete(ctx, bucket, ids)
+}
+
+// LateInitialize fills the empty fields in the bucket spec with the
+// configurations found on the bucket.
+func (in *IntelligentTieringConfigurationClient) LateInitialize(ctx context.Context, bucket *v1beta1.Bucket) error {
+	if bucket.Spec.ForProvider.IntelligentTieringConfigurations != nil {
+		return nil
+	}
+	external, err := in.list(ctx, bucket)
+	if err != nil {
+		return awsclient.Wrap(err, intelligentTieringListFailed)
+	}
+	if len(external) != 0 {
+		bucket.Spec.ForProvider.IntelligentTieringConfigurations = GenerateLocalIntelligentTieringConfigurations(external)
+	}
+	return nil
+}
+
+// SubresourceExists checks if the subresource this controller manages currently exists
+func (in *IntelligentTieringConfigurationClient) SubresourceExists(bucket *v1beta1.Bucket) bool {
+	return len(bucket.Spec.ForProvider.IntelligentTieringConfigurations) != 0
+}
+
+func (in *IntelligentTieringConfigurationClient) list(ctx context.Context, bucket *v1beta1.Bucket) ([]types.IntelligentTieringConfiguration, error) {
+	var result []types.IntelligentTieringConfiguration
+	input := &awss3.ListBucketIntelligentTieringConfigurationsInput{Bucket: awsclient.String(meta.GetExternalName(bucket))}
+	for {
+		response, err := in.client.ListBucketIntelligentTieringConfigurations(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, response.IntelligentTieringConfigurationList...)
+		if !response.IsTruncated {
+			return result, nil
+		}
+		input.ContinuationToken = response.NextContinuationToken
+	}
+}
+
+func (in *IntelligentTieringConfigurationClient) delete(ctx context.Context, bucket *v1beta1.Bucket, ids []string) error {
+	for _, id := range ids {
+		_, err := in.client.DeleteBucketIntelligentTieringConfiguration(ctx, &awss3.DeleteBucketIntelligentTieringConfigurationInput{
+			Bucket: awsclient.String(meta.GetExternalName(bucket)),
+			Id:     awsclient.String(id),
+		})
+		if err != nil {
+			return awsclient.Wrap(err, intelligentTieringDeleteFailed)
+		}
+	}
+	return nil
+}
+
+func diffIntelligentTieringConfigurations(desired, external []types.IntelligentTieringConfiguration) ([]types.IntelligentTieringConfiguration, []string) {
+	for i := range external {
+		if f := external[i].Filter; f != nil && f.And != nil {
+			f.And.Tags = s3.SortS3TagSet(f.And.Tags)
+		}
+	}
+	return diffConfigurationsByID(desired, external, func(c types.IntelligentTieringConfiguration) string {
+		return awsclient.StringValue(c.Id)
+	})
+}
+
+// GenerateIntelligentTieringConfigurations creates the list of
+// IntelligentTieringConfigurations for the AWS SDK
+func GenerateIntelligentTieringConfigurations(in []v1beta1.IntelligentTieringConfiguration) []types.IntelligentTieringConfiguration {
+	if in == nil {
+		return nil
+	}
+	result := make([]types.IntelligentTieringConfiguration, len(in))
+	for i, local := range in {
+		result[i] = types.IntelligentTieringConfiguration{
+			Id:     awsclient.String(local.ID),
+			Status: types.IntelligentTieringStatus(local.Status),
+		}
+		if local.Filter != nil {
+			result[i].Filter = &types.IntelligentTieringFilter{Prefix: local.Filter.Prefix}
+			if local.Filter.Tag != nil {
+				result[i].Filter.Tag = &types.Tag{Key: awsclient.String(local.Filter.Tag.Key), Value: awsclient.String(local.Filter.Tag.Value)}
+			}
+			if local.Filter.And != nil {
+				result[i].Filter.And = &types.IntelligentTieringAndOperator{
+					Prefix: local.Filter.And.Prefix,
+					Tags:   s3.SortS3TagSet(s3.CopyTags(local.Filter.And.Tags)),
+				}
+			}
+		}
+		for _, t := range local.Tierings {
+			result[i].Tierings = append(result[i].Tierings, types.Tiering{
+				AccessTier: types.IntelligentTieringAccessTier(t.AccessTier),
+				Days:       t.Days,
+			})
+		}
+	}
+	return result
+}
+
+// GenerateLocalIntelligentTieringConfigurations creates the list of local
+// IntelligentTieringConfigurations from the AWS SDK ones
+func GenerateLocalIntelligentTieringConfigurations(in []types.IntelligentTieringConfiguration) []v1beta1.IntelligentTieringConfiguration {
+	result := make([]v1beta1.IntelligentTieringConfiguration, len(in))
+	for i, external := range in {
+		result[i] = v1beta1.IntelligentTieringConfiguration{
+			ID:     awsclient.StringValue(external.Id),
+			Status: string(external.Status),
+		}
+		if external.Filter != nil {
+			result[i].Filter = &v1beta1.IntelligentTieringFilter{Prefix: external.Filter.Prefix}
+			if external.Filter.Tag != nil {
+				result[i].Filter.Tag = &v1beta1.Tag{Key: awsclient.StringValue(external.Filter.Tag.Key), Value: awsclient.StringValue(external.Filter.Tag.Value)}
+			}
+			if external.Filter.And != nil {
+				result[i].Filter.And = &v1beta1.IntelligentTieringAndOperator{
+					Prefix: external.Filter.And.Prefix,
+					Tags:   s3.CopyAWSTags(external.Filter.And.Tags),
+				}
+			}
+		}
+		for _, t := range external.Tierings {
+			result[i].Tierings = append(result[i].Tierings, v1beta1.Tiering{
+				AccessTier: string(t.AccessTier),
+				Days:       t.Days,
+			})
+		}
+	}
+	return result
+}
@@ -0,0 +1,399 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane-contrib/provider-aws/apis/s3/v1beta1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3/fake"
+	s3testing "github.com/crossplane-contrib/provider-aws/pkg/controller/s3/testing"
+)
+
+var _ SubresourceClient = &IntelligentTieringConfigurationClient{}
+
+func generateIntelligentTieringConfig(id string, days int32) v1beta1.IntelligentTieringConfiguration {
+	return v1beta1.IntelligentTieringConfiguration{
+		ID: id,
+		Filter: &v1beta1.IntelligentTieringFilter{
+			And: &v1beta1.IntelligentTieringAndOperator{
+				Prefix: awsclient.String(prefix),
+				Tags:   tags,
+			},
+		},
+		Status:   "Enabled",
+		Tierings: []v1beta1.Tiering{{AccessTier: "ARCHIVE_ACCESS", Days: days}},
+	}
+}
+
+func generateAWSIntelligentTieringConfig(id string, days int32) types.IntelligentTieringConfiguration {
+	return types.IntelligentTieringConfiguration{
+		Id: awsclient.String(id),
+		Filter: &types.IntelligentTieringFilter{
+			And: &types.IntelligentTieringAndOperator{
+				Prefix: awsclient.String(prefix),
+				Tags:   []types.Tag{awsTag2, awsTag, awsTag1},
+			},
+		},
+		Status:   types.IntelligentTieringStatusEnabled,
+		Tierings: []types.Tiering{{AccessTier: types.IntelligentTieringAccessTierArchiveAccess, Days: days}},
+	}
+}
+
+func intelligentTieringList(configs ...types.IntelligentTieringConfiguration) func(ctx context.Context, input *s3.ListBucketIntelligentTieringConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketIntelligentTieringConfigurationsOutput, error) {
+	return func(ctx context.Context, input *s3.ListBucketIntelligentTieringConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketIntelligentTieringConfigurationsOutput, error) {
+		return &s3.ListBucketIntelligentTieringConfigurationsOutput{IntelligentTieringConfigurationList: configs}, nil
+	}
+}
+
+func TestIntelligentTieringObserve(t *testing.T) {
+	type args struct {
+		cl *IntelligentTieringConfigurationClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		status ResourceStatus
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithIntelligentTieringConfigs(generateIntelligentTieringConfig("a", 90))),
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClient{
+					MockListBucketIntelligentTieringConfigurations: func(ctx context.Context, input *s3.ListBucketIntelligentTieringConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketIntelligentTieringConfigurationsOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+				err:    awsclient.Wrap(errBoom, intelligentTieringListFailed),
+			},
+		},
+		"NeedsCreate": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithIntelligentTieringConfigs(generateIntelligentTieringConfig("a", 90))),
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClient{
+					MockListBucketIntelligentTieringConfigurations: intelligentTieringList(),
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"NeedsUpdate": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithIntelligentTieringConfigs(generateIntelligentTieringConfig("a", 90))),
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClient{
+					MockListBucketIntelligentTieringConfigurations: intelligentTieringList(generateAWSIntelligentTieringConfig("a", 180)),
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"NeedsUpdateExtraID": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithIntelligentTieringConfigs(generateIntelligentTieringConfig("a", 90))),
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClient{
+					MockListBucketIntelligentTieringConfigurations: intelligentTieringList(
+						generateAWSIntelligentTieringConfig("a", 90),
+						generateAWSIntelligentTieringConfig("b", 90),
+					),
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"NeedsDelete": {
+			args: args{
+				b: s3testing.Bucket(),
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClient{
+					MockListBucketIntelligentTieringConfigurations: intelligentTieringList(generateAWSIntelligentTieringConfig("a", 90)),
+				}),
+			},
+			want: want{
+				status: NeedsDeletion,
+			},
+		},
+		"NoUpdateNotExists": {
+			args: args{
+				b: s3testing.Bucket(),
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClient{
+					MockListBucketIntelligentTieringConfigurations: intelligentTieringList(),
+				}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+		"NoUpdateExists": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithIntelligentTieringConfigs(
+					generateIntelligentTieringConfig("a", 90),
+					generateIntelligentTieringConfig("b", 180),
+				)),
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClient{
+					MockListBucketIntelligentTieringConfigurations: func(ctx context.Context, input *s3.ListBucketIntelligentTieringConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketIntelligentTieringConfigurationsOutput, error) {
+						if input.ContinuationToken == nil {
+							return &s3.ListBucketIntelligentTieringConfigurationsOutput{
+								IntelligentTieringConfigurationList: []types.IntelligentTieringConfiguration{generateAWSIntelligentTieringConfig("b", 180)},
+								IsTruncated:                         true,
+								NextContinuationToken:               awsclient.String("next"),
+							}, nil
+						}
+						return &s3.ListBucketIntelligentTieringConfigurationsOutput{
+							IntelligentTieringConfigurationList: []types.IntelligentTieringConfiguration{generateAWSIntelligentTieringConfig("a", 90)},
+						}, nil
+					},
+				}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			status, err := tc.args.cl.Observe(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.status, status); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIntelligentTieringCreateOrUpdate(t *testing.T) {
+	type args struct {
+		cl func(put, deleted *[]string) *IntelligentTieringConfigurationClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		put     []string
+		deleted []string
+		err     error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ErrorPut": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithIntelligentTieringConfigs(generateIntelligentTieringConfig("a", 90))),
+				cl: func(put, deleted *[]string) *IntelligentTieringConfigurationClient {
+					return NewIntelligentTieringConfigurationClient(fake.MockBucketClient{
+						MockListBucketIntelligentTieringConfigurations: intelligentTieringList(),
+						MockPutBucketIntelligentTieringConfiguration: func(ctx context.Context, input *s3.PutBucketIntelligentTieringConfigurationInput, opts []func(*s3.Options)) (*s3.PutBucketIntelligentTieringConfigurationOutput, error) {
+							return nil, errBoom
+						},
+					})
+				},
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, intelligentTieringPutFailed),
+			},
+		},
+		"ErrorDelete": {
+			args: args{
+				b: s3testing.Bucket(),
+				cl: func(put, deleted *[]string) *IntelligentTieringConfigurationClient {
+					return NewIntelligentTieringConfigurationClient(fake.MockBucketClient{
+						MockListBucketIntelligentTieringConfigurations: intelligentTieringList(generateAWSIntelligentTieringConfig("a", 90)),
+						MockDeleteBucketIntelligentTieringConfiguration: func(ctx context.Context, input *s3.DeleteBucketIntelligentTieringConfigurationInput, opts []func(*s3.Options)) (*s3.DeleteBucketIntelligentTieringConfigurationOutput, error) {
+							return nil, errBoom
+						},
+					})
+				},
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, intelligentTieringDeleteFailed),
+			},
+		},
+		"PerIDChanges": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithIntelligentTieringConfigs(
+					generateIntelligentTieringConfig("unchanged", 90),
+					generateIntelligentTieringConfig("changed", 90),
+					generateIntelligentTieringConfig("new", 90),
+				)),
+				cl: func(put, deleted *[]string) *IntelligentTieringConfigurationClient {
+					return NewIntelligentTieringConfigurationClient(fake.MockBucketClient{
+						MockListBucketIntelligentTieringConfigurations: intelligentTieringList(
+							generateAWSIntelligentTieringConfig("unchanged", 90),
+							generateAWSIntelligentTieringConfig("changed", 180),
+							generateAWSIntelligentTieringConfig("removed", 90),
+						),
+						MockPutBucketIntelligentTieringConfiguration: func(ctx context.Context, input *s3.PutBucketIntelligentTieringConfigurationInput, opts []func(*s3.Options)) (*s3.PutBucketIntelligentTieringConfigurationOutput, error) {
+							*put = append(*put, awsclient.StringValue(input.Id))
+							return &s3.PutBucketIntelligentTieringConfigurationOutput{}, nil
+						},
+						MockDeleteBucketIntelligentTieringConfiguration: func(ctx context.Context, input *s3.DeleteBucketIntelligentTieringConfigurationInput, opts []func(*s3.Options)) (*s3.DeleteBucketIntelligentTieringConfigurationOutput, error) {
+							*deleted = append(*deleted, awsclient.StringValue(input.Id))
+							return &s3.DeleteBucketIntelligentTieringConfigurationOutput{}, nil
+						},
+					})
+				},
+			},
+			want: want{
+				put:     []string{"changed", "new"},
+				deleted: []string{"removed"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var put, deleted []string
+			err := tc.args.cl(&put, &deleted).CreateOrUpdate(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.put, put); diff != "" {
+				t.Errorf("r: -want put, +got put:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, deleted); diff != "" {
+				t.Errorf("r: -want deleted, +got deleted:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIntelligentTieringDelete(t *testing.T) {
+	var deleted []string
+	cl := NewIntelligentTieringConfigurationClient(fake.MockBucketClient{
+		MockListBucketIntelligentTieringConfigurations: intelligentTieringList(
+			generateAWSIntelligentTieringConfig("a", 90),
+			generateAWSIntelligentTieringConfig("b", 90),
+		),
+		MockDeleteBucketIntelligentTieringConfiguration: func(ctx context.Context, input *s3.DeleteBucketIntelligentTieringConfigurationInput, opts []func(*s3.Options)) (*s3.DeleteBucketIntelligentTieringConfigurationOutput, error) {
+			deleted = append(deleted, awsclient.StringValue(input.Id))
+			return &s3.DeleteBucketIntelligentTieringConfigurationOutput{}, nil
+		},
+	})
+	if err := cl.Delete(context.Background(), s3testing.Bucket()); err != nil {
+		t.Errorf("r: unexpected error: %v", err)
+	}
+	if diff := cmp.Diff([]string{"a", "b"}, deleted); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
+
+func TestIntelligentTieringLateInit(t *testing.T) {
+	type args struct {
+		cl SubresourceClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		err error
+		cr  *v1beta1.Bucket
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				b: s3testing.Bucket(),
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClient{
+					MockListBucketIntelligentTieringConfigurations: func(ctx context.Context, input *s3.ListBucketIntelligentTieringConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketIntelligentTieringConfigurationsOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, intelligentTieringListFailed),
+				cr:  s3testing.Bucket(),
+			},
+		},
+		"NoLateInitEmpty": {
+			args: args{
+				b: s3testing.Bucket(),
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClient{
+					MockListBucketIntelligentTieringConfigurations: intelligentTieringList(),
+				}),
+			},
+			want: want{
+				cr: s3testing.Bucket(),
+			},
+		},
+		"SuccessfulLateInit": {
+			args: args{
+				b: s3testing.Bucket(),
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClient{
+					MockListBucketIntelligentTieringConfigurations: intelligentTieringList(generateAWSIntelligentTieringConfig("a", 90)),
+				}),
+			},
+			want: want{
+				cr: s3testing.Bucket(s3testing.WithIntelligentTieringConfigs(v1beta1.IntelligentTieringConfiguration{
+					ID: "a",
+					Filter: &v1beta1.IntelligentTieringFilter{
+						And: &v1beta1.IntelligentTieringAndOperator{
+							Prefix: awsclient.String(prefix),
+							Tags:   []v1beta1.Tag{tag2, tag, tag1},
+						},
+					},
+					Status:   "Enabled",
+					Tierings: []v1beta1.Tiering{{AccessTier: "ARCHIVE_ACCESS", Days: 90}},
+				})),
+			},
+		},
+		"NoOpLateInit": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithIntelligentTieringConfigs(generateIntelligentTieringConfig("b", 180))),
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClient{
+					MockListBucketIntelligentTieringConfigurations: intelligentTieringList(generateAWSIntelligentTieringConfig("a", 90)),
+				}),
+			},
+			want: want{
+				cr: s3testing.Bucket(s3testing.WithIntelligentTieringConfigs(generateIntelligentTieringConfig("b", 180))),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.args.cl.LateInitialize(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.b); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
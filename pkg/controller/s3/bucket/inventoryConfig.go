@@ -0,0 +1,249 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+	"sort"
+
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+
+	"github.com/crossplane-contrib/provider-aws/apis/s3/v1beta1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3"
+)
+
+const (
+	inventoryListFailed   = "cannot list Bucket inventory configurations"
+	inventoryPutFailed    = "cannot put Bucket inventory configuration"
+	inventoryDeleteFailed = "cannot delete Bucket inventory configuration"
+)
+
+// InventoryConfigurationClient is the client for API methods and reconciling the
+// InventoryConfigurations
+type InventoryConfigurationClient struct {
+	client s3.BucketClient
+}
+
+// NewInventoryConfigurationClient creates the client for Inventory Configurations
+func NewInventoryConfigurationClient(client s3.BucketClient) *InventoryConfigurationClient {
+	return &InventoryConfigurationClient{client: client}
+}
+
+// Observe checks if the resource exists and if it matches the local configuration
+func (in *InventoryConfigurationClient) Observe(ctx context.Context, bucket *v1beta1.Bucket) (ResourceStatus, error) {
+	external, err := in.list(ctx, bucket)
+	if err != nil {
+		return NeedsUpdate, awsclient.Wrap(err, inventoryListFailed)
+	}
+	desired := GenerateInventoryConfigurations(bucket.Spec.ForProvider.InventoryConfigurations)
+	put, remove := diffInventoryConfigurations(desired, external)
+	switch {
+	case len(desired) == 0 && len(external) != 0:
+		return NeedsDeletion, nil
+	case len(put) == 0 && len(remove) == 0:
+		return Updated, nil
+	default:
+		return NeedsUpdate, nil
+	}
+}
+
+// CreateOrUpdate puts the configurations that are missing or differ and
+// deletes the configurations whose ID is not in the local configuration.
+func (in *InventoryConfigurationClient) CreateOrUpdate(ctx context.Context, bucket *v1beta1.Bucket) error {
+	external, err := in.list(ctx, bucket)
+	if err != nil {
+		return awsclient.Wrap(err, inventoryListFailed)
+	}
+	desired := GenerateInventoryConfigurations(bucket.Spec.ForProvider.InventoryConfigurations)
+	put, remove := diffInventoryConfigurations(desired, external)
+	for i := range put {
+		_, err := in.client.PutBucketInventoryConfiguration(ctx, &awss3.PutBucketInventoryConfigurationInput{
+			Bucket:                 awsclient.String(meta.GetExternalName(bucket)),
+			Id:                     put[i].Id,
+			InventoryConfiguration: &put[i],
+		})
+		if err != nil {
+			return awsclient.Wrap(err, inventoryPutFailed)
+		}
+	}
+	return in.delete(ctx, bucket, remove)
+}
+
+// Delete removes all inventory configurations of the bucket.
+func (in *InventoryConfigurationClient) Delete(ctx context.Context, bucket *v1beta1.Bucket) error {
+	external, err := in.list(ctx, bucket)
+	if err != nil {
+		return awsclient.Wrap(err, inventoryListFailed)
+	}
+	ids := make([]string, len(external))
+	for i := range external {
+		ids[i] = awsclient.StringValue(external[i].Id)
+	}
+	return in.delete(ctx, bucket, ids)
+}
+
+// LateInitialize fills the empty fields in the bucket spec with the
+// configurations found on the bucket.
+func (in *InventoryConfigurationClient) LateInitialize(ctx context.Context, bucket *v1beta1.Bucket) error {
+	if bucket.Spec.ForProvider.InventoryConfigurations != nil {
+		return nil
+	}
+	external, err := in.list(ctx, bucket)
+	if err != nil {
+		return awsclient.Wrap(err, inventoryListFailed)
+	}
+	if len(external) != 0 {
+		bucket.Spec.ForProvider.InventoryConfigurations = GenerateLocalInventoryConfigurations(external)
+	}
+	return nil
+}
+
+// SubresourceExists checks if the subresource this controller manages currently exists
+func (in *InventoryConfigurationClient) SubresourceExists(bucket *v1beta1.Bucket) bool {
+	return len(bucket.Spec.ForProvider.InventoryConfigurations) != 0
+}
+
+func (in *InventoryConfigurationClient) list(ctx context.Context, bucket *v1beta1.Bucket) ([]types.InventoryConfiguration, error) {
+	var result []types.InventoryConfiguration
+	input := &awss3.ListBucketInventoryConfigurationsInput{Bucket: awsclient.String(meta.GetExternalName(bucket))}
+	for {
+		response, err := in.client.ListBucketInventoryConfigurations(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, response.InventoryConfigurationList...)
+		if !response.IsTruncated {
+			return result, nil
+		}
+		input.ContinuationToken = response.NextContinuationToken
+	}
+}
+
+func (in *InventoryConfigurationClient) delete(ctx context.Context, bucket *v1beta1.Bucket, ids []string) error {
+	for _, id := range ids {
+		_, err := in.client.DeleteBucketInventoryConfiguration(ctx, &awss3.DeleteBucketInventoryConfigurationInput{
+			Bucket: awsclient.String(meta.GetExternalName(bucket)),
+			Id:     awsclient.String(id),
+		})
+		if err != nil {
+			return awsclient.Wrap(err, inventoryDeleteFailed)
+		}
+	}
+	return nil
+}
+
+func diffInventoryConfigurations(desired, external []types.InventoryConfiguration) ([]types.InventoryConfiguration, []string) {
+	for i := range external {
+		sortInventoryOptionalFields(external[i].OptionalFields)
+	}
+	return diffConfigurationsByID(desired, external, func(c types.InventoryConfiguration) string {
+		return awsclient.StringValue(c.Id)
+	})
+}
+
+func sortInventoryOptionalFields(fields []types.InventoryOptionalField) {
+	sort.Slice(fields, func(i, j int) bool { return fields[i] < fields[j] })
+}
+
+// GenerateInventoryConfigurations creates the list of InventoryConfigurations
+// for the AWS SDK
+func GenerateInventoryConfigurations(in []v1beta1.InventoryConfiguration) []types.InventoryConfiguration {
+	if in == nil {
+		return nil
+	}
+	result := make([]types.InventoryConfiguration, len(in))
+	for i, local := range in {
+		dst := local.Destination.S3BucketDestination
+		result[i] = types.InventoryConfiguration{
+			Id: awsclient.String(local.ID),
+			Destination: &types.InventoryDestination{
+				S3BucketDestination: &types.InventoryS3BucketDestination{
+					AccountId: dst.AccountID,
+					Bucket:    awsclient.String(dst.Bucket),
+					Format:    types.InventoryFormat(dst.Format),
+					Prefix:    dst.Prefix,
+				},
+			},
+			IncludedObjectVersions: types.InventoryIncludedObjectVersions(local.IncludedObjectVersions),
+			IsEnabled:              local.IsEnabled,
+			Schedule:               &types.InventorySchedule{Frequency: types.InventoryFrequency(local.Schedule.Frequency)},
+		}
+		if dst.Encryption != nil {
+			enc := &types.InventoryEncryption{}
+			if dst.Encryption.SSEKMS != nil {
+				enc.SSEKMS = &types.SSEKMS{KeyId: awsclient.String(dst.Encryption.SSEKMS.KeyID)}
+			}
+			if dst.Encryption.SSES3 != nil {
+				enc.SSES3 = &types.SSES3{}
+			}
+			result[i].Destination.S3BucketDestination.Encryption = enc
+		}
+		if local.Filter != nil {
+			result[i].Filter = &types.InventoryFilter{Prefix: awsclient.String(local.Filter.Prefix)}
+		}
+		for _, f := range local.OptionalFields {
+			result[i].OptionalFields = append(result[i].OptionalFields, types.InventoryOptionalField(f))
+		}
+		sortInventoryOptionalFields(result[i].OptionalFields)
+	}
+	return result
+}
+
+// GenerateLocalInventoryConfigurations creates the list of local
+// InventoryConfigurations from the AWS SDK ones
+func GenerateLocalInventoryConfigurations(in []types.InventoryConfiguration) []v1beta1.InventoryConfiguration {
+	result := make([]v1beta1.InventoryConfiguration, len(in))
+	for i, external := range in {
+		result[i] = v1beta1.InventoryConfiguration{
+			ID:                     awsclient.StringValue(external.Id),
+			IncludedObjectVersions: string(external.IncludedObjectVersions),
+			IsEnabled:              external.IsEnabled,
+		}
+		if external.Destination != nil && external.Destination.S3BucketDestination != nil {
+			dst := external.Destination.S3BucketDestination
+			result[i].Destination.S3BucketDestination = v1beta1.InventoryS3BucketDestination{
+				AccountID: dst.AccountId,
+				Bucket:    awsclient.StringValue(dst.Bucket),
+				Format:    string(dst.Format),
+				Prefix:    dst.Prefix,
+			}
+			if dst.Encryption != nil {
+				enc := &v1beta1.InventoryEncryption{}
+				if dst.Encryption.SSEKMS != nil {
+					enc.SSEKMS = &v1beta1.SSEKMS{KeyID: awsclient.StringValue(dst.Encryption.SSEKMS.KeyId)}
+				}
+				if dst.Encryption.SSES3 != nil {
+					enc.SSES3 = &v1beta1.SSES3{}
+				}
+				result[i].Destination.S3BucketDestination.Encryption = enc
+			}
+		}
+		if external.Filter != nil {
+			result[i].Filter = &v1beta1.InventoryFilter{Prefix: awsclient.StringValue(external.Filter.Prefix)}
+		}
+		if external.Schedule != nil {
+			result[i].Schedule.Frequency = string(external.Schedule.Frequency)
+		}
+		for _, f := range external.OptionalFields {
+			result[i].OptionalFields = append(result[i].OptionalFields, string(f))
+		}
+	}
+	return result
+}
@@ -0,0 +1,204 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go/document"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane-contrib/provider-aws/apis/s3/v1beta1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3/fake"
+	s3testing "github.com/crossplane-contrib/provider-aws/pkg/controller/s3/testing"
+)
+
+var (
+	_ SubresourceClient = &InventoryConfigurationClient{}
+
+	inventoryDestinationARN = "arn:aws:s3:::inventory"
+)
+
+func generateInventoryConfig(id string, frequency string) v1beta1.InventoryConfiguration {
+	return v1beta1.InventoryConfiguration{
+		ID: id,
+		Destination: v1beta1.InventoryDestination{
+			S3BucketDestination: v1beta1.InventoryS3BucketDestination{
+				AccountID:  awsclient.String(accountID),
+				Bucket:     inventoryDestinationARN,
+				Encryption: &v1beta1.InventoryEncryption{SSES3: &v1beta1.SSES3{}},
+				Format:     "CSV",
+				Prefix:     awsclient.String(prefix),
+			},
+		},
+		Filter:                 &v1beta1.InventoryFilter{Prefix: prefix},
+		IncludedObjectVersions: "Current",
+		IsEnabled:              true,
+		OptionalFields:         []string{"Size", "ETag"},
+		Schedule:               v1beta1.InventorySchedule{Frequency: frequency},
+	}
+}
+
+func generateAWSInventoryConfig(id string, frequency types.InventoryFrequency) types.InventoryConfiguration {
+	return types.InventoryConfiguration{
+		Id: awsclient.String(id),
+		Destination: &types.InventoryDestination{
+			S3BucketDestination: &types.InventoryS3BucketDestination{
+				AccountId:  awsclient.String(accountID),
+				Bucket:     awsclient.String(inventoryDestinationARN),
+				Encryption: &types.InventoryEncryption{SSES3: &types.SSES3{}},
+				Format:     types.InventoryFormatCsv,
+				Prefix:     awsclient.String(prefix),
+			},
+		},
+		Filter:                 &types.InventoryFilter{Prefix: awsclient.String(prefix)},
+		IncludedObjectVersions: types.InventoryIncludedObjectVersionsCurrent,
+		IsEnabled:              true,
+		OptionalFields:         []types.InventoryOptionalField{types.InventoryOptionalFieldSize, types.InventoryOptionalFieldETag},
+		Schedule:               &types.InventorySchedule{Frequency: frequency},
+	}
+}
+
+func inventoryList(configs ...types.InventoryConfiguration) func(ctx context.Context, input *s3.ListBucketInventoryConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketInventoryConfigurationsOutput, error) {
+	return func(ctx context.Context, input *s3.ListBucketInventoryConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketInventoryConfigurationsOutput, error) {
+		return &s3.ListBucketInventoryConfigurationsOutput{InventoryConfigurationList: configs}, nil
+	}
+}
+
+func TestInventoryObserve(t *testing.T) {
+	type args struct {
+		cl *InventoryConfigurationClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		status ResourceStatus
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithInventoryConfigs(generateInventoryConfig("a", "Daily"))),
+				cl: NewInventoryConfigurationClient(fake.MockBucketClient{
+					MockListBucketInventoryConfigurations: func(ctx context.Context, input *s3.ListBucketInventoryConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketInventoryConfigurationsOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+				err:    awsclient.Wrap(errBoom, inventoryListFailed),
+			},
+		},
+		"NeedsUpdate": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithInventoryConfigs(generateInventoryConfig("a", "Daily"))),
+				cl: NewInventoryConfigurationClient(fake.MockBucketClient{
+					MockListBucketInventoryConfigurations: inventoryList(generateAWSInventoryConfig("a", types.InventoryFrequencyWeekly)),
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"NeedsDelete": {
+			args: args{
+				b: s3testing.Bucket(),
+				cl: NewInventoryConfigurationClient(fake.MockBucketClient{
+					MockListBucketInventoryConfigurations: inventoryList(generateAWSInventoryConfig("a", types.InventoryFrequencyDaily)),
+				}),
+			},
+			want: want{
+				status: NeedsDeletion,
+			},
+		},
+		"NoUpdateExists": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithInventoryConfigs(generateInventoryConfig("a", "Daily"))),
+				cl: NewInventoryConfigurationClient(fake.MockBucketClient{
+					MockListBucketInventoryConfigurations: inventoryList(generateAWSInventoryConfig("a", types.InventoryFrequencyDaily)),
+				}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			status, err := tc.args.cl.Observe(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.status, status); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestInventoryCreateOrUpdate(t *testing.T) {
+	var put, deleted []string
+	cl := NewInventoryConfigurationClient(fake.MockBucketClient{
+		MockListBucketInventoryConfigurations: inventoryList(
+			generateAWSInventoryConfig("unchanged", types.InventoryFrequencyDaily),
+			generateAWSInventoryConfig("removed", types.InventoryFrequencyDaily),
+		),
+		MockPutBucketInventoryConfiguration: func(ctx context.Context, input *s3.PutBucketInventoryConfigurationInput, opts []func(*s3.Options)) (*s3.PutBucketInventoryConfigurationOutput, error) {
+			put = append(put, awsclient.StringValue(input.Id))
+			return &s3.PutBucketInventoryConfigurationOutput{}, nil
+		},
+		MockDeleteBucketInventoryConfiguration: func(ctx context.Context, input *s3.DeleteBucketInventoryConfigurationInput, opts []func(*s3.Options)) (*s3.DeleteBucketInventoryConfigurationOutput, error) {
+			deleted = append(deleted, awsclient.StringValue(input.Id))
+			return &s3.DeleteBucketInventoryConfigurationOutput{}, nil
+		},
+	})
+	b := s3testing.Bucket(s3testing.WithInventoryConfigs(
+		generateInventoryConfig("unchanged", "Daily"),
+		generateInventoryConfig("new", "Weekly"),
+	))
+	if err := cl.CreateOrUpdate(context.Background(), b); err != nil {
+		t.Errorf("r: unexpected error: %v", err)
+	}
+	if diff := cmp.Diff([]string{"new"}, put); diff != "" {
+		t.Errorf("r: -want put, +got put:\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"removed"}, deleted); diff != "" {
+		t.Errorf("r: -want deleted, +got deleted:\n%s", diff)
+	}
+}
+
+func TestGenerateInventoryConfigurations(t *testing.T) {
+	want := []types.InventoryConfiguration{generateAWSInventoryConfig("a", types.InventoryFrequencyDaily)}
+	sortInventoryOptionalFields(want[0].OptionalFields)
+	local := GenerateLocalInventoryConfigurations(want)
+	got := GenerateInventoryConfigurations(local)
+	if diff := cmp.Diff(want, got, cmpopts.IgnoreTypes(document.NoSerde{})); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
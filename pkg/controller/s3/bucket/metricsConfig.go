@@ -0,0 +1,226 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+
+	"github.com/crossplane-contrib/provider-aws/apis/s3/v1beta1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3"
+)
+
+const (
+	metricsListFailed   = "cannot list Bucket metrics configurations"
+	metricsPutFailed    = "cannot put Bucket metrics configuration"
+	metricsDeleteFailed = "cannot delete Bucket metrics configuration"
+)
+
+// MetricsConfigurationClient is the client for API methods and reconciling the
+// MetricsConfigurations
+type MetricsConfigurationClient struct {
+	client s3.BucketClient
+}
+
+// NewMetricsConfigurationClient creates the client for Metrics Configurations
+func NewMetricsConfigurationClient(client s3.BucketClient) *MetricsConfigurationClient {
+	return &MetricsConfigurationClient{client: client}
+}
+
+// Observe checks if the resource exists and if it matches the local configuration
+func (in *MetricsConfigurationClient) Observe(ctx context.Context, bucket *v1beta1.Bucket) (ResourceStatus, error) {
+	external, err := in.list(ctx, bucket)
+	if err != nil {
+		return NeedsUpdate, awsclient.Wrap(err, metricsListFailed)
+	}
+	desired := GenerateMetricsConfigurations(bucket.Spec.ForProvider.MetricsConfigurations)
+	put, remove := diffMetricsConfigurations(desired, external)
+	switch {
+	case len(desired) == 0 && len(external) != 0:
+		return NeedsDeletion, nil
+	case len(put) == 0 && len(remove) == 0:
+		return Updated, nil
+	default:
+		return NeedsUpdate, nil
+	}
+}
+
+// CreateOrUpdate puts the configurations that are missing or differ and
+// deletes the configurations whose ID is not in the local configuration.
+func (in *MetricsConfigurationClient) CreateOrUpdate(ctx context.Context, bucket *v1beta1.Bucket) error {
+	external, err := in.list(ctx, bucket)
+	if err != nil {
+		return awsclient.Wrap(err, metricsListFailed)
+	}
+	desired := GenerateMetricsConfigurations(bucket.Spec.ForProvider.MetricsConfigurations)
+	put, remove := diffMetricsConfigurations(desired, external)
+	for i := range put {
+		_, err := in.client.PutBucketMetricsConfiguration(ctx, &awss3.PutBucketMetricsConfigurationInput{
+			Bucket:               awsclient.String(meta.GetExternalName(bucket)),
+			Id:                   put[i].Id,
+			MetricsConfiguration: &put[i],
+		})
+		if err != nil {
+			return awsclient.Wrap(err, metricsPutFailed)
+		}
+	}
+	return in.delete(ctx, bucket, remove)
+}
+
+// Delete removes all metrics configurations of the bucket.
+func (in *MetricsConfigurationClient) Delete(ctx context.Context, bucket *v1beta1.Bucket) error {
+	external, err := in.list(ctx, bucket)
+	if err != nil {
+		return awsclient.Wrap(err, metricsListFailed)
+	}
+	ids := make([]string, len(external))
+	for i := range external {
+		ids[i] = awsclient.StringValue(external[i].Id)
+	}
+	return in.delete(ctx, bucket, ids)
+}
+
+// LateInitialize fills the empty fields in the bucket spec with the
+// configurations found on the bucket.
+func (in *MetricsConfigurationClient) LateInitialize(ctx context.Context, bucket *v1beta1.Bucket) error {
+	if bucket.Spec.ForProvider.MetricsConfigurations != nil {
+		return nil
+	}
+	external, err := in.list(ctx, bucket)
+	if err != nil {
+		return awsclient.Wrap(err, metricsListFailed)
+	}
+	if len(external) != 0 {
+		bucket.Spec.ForProvider.MetricsConfigurations = GenerateLocalMetricsConfigurations(external)
+	}
+	return nil
+}
+
+// SubresourceExists checks if the subresource this controller manages currently exists
+func (in *MetricsConfigurationClient) SubresourceExists(bucket *v1beta1.Bucket) bool {
+	return len(bucket.Spec.ForProvider.MetricsConfigurations) != 0
+}
+
+func (in *MetricsConfigurationClient) list(ctx context.Context, bucket *v1beta1.Bucket) ([]types.MetricsConfiguration, error) {
+	var result []types.MetricsConfiguration
+	input := &awss3.ListBucketMetricsConfigurationsInput{Bucket: awsclient.String(meta.GetExternalName(bucket))}
+	for {
+		response, err := in.client.ListBucketMetricsConfigurations(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, response.MetricsConfigurationList...)
+		if !response.IsTruncated {
+			return result, nil
+		}
+		input.ContinuationToken = response.NextContinuationToken
+	}
+}
+
+func (in *MetricsConfigurationClient) delete(ctx context.Context, bucket *v1beta1.Bucket, ids []string) error {
+	for _, id := range ids {
+		_, err := in.client.DeleteBucketMetricsConfiguration(ctx, &awss3.DeleteBucketMetricsConfigurationInput{
+			Bucket: awsclient.String(meta.GetExternalName(bucket)),
+			Id:     awsclient.String(id),
+		})
+		if err != nil {
+			return awsclient.Wrap(err, metricsDeleteFailed)
+		}
+	}
+	return nil
+}
+
+func diffMetricsConfigurations(desired, external []types.MetricsConfiguration) ([]types.MetricsConfiguration, []string) {
+	for i := range external {
+		if f, ok := external[i].Filter.(*types.MetricsFilterMemberAnd); ok {
+			f.Value.Tags = s3.SortS3TagSet(f.Value.Tags)
+		}
+	}
+	return diffConfigurationsByID(desired, external, func(c types.MetricsConfiguration) string {
+		return awsclient.StringValue(c.Id)
+	})
+}
+
+// generateMetricsFilter creates the MetricsFilter union for the AWS SDK
+func generateMetricsFilter(in *v1beta1.MetricsFilter) types.MetricsFilter {
+	switch {
+	case in == nil:
+		return nil
+	case in.And != nil:
+		return &types.MetricsFilterMemberAnd{Value: types.MetricsAndOperator{
+			Prefix: in.And.Prefix,
+			Tags:   s3.SortS3TagSet(s3.CopyTags(in.And.Tags)),
+		}}
+	case in.Tag != nil:
+		return &types.MetricsFilterMemberTag{Value: types.Tag{Key: awsclient.String(in.Tag.Key), Value: awsclient.String(in.Tag.Value)}}
+	case in.Prefix != nil:
+		return &types.MetricsFilterMemberPrefix{Value: *in.Prefix}
+	default:
+		return nil
+	}
+}
+
+// generateLocalMetricsFilter creates the local MetricsFilter from the AWS SDK
+// union
+func generateLocalMetricsFilter(in types.MetricsFilter) *v1beta1.MetricsFilter {
+	switch v := in.(type) {
+	case *types.MetricsFilterMemberAnd:
+		return &v1beta1.MetricsFilter{And: &v1beta1.MetricsAndOperator{
+			Prefix: v.Value.Prefix,
+			Tags:   s3.CopyAWSTags(v.Value.Tags),
+		}}
+	case *types.MetricsFilterMemberTag:
+		return &v1beta1.MetricsFilter{Tag: &v1beta1.Tag{Key: awsclient.StringValue(v.Value.Key), Value: awsclient.StringValue(v.Value.Value)}}
+	case *types.MetricsFilterMemberPrefix:
+		return &v1beta1.MetricsFilter{Prefix: awsclient.String(v.Value)}
+	default:
+		return nil
+	}
+}
+
+// GenerateMetricsConfigurations creates the list of MetricsConfigurations for
+// the AWS SDK
+func GenerateMetricsConfigurations(in []v1beta1.MetricsConfiguration) []types.MetricsConfiguration {
+	if in == nil {
+		return nil
+	}
+	result := make([]types.MetricsConfiguration, len(in))
+	for i, local := range in {
+		result[i] = types.MetricsConfiguration{
+			Id:     awsclient.String(local.ID),
+			Filter: generateMetricsFilter(local.Filter),
+		}
+	}
+	return result
+}
+
+// GenerateLocalMetricsConfigurations creates the list of local
+// MetricsConfigurations from the AWS SDK ones
+func GenerateLocalMetricsConfigurations(in []types.MetricsConfiguration) []v1beta1.MetricsConfiguration {
+	result := make([]v1beta1.MetricsConfiguration, len(in))
+	for i, external := range in {
+		result[i] = v1beta1.MetricsConfiguration{
+			ID:     awsclient.StringValue(external.Id),
+			Filter: generateLocalMetricsFilter(external.Filter),
+		}
+	}
+	return result
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane-contrib/provider-aws/apis/s3/v1beta1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3/fake"
+	s3testing "github.com/crossplane-contrib/provider-aws/pkg/controller/s3/testing"
+)
+
+var _ SubresourceClient = &MetricsConfigurationClient{}
+
+func metricsList(configs ...types.MetricsConfiguration) func(ctx context.Context, input *s3.ListBucketMetricsConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketMetricsConfigurationsOutput, error) {
+	return func(ctx context.Context, input *s3.ListBucketMetricsConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketMetricsConfigurationsOutput, error) {
+		return &s3.ListBucketMetricsConfigurationsOutput{MetricsConfigurationList: configs}, nil
+	}
+}
+
+func TestMetricsObserve(t *testing.T) {
+	type args struct {
+		cl *MetricsConfigurationClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		status ResourceStatus
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithMetricsConfigs(v1beta1.MetricsConfiguration{ID: "a"})),
+				cl: NewMetricsConfigurationClient(fake.MockBucketClient{
+					MockListBucketMetricsConfigurations: func(ctx context.Context, input *s3.ListBucketMetricsConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketMetricsConfigurationsOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+				err:    awsclient.Wrap(errBoom, metricsListFailed),
+			},
+		},
+		"NeedsUpdate": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithMetricsConfigs(v1beta1.MetricsConfiguration{
+					ID:     "a",
+					Filter: &v1beta1.MetricsFilter{Tag: &tag},
+				})),
+				cl: NewMetricsConfigurationClient(fake.MockBucketClient{
+					MockListBucketMetricsConfigurations: metricsList(types.MetricsConfiguration{
+						Id:     awsclient.String("a"),
+						Filter: &types.MetricsFilterMemberTag{Value: awsTag1},
+					}),
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"NeedsDelete": {
+			args: args{
+				b: s3testing.Bucket(),
+				cl: NewMetricsConfigurationClient(fake.MockBucketClient{
+					MockListBucketMetricsConfigurations: metricsList(types.MetricsConfiguration{Id: awsclient.String("a")}),
+				}),
+			},
+			want: want{
+				status: NeedsDeletion,
+			},
+		},
+		"NoUpdateExists": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithMetricsConfigs(
+					v1beta1.MetricsConfiguration{ID: "EntireBucket"},
+					v1beta1.MetricsConfiguration{
+						ID:     "a",
+						Filter: &v1beta1.MetricsFilter{Prefix: awsclient.String(prefix)},
+					},
+				)),
+				cl: NewMetricsConfigurationClient(fake.MockBucketClient{
+					MockListBucketMetricsConfigurations: metricsList(
+						types.MetricsConfiguration{
+							Id:     awsclient.String("a"),
+							Filter: &types.MetricsFilterMemberPrefix{Value: prefix},
+						},
+						types.MetricsConfiguration{Id: awsclient.String("EntireBucket")},
+					),
+				}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			status, err := tc.args.cl.Observe(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.status, status); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMetricsLateInit(t *testing.T) {
+	b := s3testing.Bucket()
+	cl := NewMetricsConfigurationClient(fake.MockBucketClient{
+		MockListBucketMetricsConfigurations: metricsList(types.MetricsConfiguration{
+			Id:     awsclient.String("a"),
+			Filter: &types.MetricsFilterMemberAnd{Value: types.MetricsAndOperator{Prefix: awsclient.String(prefix), Tags: awsTags}},
+		}),
+	})
+	if err := cl.LateInitialize(context.Background(), b); err != nil {
+		t.Errorf("r: unexpected error: %v", err)
+	}
+	want := s3testing.Bucket(s3testing.WithMetricsConfigs(v1beta1.MetricsConfiguration{
+		ID:     "a",
+		Filter: &v1beta1.MetricsFilter{And: &v1beta1.MetricsAndOperator{Prefix: awsclient.String(prefix), Tags: tags}},
+	}))
+	if diff := cmp.Diff(want, b); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,242 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+	"fmt"
+
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go/document"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-aws/apis/s3/v1beta1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3"
+)
+
+const (
+	objectLockGetFailed = "cannot get Bucket object lock configuration"
+	objectLockPutFailed = "cannot put Bucket object lock configuration"
+
+	errWeakenComplianceFmt = "refusing to weaken the COMPLIANCE default retention of %d days to %s"
+)
+
+// typeObjectLockRetention Buckets have their desired default Object Lock
+// retention applied.
+const typeObjectLockRetention xpv1.ConditionType = "ObjectLockRetention"
+
+// Reasons the default Object Lock retention of a Bucket is or is not applied.
+const (
+	reasonRetentionApplied  xpv1.ConditionReason = "RetentionApplied"
+	reasonRetentionRejected xpv1.ConditionReason = "RetentionRejected"
+)
+
+func retentionApplied() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               typeObjectLockRetention,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             reasonRetentionApplied,
+	}
+}
+
+func retentionRejected(err error) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               typeObjectLockRetention,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             reasonRetentionRejected,
+		Message:            err.Error(),
+	}
+}
+
+// daysPerYear is used to compare retention periods given in years with
+// retention periods given in days.
+const daysPerYear = 365
+
+// ObjectLockConfigurationClient is the client for API methods and reconciling
+// the ObjectLockConfiguration
+type ObjectLockConfigurationClient struct {
+	client s3.BucketClient
+}
+
+// NewObjectLockConfigurationClient creates the client for Object Lock Configuration
+func NewObjectLockConfigurationClient(client s3.BucketClient) *ObjectLockConfigurationClient {
+	return &ObjectLockConfigurationClient{client: client}
+}
+
+// Observe checks if the resource exists and if it matches the local
+// configuration. The configuration is never deleted, since Object Lock
+// cannot be disabled once it is enabled.
+func (in *ObjectLockConfigurationClient) Observe(ctx context.Context, bucket *v1beta1.Bucket) (ResourceStatus, error) {
+	if bucket.Spec.ForProvider.ObjectLockConfiguration == nil {
+		return Updated, nil
+	}
+	external, err := in.get(ctx, bucket)
+	if err != nil {
+		return NeedsUpdate, awsclient.Wrap(err, objectLockGetFailed)
+	}
+	if cmp.Equal(external, GenerateObjectLockConfiguration(bucket.Spec.ForProvider.ObjectLockConfiguration), cmpopts.IgnoreTypes(document.NoSerde{})) {
+		bucket.Status.SetConditions(retentionApplied())
+		return Updated, nil
+	}
+	return NeedsUpdate, nil
+}
+
+// CreateOrUpdate sends a request to have resource created on AWS. A change that
+// would weaken a default retention in COMPLIANCE mode is rejected.
+func (in *ObjectLockConfigurationClient) CreateOrUpdate(ctx context.Context, bucket *v1beta1.Bucket) error {
+	if bucket.Spec.ForProvider.ObjectLockConfiguration == nil {
+		return nil
+	}
+	external, err := in.get(ctx, bucket)
+	if err != nil {
+		return awsclient.Wrap(err, objectLockGetFailed)
+	}
+	desired := GenerateObjectLockConfiguration(bucket.Spec.ForProvider.ObjectLockConfiguration)
+	if err := checkComplianceRetention(external, desired); err != nil {
+		bucket.Status.SetConditions(retentionRejected(err))
+		return err
+	}
+	_, err = in.client.PutObjectLockConfiguration(ctx, &awss3.PutObjectLockConfigurationInput{
+		Bucket:                  awsclient.String(meta.GetExternalName(bucket)),
+		ObjectLockConfiguration: desired,
+	})
+	if err != nil {
+		return awsclient.Wrap(err, objectLockPutFailed)
+	}
+	bucket.Status.SetConditions(retentionApplied())
+	return nil
+}
+
+// Delete does nothing because Object Lock cannot be disabled for a bucket.
+func (in *ObjectLockConfigurationClient) Delete(_ context.Context, _ *v1beta1.Bucket) error {
+	return nil
+}
+
+// LateInitialize fills the empty fields in the bucket spec with the default
+// retention found on the bucket.
+func (in *ObjectLockConfigurationClient) LateInitialize(ctx context.Context, bucket *v1beta1.Bucket) error {
+	if bucket.Spec.ForProvider.ObjectLockConfiguration != nil {
+		return nil
+	}
+	external, err := in.get(ctx, bucket)
+	if err != nil {
+		return awsclient.Wrap(err, objectLockGetFailed)
+	}
+	if external == nil || external.Rule == nil || external.Rule.DefaultRetention == nil {
+		return nil
+	}
+	bucket.Spec.ForProvider.ObjectLockConfiguration = GenerateLocalObjectLockConfiguration(external)
+	return nil
+}
+
+// SubresourceExists checks if the subresource this controller manages currently exists
+func (in *ObjectLockConfigurationClient) SubresourceExists(bucket *v1beta1.Bucket) bool {
+	return bucket.Spec.ForProvider.ObjectLockConfiguration != nil
+}
+
+func (in *ObjectLockConfigurationClient) get(ctx context.Context, bucket *v1beta1.Bucket) (*types.ObjectLockConfiguration, error) {
+	response, err := in.client.GetObjectLockConfiguration(ctx, &awss3.GetObjectLockConfigurationInput{Bucket: awsclient.String(meta.GetExternalName(bucket))})
+	if err != nil || response == nil {
+		return nil, resource.Ignore(s3.ObjectLockConfigurationNotFound, err)
+	}
+	return response.ObjectLockConfiguration, nil
+}
+
+// checkComplianceRetention returns an error if the desired configuration
+// removes, shortens or changes the mode of an existing default retention in
+// COMPLIANCE mode.
+func checkComplianceRetention(external, desired *types.ObjectLockConfiguration) error {
+	current := defaultRetention(external)
+	if current == nil || current.Mode != types.ObjectLockRetentionModeCompliance {
+		return nil
+	}
+	wanted := defaultRetention(desired)
+	switch {
+	case wanted == nil:
+		return errors.Errorf(errWeakenComplianceFmt, retentionDays(current), "no default retention")
+	case wanted.Mode != types.ObjectLockRetentionModeCompliance:
+		return errors.Errorf(errWeakenComplianceFmt, retentionDays(current), fmt.Sprintf("%s mode", wanted.Mode))
+	case retentionDays(wanted) < retentionDays(current):
+		return errors.Errorf(errWeakenComplianceFmt, retentionDays(current), fmt.Sprintf("%d days", retentionDays(wanted)))
+	}
+	return nil
+}
+
+func defaultRetention(config *types.ObjectLockConfiguration) *types.DefaultRetention {
+	if config == nil || config.Rule == nil {
+		return nil
+	}
+	return config.Rule.DefaultRetention
+}
+
+func retentionDays(r *types.DefaultRetention) int32 {
+	return r.Days + r.Years*daysPerYear
+}
+
+// GenerateObjectLockConfiguration creates the ObjectLockConfiguration for the
+// AWS SDK
+func GenerateObjectLockConfiguration(config *v1beta1.ObjectLockConfiguration) *types.ObjectLockConfiguration {
+	if config == nil {
+		return nil
+	}
+	result := &types.ObjectLockConfiguration{ObjectLockEnabled: types.ObjectLockEnabledEnabled}
+	if config.Rule != nil {
+		result.Rule = &types.ObjectLockRule{
+			DefaultRetention: &types.DefaultRetention{
+				Days:  ptr.Deref(config.Rule.DefaultRetention.Days, 0),
+				Mode:  types.ObjectLockRetentionMode(config.Rule.DefaultRetention.Mode),
+				Years: ptr.Deref(config.Rule.DefaultRetention.Years, 0),
+			},
+		}
+	}
+	return result
+}
+
+// GenerateLocalObjectLockConfiguration creates the local
+// ObjectLockConfiguration from the AWS SDK one
+func GenerateLocalObjectLockConfiguration(config *types.ObjectLockConfiguration) *v1beta1.ObjectLockConfiguration {
+	if config == nil {
+		return nil
+	}
+	result := &v1beta1.ObjectLockConfiguration{}
+	if r := defaultRetention(config); r != nil {
+		result.Rule = &v1beta1.ObjectLockRule{
+			DefaultRetention: v1beta1.DefaultRetention{
+				Mode: string(r.Mode),
+			},
+		}
+		if r.Days != 0 {
+			result.Rule.DefaultRetention.Days = ptr.To(r.Days)
+		}
+		if r.Years != 0 {
+			result.Rule.DefaultRetention.Years = ptr.To(r.Years)
+		}
+	}
+	return result
+}
@@ -0,0 +1,331 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-aws/apis/s3/v1beta1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	clientss3 "github.com/crossplane-contrib/provider-aws/pkg/clients/s3"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3/fake"
+	s3testing "github.com/crossplane-contrib/provider-aws/pkg/controller/s3/testing"
+)
+
+var _ SubresourceClient = &ObjectLockConfigurationClient{}
+
+func generateObjectLockConfig(mode string, days *int32, years *int32) *v1beta1.ObjectLockConfiguration {
+	return &v1beta1.ObjectLockConfiguration{
+		Rule: &v1beta1.ObjectLockRule{
+			DefaultRetention: v1beta1.DefaultRetention{Mode: mode, Days: days, Years: years},
+		},
+	}
+}
+
+func generateAWSObjectLockConfig(mode types.ObjectLockRetentionMode, days int32, years int32) *types.ObjectLockConfiguration {
+	return &types.ObjectLockConfiguration{
+		ObjectLockEnabled: types.ObjectLockEnabledEnabled,
+		Rule: &types.ObjectLockRule{
+			DefaultRetention: &types.DefaultRetention{Mode: mode, Days: days, Years: years},
+		},
+	}
+}
+
+func objectLockGet(config *types.ObjectLockConfiguration) func(ctx context.Context, input *s3.GetObjectLockConfigurationInput, opts []func(*s3.Options)) (*s3.GetObjectLockConfigurationOutput, error) {
+	return func(ctx context.Context, input *s3.GetObjectLockConfigurationInput, opts []func(*s3.Options)) (*s3.GetObjectLockConfigurationOutput, error) {
+		if config == nil {
+			return nil, &smithy.GenericAPIError{Code: clientss3.ObjectLockNotFoundErrCode}
+		}
+		return &s3.GetObjectLockConfigurationOutput{ObjectLockConfiguration: config}, nil
+	}
+}
+
+func TestObjectLockObserve(t *testing.T) {
+	type args struct {
+		cl *ObjectLockConfigurationClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		status ResourceStatus
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithObjectLockConfig(generateObjectLockConfig("GOVERNANCE", ptr.To[int32](1), nil))),
+				cl: NewObjectLockConfigurationClient(fake.MockBucketClient{
+					MockGetObjectLockConfiguration: func(ctx context.Context, input *s3.GetObjectLockConfigurationInput, opts []func(*s3.Options)) (*s3.GetObjectLockConfigurationOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+				err:    awsclient.Wrap(errBoom, objectLockGetFailed),
+			},
+		},
+		"NotManaged": {
+			args: args{
+				b: s3testing.Bucket(),
+				cl: NewObjectLockConfigurationClient(fake.MockBucketClient{
+					MockGetObjectLockConfiguration: objectLockGet(generateAWSObjectLockConfig(types.ObjectLockRetentionModeCompliance, 1, 0)),
+				}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+		"NeedsUpdateNotEnabled": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithObjectLockConfig(generateObjectLockConfig("GOVERNANCE", ptr.To[int32](1), nil))),
+				cl: NewObjectLockConfigurationClient(fake.MockBucketClient{
+					MockGetObjectLockConfiguration: objectLockGet(nil),
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"NeedsUpdate": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithObjectLockConfig(generateObjectLockConfig("GOVERNANCE", ptr.To[int32](1), nil))),
+				cl: NewObjectLockConfigurationClient(fake.MockBucketClient{
+					MockGetObjectLockConfiguration: objectLockGet(generateAWSObjectLockConfig(types.ObjectLockRetentionModeGovernance, 2, 0)),
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"NoUpdateExists": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithObjectLockConfig(generateObjectLockConfig("COMPLIANCE", nil, ptr.To[int32](1)))),
+				cl: NewObjectLockConfigurationClient(fake.MockBucketClient{
+					MockGetObjectLockConfiguration: objectLockGet(generateAWSObjectLockConfig(types.ObjectLockRetentionModeCompliance, 0, 1)),
+				}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			status, err := tc.args.cl.Observe(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.status, status); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObjectLockCreateOrUpdate(t *testing.T) {
+	type args struct {
+		external *types.ObjectLockConfiguration
+		desired  *v1beta1.ObjectLockConfiguration
+		put      error
+	}
+
+	type want struct {
+		err  error
+		cond *xpv1.Condition
+	}
+
+	rejected := func(msg string) *xpv1.Condition {
+		c := retentionRejected(errors.New(msg))
+		return &c
+	}
+	applied := retentionApplied()
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ErrorPut": {
+			args: args{
+				desired: generateObjectLockConfig("GOVERNANCE", ptr.To[int32](1), nil),
+				put:     errBoom,
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, objectLockPutFailed),
+			},
+		},
+		"EnableObjectLock": {
+			args: args{
+				desired: generateObjectLockConfig("COMPLIANCE", ptr.To[int32](30), nil),
+			},
+			want: want{
+				cond: &applied,
+			},
+		},
+		"WeakenGovernance": {
+			args: args{
+				external: generateAWSObjectLockConfig(types.ObjectLockRetentionModeGovernance, 30, 0),
+				desired:  &v1beta1.ObjectLockConfiguration{},
+			},
+			want: want{
+				cond: &applied,
+			},
+		},
+		"ExtendCompliance": {
+			args: args{
+				external: generateAWSObjectLockConfig(types.ObjectLockRetentionModeCompliance, 30, 0),
+				desired:  generateObjectLockConfig("COMPLIANCE", nil, ptr.To[int32](1)),
+			},
+			want: want{
+				cond: &applied,
+			},
+		},
+		"ShortenCompliance": {
+			args: args{
+				external: generateAWSObjectLockConfig(types.ObjectLockRetentionModeCompliance, 0, 1),
+				desired:  generateObjectLockConfig("COMPLIANCE", ptr.To[int32](30), nil),
+			},
+			want: want{
+				err:  errors.New("refusing to weaken the COMPLIANCE default retention of 365 days to 30 days"),
+				cond: rejected("refusing to weaken the COMPLIANCE default retention of 365 days to 30 days"),
+			},
+		},
+		"ComplianceToGovernance": {
+			args: args{
+				external: generateAWSObjectLockConfig(types.ObjectLockRetentionModeCompliance, 30, 0),
+				desired:  generateObjectLockConfig("GOVERNANCE", ptr.To[int32](30), nil),
+			},
+			want: want{
+				err:  errors.New("refusing to weaken the COMPLIANCE default retention of 30 days to GOVERNANCE mode"),
+				cond: rejected("refusing to weaken the COMPLIANCE default retention of 30 days to GOVERNANCE mode"),
+			},
+		},
+		"RemoveCompliance": {
+			args: args{
+				external: generateAWSObjectLockConfig(types.ObjectLockRetentionModeCompliance, 30, 0),
+				desired:  &v1beta1.ObjectLockConfiguration{},
+			},
+			want: want{
+				err:  errors.New("refusing to weaken the COMPLIANCE default retention of 30 days to no default retention"),
+				cond: rejected("refusing to weaken the COMPLIANCE default retention of 30 days to no default retention"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cl := NewObjectLockConfigurationClient(fake.MockBucketClient{
+				MockGetObjectLockConfiguration: objectLockGet(tc.args.external),
+				MockPutObjectLockConfiguration: func(ctx context.Context, input *s3.PutObjectLockConfigurationInput, opts []func(*s3.Options)) (*s3.PutObjectLockConfigurationOutput, error) {
+					return &s3.PutObjectLockConfigurationOutput{}, tc.args.put
+				},
+			})
+			b := s3testing.Bucket(s3testing.WithObjectLockConfig(tc.args.desired))
+			err := cl.CreateOrUpdate(context.Background(), b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if tc.want.cond == nil {
+				return
+			}
+			if diff := cmp.Diff(*tc.want.cond, b.Status.GetCondition(typeObjectLockRetention), test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObjectLockLateInit(t *testing.T) {
+	type args struct {
+		cl SubresourceClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		err error
+		cr  *v1beta1.Bucket
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				b: s3testing.Bucket(),
+				cl: NewObjectLockConfigurationClient(fake.MockBucketClient{
+					MockGetObjectLockConfiguration: func(ctx context.Context, input *s3.GetObjectLockConfigurationInput, opts []func(*s3.Options)) (*s3.GetObjectLockConfigurationOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, objectLockGetFailed),
+				cr:  s3testing.Bucket(),
+			},
+		},
+		"NotEnabled": {
+			args: args{
+				b: s3testing.Bucket(),
+				cl: NewObjectLockConfigurationClient(fake.MockBucketClient{
+					MockGetObjectLockConfiguration: objectLockGet(nil),
+				}),
+			},
+			want: want{
+				cr: s3testing.Bucket(),
+			},
+		},
+		"SuccessfulLateInit": {
+			args: args{
+				b: s3testing.Bucket(),
+				cl: NewObjectLockConfigurationClient(fake.MockBucketClient{
+					MockGetObjectLockConfiguration: objectLockGet(generateAWSObjectLockConfig(types.ObjectLockRetentionModeGovernance, 10, 0)),
+				}),
+			},
+			want: want{
+				cr: s3testing.Bucket(s3testing.WithObjectLockConfig(generateObjectLockConfig("GOVERNANCE", ptr.To[int32](10), nil))),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.args.cl.LateInitialize(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.b); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -19,6 +19,10 @@ package bucket
 import (
 	"context"
 
+	"github.com/aws/smithy-go/document"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
 	"github.com/crossplane-contrib/provider-aws/apis/s3/v1beta1"
 	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3"
 )
@@ -38,6 +42,8 @@ func NewSubresourceClients(client s3.BucketClient) []SubresourceClient {
 		// Note: Moved VersioningClient up, since ReplicationConfiguration may be blocked
 		// by an invalid VersioningConfig, see https://github.com/crossplane-contrib/provider-aws/issues/553
 		NewVersioningConfigurationClient(client),
+		// Note: Object Lock requires versioning to be enabled.
+		NewObjectLockConfigurationClient(client),
 		NewAccelerateConfigurationClient(client),
 		NewCORSConfigurationClient(client),
 		NewLifecycleConfigurationClient(client),
@@ -48,6 +54,10 @@ func NewSubresourceClients(client s3.BucketClient) []SubresourceClient {
 		NewSSEConfigurationClient(client),
 		NewTaggingConfigurationClient(client),
 		NewWebsiteConfigurationClient(client),
+		NewIntelligentTieringConfigurationClient(client),
+		NewInventoryConfigurationClient(client),
+		NewAnalyticsConfigurationClient(client),
+		NewMetricsConfigurationClient(client),
 		NewPublicAccessBlockClient(client),
 		NewPolicyClient(client),
 	}
@@ -64,3 +74,28 @@ const (
 	// NeedsDeletion is returned if the resource needs to be deleted.
 	NeedsDeletion
 )
+
+// diffConfigurationsByID compares list-keyed configurations, such as the
+// inventory or metrics configurations of a bucket, by their ID. It returns the
+// desired configurations that are missing or differ from the external ones and
+// the IDs of the external configurations that are not desired anymore. Any
+// sorting the comparison depends on must be done by the caller.
+func diffConfigurationsByID[T any](desired, external []T, id func(T) string) (put []T, remove []string) {
+	existing := make(map[string]T, len(external))
+	for _, e := range external {
+		existing[id(e)] = e
+	}
+	for _, d := range desired {
+		e, ok := existing[id(d)]
+		if !ok || !cmp.Equal(d, e, cmpopts.EquateEmpty(), cmpopts.IgnoreTypes(document.NoSerde{})) {
+			put = append(put, d)
+		}
+		delete(existing, id(d))
+	}
+	for _, e := range external {
+		if _, ok := existing[id(e)]; ok {
+			remove = append(remove, id(e))
+		}
+	}
+	return put, remove
+}
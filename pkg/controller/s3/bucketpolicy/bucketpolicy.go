@@ -38,6 +38,7 @@ import (
 	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
 	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3"
 	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	policyutils "github.com/crossplane-contrib/provider-aws/pkg/utils/policy"
 )
 
 const (
@@ -47,6 +48,7 @@ const (
 	errGet              = "failed to get BucketPolicy for bucket with name"
 	errUpdate           = "failed to update the policy for bucket"
 	errNotSpecified     = "failed to format bucketPolicy, no rawPolicy or policy specified"
+	errInvalidPolicy    = "invalid bucket policy"
 )
 
 // SetupBucketPolicy adds a controller that reconciles
@@ -132,9 +134,15 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 	cr.SetConditions(xpv1.Available())
 
 	// If our version and the external version are the same, we return ResourceUpToDate: true
+	upToDate := cmp.Equal(*policyData, *resp.Policy)
+	diff := ""
+	if !upToDate {
+		diff = policyutils.DiffPolicyStrings(*policyData, *resp.Policy)
+	}
 	return managed.ExternalObservation{
 		ResourceExists:   true,
-		ResourceUpToDate: cmp.Equal(*policyData, *resp.Policy),
+		ResourceUpToDate: upToDate,
+		Diff:             diff,
 	}, nil
 }
 
@@ -162,6 +170,14 @@ func (e *external) formatBucketPolicy(original *v1alpha3.BucketPolicy) (*string,
 	return nil, errors.New(errNotSpecified)
 }
 
+// validateBucketPolicy checks the given bucket policy for mistakes that would
+// make S3 reject it.
+func validateBucketPolicy(policy string) error {
+	return policyutils.ValidatePolicyString(policy,
+		policyutils.WithResourcePolicy(),
+		policyutils.WithMaxSize(policyutils.MaxBucketPolicySize))
+}
+
 func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
 	cr, ok := mgd.(*v1alpha3.BucketPolicy)
 	if !ok {
@@ -174,6 +190,9 @@ func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.Ex
 	if err != nil {
 		return managed.ExternalCreation{}, awsclient.Wrap(err, errAttach)
 	}
+	if err := validateBucketPolicy(*policyData); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errInvalidPolicy)
+	}
 
 	policyString := *policyData
 	_, err = e.client.PutBucketPolicy(ctx, &awss3.PutBucketPolicyInput{Bucket: cr.Spec.Parameters.BucketName, Policy: awsclient.String(policyString)})
@@ -191,6 +210,9 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 	if err != nil {
 		return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
 	}
+	if err := validateBucketPolicy(*policyData); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errInvalidPolicy)
+	}
 
 	_, err = e.client.PutBucketPolicy(ctx, &awss3.PutBucketPolicyInput{Bucket: cr.Spec.Parameters.BucketName, Policy: awsclient.String(*policyData)})
 	return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
@@ -57,6 +57,9 @@ var (
 			},
 		},
 	}
+	invalidParams = v1alpha3.BucketPolicyParameters{
+		RawPolicy: awsclient.String(`{"Statement":[{"Action":"s3:ListBucket","Effect":"Allow","Resource":"arn:aws:s3:::test.s3.crossplane.com"}],"Version":"2012-10-17"}`),
+	}
 	errBoom = errors.New("boom")
 )
 
@@ -232,6 +235,17 @@ func TestCreate(t *testing.T) {
 				err: awsclient.Wrap(errBoom, errAttach),
 			},
 		},
+		"InvalidPolicy": {
+			args: args{
+				cr: bucketPolicy(withPolicy(&invalidParams)),
+			},
+			want: want{
+				cr: bucketPolicy(
+					withPolicy(&invalidParams),
+					withConditions(xpv1.Creating())),
+				err: errors.Wrap(errors.New("statement 0: one of Principal and NotPrincipal must be specified in resource-based policies"), errInvalidPolicy),
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -0,0 +1,367 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5" //nolint:gosec // S3 requires MD5 for the Content-MD5 header
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-aws/apis/s3/v1alpha1"
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not an Object resource"
+	errHead             = "cannot get object"
+	errGetTagging       = "cannot get object tagging"
+	errPut              = "cannot put object"
+	errDelete           = "cannot delete object"
+	errKubeUpdate       = "cannot update Object custom resource"
+	errContent          = "cannot get object content"
+	errContentSource    = "exactly one of inline, base64, secretKeyRef and configMapKeyRef must be set"
+	errDecodeBase64     = "cannot decode base64 content"
+	errGetSecret        = "cannot get content Secret"
+	errGetConfigMap     = "cannot get content ConfigMap"
+	errFmtKeyNotFound   = "key %s not found"
+)
+
+// contentSHA256MetadataKey is the user metadata key the SHA-256 checksum of
+// the uploaded content is stored under. The ETag is only an MD5 digest of the
+// content for unencrypted and SSE-S3 objects, so the checksum is what detects
+// content changes regardless of how the object is encrypted.
+const contentSHA256MetadataKey = "crossplane-content-sha256"
+
+// SetupObject adds a controller that reconciles Objects.
+func SetupObject(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.ObjectGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), awsv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: s3.NewObjectClient}),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.ObjectGroupVersionKind),
+		reconcilerOpts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.Object{}).
+		Complete(r)
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) s3.ObjectClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Object)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	client s3.ObjectClient
+	kube   client.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Object)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	resp, err := e.client.HeadObject(ctx, &awss3.HeadObjectInput{
+		Bucket: cr.Spec.ForProvider.Bucket,
+		Key:    aws.String(cr.Spec.ForProvider.Key),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(s3.IsObjectNotFound, err), errHead)
+	}
+
+	// An ETag other than the one of the last upload means that the object
+	// was overwritten out of band.
+	lastETag := cr.Status.AtProvider.ETag
+	cr.Status.AtProvider = v1alpha1.ObjectObservation{
+		ETag:          aws.ToString(resp.ETag),
+		VersionID:     aws.ToString(resp.VersionId),
+		ContentSHA256: resp.Metadata[contentSHA256MetadataKey],
+	}
+	cr.SetConditions(xpv1.Available())
+
+	// The content source may already be gone while the Object is deleted.
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	lateInitialize(&cr.Spec.ForProvider, resp)
+
+	body, err := e.content(ctx, cr.Spec.ForProvider.Content)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errContent)
+	}
+	tagging, err := e.client.GetObjectTagging(ctx, &awss3.GetObjectTaggingInput{
+		Bucket: cr.Spec.ForProvider.Bucket,
+		Key:    aws.String(cr.Spec.ForProvider.Key),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errGetTagging)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        (lastETag == "" || lastETag == cr.Status.AtProvider.ETag) && IsUpToDate(cr.Spec.ForProvider, body, resp, tagging.TagSet),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Object)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+	return managed.ExternalCreation{}, e.put(ctx, cr)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Object)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	return managed.ExternalUpdate{}, e.put(ctx, cr)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Object)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	if aws.ToBool(cr.Spec.ForProvider.DeleteAllVersions) {
+		err := s3.DeleteObjectVersions(ctx, e.client, aws.ToString(cr.Spec.ForProvider.Bucket), cr.Spec.ForProvider.Key)
+		return awsclient.Wrap(resource.Ignore(s3.IsNoSuchBucket, err), errDelete)
+	}
+	_, err := e.client.DeleteObject(ctx, &awss3.DeleteObjectInput{
+		Bucket: cr.Spec.ForProvider.Bucket,
+		Key:    aws.String(cr.Spec.ForProvider.Key),
+	})
+	return awsclient.Wrap(resource.Ignore(s3.IsNoSuchBucket, err), errDelete)
+}
+
+// put uploads the desired content, metadata and tags of the Object in a
+// single request, since S3 cannot change the metadata of an object in place.
+func (e *external) put(ctx context.Context, cr *v1alpha1.Object) error {
+	body, err := e.content(ctx, cr.Spec.ForProvider.Content)
+	if err != nil {
+		return errors.Wrap(err, errContent)
+	}
+	resp, err := e.client.PutObject(ctx, GeneratePutObjectInput(cr.Spec.ForProvider, body))
+	if err != nil {
+		return awsclient.Wrap(err, errPut)
+	}
+	cr.Status.AtProvider = v1alpha1.ObjectObservation{
+		ETag:          aws.ToString(resp.ETag),
+		VersionID:     aws.ToString(resp.VersionId),
+		ContentSHA256: contentSHA256(body),
+	}
+	return nil
+}
+
+// content returns the object body from the single configured source.
+func (e *external) content(ctx context.Context, src v1alpha1.ObjectContentSource) ([]byte, error) {
+	set := 0
+	for _, s := range []bool{src.Inline != nil, src.Base64 != nil, src.SecretKeyRef != nil, src.ConfigMapKeyRef != nil} {
+		if s {
+			set++
+		}
+	}
+	if set != 1 {
+		return nil, errors.New(errContentSource)
+	}
+
+	switch {
+	case src.Inline != nil:
+		return []byte(*src.Inline), nil
+	case src.Base64 != nil:
+		b, err := base64.StdEncoding.DecodeString(*src.Base64)
+		return b, errors.Wrap(err, errDecodeBase64)
+	case src.SecretKeyRef != nil:
+		s := &corev1.Secret{}
+		nn := types.NamespacedName{Name: src.SecretKeyRef.Name, Namespace: src.SecretKeyRef.Namespace}
+		if err := e.kube.Get(ctx, nn, s); err != nil {
+			return nil, errors.Wrap(err, errGetSecret)
+		}
+		b, ok := s.Data[src.SecretKeyRef.Key]
+		if !ok {
+			return nil, errors.Errorf(errFmtKeyNotFound, src.SecretKeyRef.Key)
+		}
+		return b, nil
+	default:
+		cm := &corev1.ConfigMap{}
+		nn := types.NamespacedName{Name: src.ConfigMapKeyRef.Name, Namespace: src.ConfigMapKeyRef.Namespace}
+		if err := e.kube.Get(ctx, nn, cm); err != nil {
+			return nil, errors.Wrap(err, errGetConfigMap)
+		}
+		if s, ok := cm.Data[src.ConfigMapKeyRef.Key]; ok {
+			return []byte(s), nil
+		}
+		b, ok := cm.BinaryData[src.ConfigMapKeyRef.Key]
+		if !ok {
+			return nil, errors.Errorf(errFmtKeyNotFound, src.ConfigMapKeyRef.Key)
+		}
+		return b, nil
+	}
+}
+
+// GeneratePutObjectInput returns the input to upload the supplied body with
+// the metadata and tags of the Object.
+func GeneratePutObjectInput(p v1alpha1.ObjectParameters, body []byte) *awss3.PutObjectInput {
+	sum := md5.Sum(body) //nolint:gosec // S3 requires MD5 for the Content-MD5 header
+	in := &awss3.PutObjectInput{
+		Bucket:        p.Bucket,
+		Key:           aws.String(p.Key),
+		Body:          bytes.NewReader(body),
+		ContentLength: int64(len(body)),
+		ContentMD5:    aws.String(base64.StdEncoding.EncodeToString(sum[:])),
+		ContentType:   p.ContentType,
+		Metadata:      desiredMetadata(p.Metadata),
+		SSEKMSKeyId:   p.SSEKMSKeyID,
+	}
+	in.Metadata[contentSHA256MetadataKey] = contentSHA256(body)
+	if p.ServerSideEncryption != nil {
+		in.ServerSideEncryption = s3types.ServerSideEncryption(*p.ServerSideEncryption)
+	}
+	if len(p.Tags) != 0 {
+		tags := url.Values{}
+		for k, v := range p.Tags {
+			tags.Set(k, v)
+		}
+		in.Tagging = aws.String(tags.Encode())
+	}
+	return in
+}
+
+// IsUpToDate returns true if the stored object matches the desired body and
+// parameters. Optional parameters that are not set are not compared.
+func IsUpToDate(p v1alpha1.ObjectParameters, body []byte, head *awss3.HeadObjectOutput, tags []s3types.Tag) bool {
+	if head.Metadata[contentSHA256MetadataKey] != contentSHA256(body) {
+		return false
+	}
+	metadata := make(map[string]string, len(head.Metadata))
+	for k, v := range head.Metadata {
+		if k != contentSHA256MetadataKey {
+			metadata[k] = v
+		}
+	}
+	wantMetadata := desiredMetadata(p.Metadata)
+	if !cmp.Equal(wantMetadata, metadata, cmpopts.EquateEmpty()) {
+		return false
+	}
+	if p.ContentType != nil && *p.ContentType != aws.ToString(head.ContentType) {
+		return false
+	}
+	if p.ServerSideEncryption != nil && *p.ServerSideEncryption != string(head.ServerSideEncryption) {
+		return false
+	}
+	if p.SSEKMSKeyID != nil && !kmsKeyMatches(*p.SSEKMSKeyID, aws.ToString(head.SSEKMSKeyId)) {
+		return false
+	}
+	observed := make(map[string]string, len(tags))
+	for _, t := range tags {
+		observed[aws.ToString(t.Key)] = aws.ToString(t.Value)
+	}
+	return cmp.Equal(p.Tags, observed, cmpopts.EquateEmpty())
+}
+
+func lateInitialize(p *v1alpha1.ObjectParameters, head *awss3.HeadObjectOutput) {
+	p.ContentType = awsclient.LateInitializeStringPtr(p.ContentType, head.ContentType)
+	p.ServerSideEncryption = awsclient.LateInitializeStringPtr(p.ServerSideEncryption, awsclient.String(string(head.ServerSideEncryption)))
+}
+
+// desiredMetadata returns the user metadata with lower case keys, which is
+// how S3 returns them.
+func desiredMetadata(m map[string]string) map[string]string {
+	out := make(map[string]string, len(m)+1)
+	for k, v := range m {
+		out[strings.ToLower(k)] = v
+	}
+	return out
+}
+
+// kmsKeyMatches returns true if the desired key ID or ARN identifies the
+// observed key ARN.
+func kmsKeyMatches(want, got string) bool {
+	return want == got || strings.HasSuffix(got, ":key/"+want)
+}
+
+func contentSHA256(body []byte) string {
+	sum := sha256.Sum256(body)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,597 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-aws/apis/s3/v1alpha1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3/fake"
+)
+
+var (
+	// an arbitrary managed resource
+	unexpectedItem resource.Managed
+	bucketName     = "test.s3.crossplane.com"
+	key            = "config/app.yaml"
+	content        = "debug: true"
+	eTag           = `"etag"`
+	versionID      = "version"
+	kmsKeyARN      = "arn:aws:kms:us-east-1:123456789012:key/1234abcd"
+	errBoom        = errors.New("boom")
+)
+
+type args struct {
+	s3   s3.ObjectClient
+	kube client.Client
+	cr   resource.Managed
+}
+
+type objectModifier func(*v1alpha1.Object)
+
+func withConditions(c ...xpv1.Condition) objectModifier {
+	return func(r *v1alpha1.Object) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withContentType(s string) objectModifier {
+	return func(r *v1alpha1.Object) { r.Spec.ForProvider.ContentType = &s }
+}
+
+func withTags(t map[string]string) objectModifier {
+	return func(r *v1alpha1.Object) { r.Spec.ForProvider.Tags = t }
+}
+
+func withContent(c v1alpha1.ObjectContentSource) objectModifier {
+	return func(r *v1alpha1.Object) { r.Spec.ForProvider.Content = c }
+}
+
+func withDeleteAllVersions() objectModifier {
+	return func(r *v1alpha1.Object) { r.Spec.ForProvider.DeleteAllVersions = aws.Bool(true) }
+}
+
+func withAtProvider(o v1alpha1.ObjectObservation) objectModifier {
+	return func(r *v1alpha1.Object) { r.Status.AtProvider = o }
+}
+
+func object(m ...objectModifier) *v1alpha1.Object {
+	cr := &v1alpha1.Object{
+		Spec: v1alpha1.ObjectSpec{
+			ForProvider: v1alpha1.ObjectParameters{
+				Bucket:  &bucketName,
+				Key:     key,
+				Content: v1alpha1.ObjectContentSource{Inline: &content},
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func observation(body string) v1alpha1.ObjectObservation {
+	return v1alpha1.ObjectObservation{ETag: eTag, VersionID: versionID, ContentSHA256: contentSHA256([]byte(body))}
+}
+
+func headObject(body string) func(context.Context, *awss3.HeadObjectInput, []func(*awss3.Options)) (*awss3.HeadObjectOutput, error) {
+	return func(_ context.Context, _ *awss3.HeadObjectInput, _ []func(*awss3.Options)) (*awss3.HeadObjectOutput, error) {
+		return &awss3.HeadObjectOutput{
+			ETag:        aws.String(eTag),
+			VersionId:   aws.String(versionID),
+			ContentType: aws.String("text/yaml"),
+			Metadata:    map[string]string{contentSHA256MetadataKey: contentSHA256([]byte(body))},
+		}, nil
+	}
+}
+
+func getObjectTagging(tags ...s3types.Tag) func(context.Context, *awss3.GetObjectTaggingInput, []func(*awss3.Options)) (*awss3.GetObjectTaggingOutput, error) {
+	return func(_ context.Context, _ *awss3.GetObjectTaggingInput, _ []func(*awss3.Options)) (*awss3.GetObjectTaggingOutput, error) {
+		return &awss3.GetObjectTaggingOutput{TagSet: tags}, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ResourceDoesNotExist": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockHeadObject: func(ctx context.Context, input *awss3.HeadObjectInput, opts []func(*awss3.Options)) (*awss3.HeadObjectOutput, error) {
+						return nil, &s3types.NotFound{}
+					},
+				},
+				cr: object(),
+			},
+			want: want{
+				cr: object(),
+			},
+		},
+		"ClientError": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockHeadObject: func(ctx context.Context, input *awss3.HeadObjectInput, opts []func(*awss3.Options)) (*awss3.HeadObjectOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: object(),
+			},
+			want: want{
+				cr:  object(),
+				err: awsclient.Wrap(errBoom, errHead),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockHeadObject:       headObject(content),
+					MockGetObjectTagging: getObjectTagging(s3types.Tag{Key: aws.String("k"), Value: aws.String("v")}),
+				},
+				cr: object(withContentType("text/yaml"), withTags(map[string]string{"k": "v"}), withAtProvider(observation(content))),
+			},
+			want: want{
+				cr: object(withContentType("text/yaml"), withTags(map[string]string{"k": "v"}), withAtProvider(observation(content)),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitContentType": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockHeadObject:       headObject(content),
+					MockGetObjectTagging: getObjectTagging(),
+				},
+				cr: object(),
+			},
+			want: want{
+				cr: object(withContentType("text/yaml"), withAtProvider(observation(content)),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"ContentChanged": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockHeadObject:       headObject("debug: false"),
+					MockGetObjectTagging: getObjectTagging(),
+				},
+				cr: object(withContentType("text/yaml")),
+			},
+			want: want{
+				cr: object(withContentType("text/yaml"), withAtProvider(observation("debug: false")),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"OverwrittenOutOfBand": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockHeadObject:       headObject(content),
+					MockGetObjectTagging: getObjectTagging(),
+				},
+				cr: object(withContentType("text/yaml"), withAtProvider(v1alpha1.ObjectObservation{ETag: `"other"`})),
+			},
+			want: want{
+				cr: object(withContentType("text/yaml"), withAtProvider(observation(content)),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"TagsChanged": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockHeadObject:       headObject(content),
+					MockGetObjectTagging: getObjectTagging(s3types.Tag{Key: aws.String("k"), Value: aws.String("old")}),
+				},
+				cr: object(withContentType("text/yaml"), withTags(map[string]string{"k": "v"})),
+			},
+			want: want{
+				cr: object(withContentType("text/yaml"), withTags(map[string]string{"k": "v"}), withAtProvider(observation(content)),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"SecretContent": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockHeadObject:       headObject(content),
+					MockGetObjectTagging: getObjectTagging(),
+				},
+				kube: &test.MockClient{
+					MockGet: func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+						obj.(*corev1.Secret).Data = map[string][]byte{"config": []byte(content)}
+						return nil
+					},
+				},
+				cr: object(withContentType("text/yaml"), withContent(v1alpha1.ObjectContentSource{
+					SecretKeyRef: &xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Name: "s", Namespace: "ns"}, Key: "config"},
+				})),
+			},
+			want: want{
+				cr: object(withContentType("text/yaml"), withAtProvider(observation(content)), withContent(v1alpha1.ObjectContentSource{
+					SecretKeyRef: &xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Name: "s", Namespace: "ns"}, Key: "config"},
+				}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"ConfigMapKeyNotFound": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockHeadObject: headObject(content),
+				},
+				kube: &test.MockClient{
+					MockGet: func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+						obj.(*corev1.ConfigMap).Data = map[string]string{"other": content}
+						return nil
+					},
+				},
+				cr: object(withContentType("text/yaml"), withContent(v1alpha1.ObjectContentSource{
+					ConfigMapKeyRef: &v1alpha1.ConfigMapKeySelector{Name: "cm", Namespace: "ns", Key: "config"},
+				})),
+			},
+			want: want{
+				cr: object(withContentType("text/yaml"), withAtProvider(observation(content)), withContent(v1alpha1.ObjectContentSource{
+					ConfigMapKeyRef: &v1alpha1.ConfigMapKeySelector{Name: "cm", Namespace: "ns", Key: "config"},
+				}), withConditions(xpv1.Available())),
+				err: errors.Wrap(errors.Errorf(errFmtKeyNotFound, "config"), errContent),
+			},
+		},
+		"AmbiguousContent": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockHeadObject: headObject(content),
+				},
+				cr: object(withContentType("text/yaml"), withContent(v1alpha1.ObjectContentSource{Inline: &content, Base64: &content})),
+			},
+			want: want{
+				cr: object(withContentType("text/yaml"), withAtProvider(observation(content)),
+					withContent(v1alpha1.ObjectContentSource{Inline: &content, Base64: &content}), withConditions(xpv1.Available())),
+				err: errors.Wrap(errors.New(errContentSource), errContent),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.s3, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockPutObject: func(ctx context.Context, input *awss3.PutObjectInput, opts []func(*awss3.Options)) (*awss3.PutObjectOutput, error) {
+						if diff := cmp.Diff(map[string]string{"team": "a", contentSHA256MetadataKey: contentSHA256([]byte(content))}, input.Metadata); diff != "" {
+							return nil, errors.New(diff)
+						}
+						if diff := cmp.Diff("k=v", aws.ToString(input.Tagging)); diff != "" {
+							return nil, errors.New(diff)
+						}
+						return &awss3.PutObjectOutput{ETag: aws.String(eTag), VersionId: aws.String(versionID)}, nil
+					},
+				},
+				cr: object(withTags(map[string]string{"k": "v"}), func(o *v1alpha1.Object) {
+					o.Spec.ForProvider.Metadata = map[string]string{"Team": "a"}
+				}),
+			},
+			want: want{
+				cr: object(withTags(map[string]string{"k": "v"}), func(o *v1alpha1.Object) {
+					o.Spec.ForProvider.Metadata = map[string]string{"Team": "a"}
+				}, withAtProvider(observation(content)), withConditions(xpv1.Creating())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockPutObject: func(ctx context.Context, input *awss3.PutObjectInput, opts []func(*awss3.Options)) (*awss3.PutObjectOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: object(),
+			},
+			want: want{
+				cr:  object(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errPut),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.s3, kube: tc.kube}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockPutObject: func(ctx context.Context, input *awss3.PutObjectInput, opts []func(*awss3.Options)) (*awss3.PutObjectOutput, error) {
+						return &awss3.PutObjectOutput{ETag: aws.String(eTag), VersionId: aws.String(versionID)}, nil
+					},
+				},
+				cr: object(withAtProvider(v1alpha1.ObjectObservation{ETag: `"other"`})),
+			},
+			want: want{
+				cr: object(withAtProvider(observation(content))),
+			},
+		},
+		"InvalidBase64": {
+			args: args{
+				cr: object(withContent(v1alpha1.ObjectContentSource{Base64: aws.String("%")})),
+			},
+			want: want{
+				cr:  object(withContent(v1alpha1.ObjectContentSource{Base64: aws.String("%")})),
+				err: errors.Wrap(errors.Wrap(errors.New("illegal base64 data at input byte 0"), errDecodeBase64), errContent),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.s3, kube: tc.kube}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockDeleteObject: func(ctx context.Context, input *awss3.DeleteObjectInput, opts []func(*awss3.Options)) (*awss3.DeleteObjectOutput, error) {
+						return &awss3.DeleteObjectOutput{}, nil
+					},
+				},
+				cr: object(),
+			},
+			want: want{
+				cr: object(withConditions(xpv1.Deleting())),
+			},
+		},
+		"BucketGone": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockDeleteObject: func(ctx context.Context, input *awss3.DeleteObjectInput, opts []func(*awss3.Options)) (*awss3.DeleteObjectOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: s3.NoSuchBucketErrCode}
+					},
+				},
+				cr: object(),
+			},
+			want: want{
+				cr: object(withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteAllVersions": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockListObjectVersions: func(ctx context.Context, input *awss3.ListObjectVersionsInput, opts []func(*awss3.Options)) (*awss3.ListObjectVersionsOutput, error) {
+						if input.KeyMarker == nil {
+							return &awss3.ListObjectVersionsOutput{
+								Versions: []s3types.ObjectVersion{
+									{Key: aws.String(key), VersionId: aws.String("v1")},
+									{Key: aws.String(key + ".bak"), VersionId: aws.String("v2")},
+								},
+								IsTruncated:         true,
+								NextKeyMarker:       aws.String(key),
+								NextVersionIdMarker: aws.String("v1"),
+							}, nil
+						}
+						return &awss3.ListObjectVersionsOutput{
+							DeleteMarkers: []s3types.DeleteMarkerEntry{{Key: aws.String(key), VersionId: aws.String("v3")}},
+						}, nil
+					},
+					MockDeleteObjects: func(ctx context.Context, input *awss3.DeleteObjectsInput, opts []func(*awss3.Options)) (*awss3.DeleteObjectsOutput, error) {
+						for _, o := range input.Delete.Objects {
+							if aws.ToString(o.Key) != key {
+								return nil, errors.Errorf("unexpected key %s", aws.ToString(o.Key))
+							}
+						}
+						return &awss3.DeleteObjectsOutput{}, nil
+					},
+				},
+				cr: object(withDeleteAllVersions()),
+			},
+			want: want{
+				cr: object(withDeleteAllVersions(), withConditions(xpv1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockDeleteObject: func(ctx context.Context, input *awss3.DeleteObjectInput, opts []func(*awss3.Options)) (*awss3.DeleteObjectOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: object(),
+			},
+			want: want{
+				cr:  object(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.s3, kube: tc.kube}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	body := []byte(content)
+	head := func(m ...func(*awss3.HeadObjectOutput)) *awss3.HeadObjectOutput {
+		h := &awss3.HeadObjectOutput{
+			ServerSideEncryption: s3types.ServerSideEncryptionAwsKms,
+			SSEKMSKeyId:          aws.String(kmsKeyARN),
+			Metadata:             map[string]string{"team": "a", contentSHA256MetadataKey: contentSHA256(body)},
+		}
+		for _, f := range m {
+			f(h)
+		}
+		return h
+	}
+
+	cases := map[string]struct {
+		p    v1alpha1.ObjectParameters
+		head *awss3.HeadObjectOutput
+		want bool
+	}{
+		"KMSKeyIDMatchesARN": {
+			p:    v1alpha1.ObjectParameters{Metadata: map[string]string{"Team": "a"}, ServerSideEncryption: aws.String("aws:kms"), SSEKMSKeyID: aws.String("1234abcd")},
+			head: head(),
+			want: true,
+		},
+		"KMSKeyChanged": {
+			p:    v1alpha1.ObjectParameters{Metadata: map[string]string{"Team": "a"}, SSEKMSKeyID: aws.String("5678efgh")},
+			head: head(),
+			want: false,
+		},
+		"MetadataChanged": {
+			p:    v1alpha1.ObjectParameters{Metadata: map[string]string{"team": "b"}},
+			head: head(),
+			want: false,
+		},
+		"ChecksumMissing": {
+			p:    v1alpha1.ObjectParameters{Metadata: map[string]string{"team": "a"}},
+			head: head(func(h *awss3.HeadObjectOutput) { delete(h.Metadata, contentSHA256MetadataKey) }),
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsUpToDate(tc.p, body, tc.head, nil)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
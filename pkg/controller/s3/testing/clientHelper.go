@@ -54,6 +54,21 @@ func Client(m ...ClientModifier) *fake.MockBucketClient {
 		MockGetBucketWebsite: func(ctx context.Context, input *awss3.GetBucketWebsiteInput, opts []func(*awss3.Options)) (*awss3.GetBucketWebsiteOutput, error) {
 			return nil, &smithy.GenericAPIError{Code: clients3.WebsiteNotFoundErrCode}
 		},
+		MockListBucketIntelligentTieringConfigurations: func(ctx context.Context, input *awss3.ListBucketIntelligentTieringConfigurationsInput, opts []func(*awss3.Options)) (*awss3.ListBucketIntelligentTieringConfigurationsOutput, error) {
+			return &awss3.ListBucketIntelligentTieringConfigurationsOutput{}, nil
+		},
+		MockListBucketInventoryConfigurations: func(ctx context.Context, input *awss3.ListBucketInventoryConfigurationsInput, opts []func(*awss3.Options)) (*awss3.ListBucketInventoryConfigurationsOutput, error) {
+			return &awss3.ListBucketInventoryConfigurationsOutput{}, nil
+		},
+		MockListBucketAnalyticsConfigurations: func(ctx context.Context, input *awss3.ListBucketAnalyticsConfigurationsInput, opts []func(*awss3.Options)) (*awss3.ListBucketAnalyticsConfigurationsOutput, error) {
+			return &awss3.ListBucketAnalyticsConfigurationsOutput{}, nil
+		},
+		MockListBucketMetricsConfigurations: func(ctx context.Context, input *awss3.ListBucketMetricsConfigurationsInput, opts []func(*awss3.Options)) (*awss3.ListBucketMetricsConfigurationsOutput, error) {
+			return &awss3.ListBucketMetricsConfigurationsOutput{}, nil
+		},
+		MockGetObjectLockConfiguration: func(ctx context.Context, input *awss3.GetObjectLockConfigurationInput, opts []func(*awss3.Options)) (*awss3.GetObjectLockConfigurationOutput, error) {
+			return nil, &smithy.GenericAPIError{Code: clients3.ObjectLockNotFoundErrCode}
+		},
 		MockPutBucketAcl: func(ctx context.Context, input *awss3.PutBucketAclInput, opts []func(*awss3.Options)) (*awss3.PutBucketAclOutput, error) {
 			return &awss3.PutBucketAclOutput{}, nil
 		},
@@ -109,6 +109,31 @@ func WithNotificationConfig(s *v1beta1.NotificationConfiguration) BucketModifier
 	return func(r *v1beta1.Bucket) { r.Spec.ForProvider.NotificationConfiguration = s }
 }
 
+// WithIntelligentTieringConfigs sets the IntelligentTieringConfigurations for an S3 Bucket
+func WithIntelligentTieringConfigs(s ...v1beta1.IntelligentTieringConfiguration) BucketModifier {
+	return func(r *v1beta1.Bucket) { r.Spec.ForProvider.IntelligentTieringConfigurations = s }
+}
+
+// WithInventoryConfigs sets the InventoryConfigurations for an S3 Bucket
+func WithInventoryConfigs(s ...v1beta1.InventoryConfiguration) BucketModifier {
+	return func(r *v1beta1.Bucket) { r.Spec.ForProvider.InventoryConfigurations = s }
+}
+
+// WithAnalyticsConfigs sets the AnalyticsConfigurations for an S3 Bucket
+func WithAnalyticsConfigs(s ...v1beta1.AnalyticsConfiguration) BucketModifier {
+	return func(r *v1beta1.Bucket) { r.Spec.ForProvider.AnalyticsConfigurations = s }
+}
+
+// WithMetricsConfigs sets the MetricsConfigurations for an S3 Bucket
+func WithMetricsConfigs(s ...v1beta1.MetricsConfiguration) BucketModifier {
+	return func(r *v1beta1.Bucket) { r.Spec.ForProvider.MetricsConfigurations = s }
+}
+
+// WithObjectLockConfig sets the ObjectLockConfiguration for an S3 Bucket
+func WithObjectLockConfig(s *v1beta1.ObjectLockConfiguration) BucketModifier {
+	return func(r *v1beta1.Bucket) { r.Spec.ForProvider.ObjectLockConfiguration = s }
+}
+
 // WithPolicy sets the policy for an S3 Bucket
 func WithPolicy(s *common.BucketPolicyBody) BucketModifier {
 	return func(r *v1beta1.Bucket) { r.Spec.ForProvider.Policy = s }
@@ -119,6 +144,11 @@ func WithPolicyUpdatePolicy(s *v1beta1.BucketPolicyUpdatePolicy) BucketModifier
 	return func(r *v1beta1.Bucket) { r.Spec.ForProvider.PolicyUpdatePolicy = s }
 }
 
+// WithForceDestroy sets ForceDestroy for an S3 Bucket.
+func WithForceDestroy(forceDestroy bool) BucketModifier {
+	return func(r *v1beta1.Bucket) { r.Spec.ForProvider.ForceDestroy = &forceDestroy }
+}
+
 // Bucket creates a v1beta1 Bucket for use in testing
 func Bucket(m ...BucketModifier) *v1beta1.Bucket {
 	cr := &v1beta1.Bucket{
@@ -0,0 +1,411 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multiregionaccesspoint
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	svcsdk "github.com/aws/aws-sdk-go/service/s3control"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/s3control/s3controliface"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/s3control/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a MultiRegionAccessPoint resource"
+
+	errCreateSession      = "cannot create a new session"
+	errCreate             = "cannot create MultiRegionAccessPoint in AWS"
+	errDescribe           = "failed to describe MultiRegionAccessPoint"
+	errDelete             = "failed to delete MultiRegionAccessPoint"
+	errDescribeOperation  = "failed to describe MultiRegionAccessPoint operation"
+	errOperationFailedFmt = "%s request %s failed: %s: %s"
+	errDescribePolicy     = "failed to describe MultiRegionAccessPoint policy"
+	errPutPolicy          = "failed to put MultiRegionAccessPoint policy"
+	errFormatPolicy       = "failed to format MultiRegionAccessPoint policy"
+	errKubeUpdateFailed   = "cannot update MultiRegionAccessPoint custom resource"
+)
+
+const (
+	// controlPlaneRegion is the only Region that serves the control plane
+	// requests of Multi-Region Access Points.
+	controlPlaneRegion = "us-west-2"
+
+	operationSucceeded = "SUCCEEDED"
+	operationFailed    = "FAILED"
+)
+
+// SetupMultiRegionAccessPoint adds a controller that reconciles
+// MultiRegionAccessPoints.
+func SetupMultiRegionAccessPoint(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.MultiRegionAccessPointGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient()}),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(svcapitypes.MultiRegionAccessPointGroupVersionKind),
+		reconcilerOpts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&svcapitypes.MultiRegionAccessPoint{}).
+		Complete(r)
+}
+
+type connector struct {
+	kube client.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	if _, ok := mg.(*svcapitypes.MultiRegionAccessPoint); !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, controlPlaneRegion)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{kube: c.kube, client: svcsdk.New(sess)}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client svcsdkapi.S3ControlAPI
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*svcapitypes.MultiRegionAccessPoint)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	pending, err := e.observeOperation(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	resp, err := e.client.GetMultiRegionAccessPointWithContext(ctx, &svcsdk.GetMultiRegionAccessPointInput{
+		AccountId: cr.Spec.ForProvider.AccountID,
+		Name:      awsclient.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		// A Multi-Region Access Point only shows up once its asynchronous
+		// creation was picked up.
+		if isNotFound(err) && pending == svcsdk.AsyncOperationNameCreateMultiRegionAccessPoint {
+			cr.SetConditions(xpv1.Creating())
+			return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+		}
+		if isNotFound(err) {
+			return managed.ExternalObservation{}, nil
+		}
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errDescribe)
+	}
+
+	token := cr.Status.AtProvider.RequestTokenARN
+	cr.Status.AtProvider = GenerateObservation(resp.AccessPoint, cr.Spec.ForProvider.AccountID)
+	cr.Status.AtProvider.RequestTokenARN = token
+
+	status := awsclient.StringValue(resp.AccessPoint.Status)
+	switch status {
+	case svcsdk.MultiRegionAccessPointStatusReady:
+		cr.SetConditions(xpv1.Available())
+	case svcsdk.MultiRegionAccessPointStatusCreating:
+		cr.SetConditions(xpv1.Creating())
+	case svcsdk.MultiRegionAccessPointStatusDeleting:
+		cr.SetConditions(xpv1.Deleting())
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
+	// The policy can only be compared once no other request is in flight.
+	if pending != "" || status != svcsdk.MultiRegionAccessPointStatusReady {
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+
+	upToDate, err := e.isPolicyUpToDate(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+// observeOperation checks the asynchronous request recorded in the
+// annotations and returns the name of its operation while it is still in
+// progress. The recorded request is forgotten once it finished, and an error
+// is returned if it failed.
+func (e *external) observeOperation(ctx context.Context, cr *svcapitypes.MultiRegionAccessPoint) (string, error) {
+	token, ok := cr.GetAnnotations()[svcapitypes.AnnotationKeyRequestToken]
+	if !ok {
+		cr.Status.AtProvider.RequestTokenARN = nil
+		return "", nil
+	}
+	resp, err := e.client.DescribeMultiRegionAccessPointOperationWithContext(ctx, &svcsdk.DescribeMultiRegionAccessPointOperationInput{
+		AccountId:       cr.Spec.ForProvider.AccountID,
+		RequestTokenARN: awsclient.String(token),
+	})
+	if err != nil {
+		return "", awsclient.Wrap(err, errDescribeOperation)
+	}
+	op := resp.AsyncOperation
+	status := ""
+	if op != nil {
+		status = awsclient.StringValue(op.RequestStatus)
+	}
+	if op != nil && status != operationSucceeded && status != operationFailed {
+		cr.Status.AtProvider.RequestTokenARN = awsclient.String(token)
+		return awsclient.StringValue(op.Operation), nil
+	}
+	if err := e.recordRequestToken(ctx, cr, nil); err != nil {
+		return "", err
+	}
+	if status == operationFailed {
+		code, msg := "", ""
+		if op.ResponseDetails != nil && op.ResponseDetails.ErrorDetails != nil {
+			code = awsclient.StringValue(op.ResponseDetails.ErrorDetails.Code)
+			msg = awsclient.StringValue(op.ResponseDetails.ErrorDetails.Message)
+		}
+		return "", errors.Errorf(errOperationFailedFmt, awsclient.StringValue(op.Operation), token, code, msg)
+	}
+	return "", nil
+}
+
+// recordRequestToken records the supplied request token in the annotations
+// and the status, or forgets the recorded one if it is nil, and persists the
+// annotations.
+func (e *external) recordRequestToken(ctx context.Context, cr *svcapitypes.MultiRegionAccessPoint, token *string) error {
+	if token == nil {
+		meta.RemoveAnnotations(cr, svcapitypes.AnnotationKeyRequestToken)
+	} else {
+		meta.AddAnnotations(cr, map[string]string{svcapitypes.AnnotationKeyRequestToken: *token})
+	}
+	// Updating the object resets its status to the persisted one.
+	status := cr.Status.DeepCopy()
+	if err := e.kube.Update(ctx, cr); err != nil {
+		return errors.Wrap(err, errKubeUpdateFailed)
+	}
+	cr.Status = *status
+	cr.Status.AtProvider.RequestTokenARN = token
+	return nil
+}
+
+// pendingRequest returns whether an asynchronous request is in progress.
+func pendingRequest(cr *svcapitypes.MultiRegionAccessPoint) bool {
+	_, ok := cr.GetAnnotations()[svcapitypes.AnnotationKeyRequestToken]
+	return ok
+}
+
+func (e *external) isPolicyUpToDate(ctx context.Context, cr *svcapitypes.MultiRegionAccessPoint) (bool, error) {
+	// There is no API to remove the policy of a Multi-Region Access Point,
+	// so an unset policy is not managed.
+	if cr.Spec.ForProvider.Policy == nil {
+		return true, nil
+	}
+	resp, err := e.client.GetMultiRegionAccessPointPolicyWithContext(ctx, &svcsdk.GetMultiRegionAccessPointPolicyInput{
+		AccountId: cr.Spec.ForProvider.AccountID,
+		Name:      awsclient.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return false, awsclient.Wrap(resource.Ignore(isPolicyNotFound, err), errDescribePolicy)
+	}
+	current := currentPolicy(resp.Policy)
+	if current == nil {
+		return false, nil
+	}
+	diff, err := s3.DiffParsedPolicies(cr.Spec.ForProvider.Policy, current)
+	return diff == "", err
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*svcapitypes.MultiRegionAccessPoint)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	resp, err := e.client.CreateMultiRegionAccessPointWithContext(ctx, GenerateCreateMultiRegionAccessPointInput(meta.GetExternalName(cr), cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+	// The annotations are persisted by the managed reconciler once the
+	// creation succeeded, while the status is not.
+	if resp.RequestTokenARN != nil {
+		meta.AddAnnotations(cr, map[string]string{svcapitypes.AnnotationKeyRequestToken: *resp.RequestTokenARN})
+	}
+	cr.Status.AtProvider.RequestTokenARN = resp.RequestTokenARN
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*svcapitypes.MultiRegionAccessPoint)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	if pendingRequest(cr) || cr.Spec.ForProvider.Policy == nil {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	policy, err := s3.FormatPolicy(cr.Spec.ForProvider.Policy)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errFormatPolicy)
+	}
+	resp, err := e.client.PutMultiRegionAccessPointPolicyWithContext(ctx, &svcsdk.PutMultiRegionAccessPointPolicyInput{
+		AccountId: cr.Spec.ForProvider.AccountID,
+		Details: &svcsdk.PutMultiRegionAccessPointPolicyInput_{
+			Name:   awsclient.String(meta.GetExternalName(cr)),
+			Policy: policy,
+		},
+	})
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errPutPolicy)
+	}
+	return managed.ExternalUpdate{}, e.recordRequestToken(ctx, cr, resp.RequestTokenARN)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*svcapitypes.MultiRegionAccessPoint)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	// Wait for the request in flight, which may be the deletion itself.
+	if pendingRequest(cr) {
+		return nil
+	}
+	resp, err := e.client.DeleteMultiRegionAccessPointWithContext(ctx, &svcsdk.DeleteMultiRegionAccessPointInput{
+		AccountId: cr.Spec.ForProvider.AccountID,
+		Details: &svcsdk.DeleteMultiRegionAccessPointInput_{
+			Name: awsclient.String(meta.GetExternalName(cr)),
+		},
+	})
+	if err != nil {
+		return awsclient.Wrap(resource.Ignore(isNotFound, err), errDelete)
+	}
+	return e.recordRequestToken(ctx, cr, resp.RequestTokenARN)
+}
+
+// GenerateCreateMultiRegionAccessPointInput returns a create input.
+func GenerateCreateMultiRegionAccessPointInput(name string, p svcapitypes.MultiRegionAccessPointParameters) *svcsdk.CreateMultiRegionAccessPointInput {
+	details := &svcsdk.CreateMultiRegionAccessPointInput_{
+		Name:    awsclient.String(name),
+		Regions: make([]*svcsdk.Region, len(p.Regions)),
+	}
+	for i, r := range p.Regions {
+		details.Regions[i] = &svcsdk.Region{
+			Bucket:          r.Bucket,
+			BucketAccountId: r.BucketAccountID,
+		}
+	}
+	if pab := p.PublicAccessBlockConfiguration; pab != nil {
+		details.PublicAccessBlock = &svcsdk.PublicAccessBlockConfiguration{
+			BlockPublicAcls:       pab.BlockPublicACLs,
+			BlockPublicPolicy:     pab.BlockPublicPolicy,
+			IgnorePublicAcls:      pab.IgnorePublicACLs,
+			RestrictPublicBuckets: pab.RestrictPublicBuckets,
+		}
+	}
+	return &svcsdk.CreateMultiRegionAccessPointInput{
+		AccountId: p.AccountID,
+		Details:   details,
+	}
+}
+
+// GenerateObservation returns the observation of the supplied report.
+func GenerateObservation(r *svcsdk.MultiRegionAccessPointReport, accountID *string) svcapitypes.MultiRegionAccessPointObservation {
+	if r == nil {
+		return svcapitypes.MultiRegionAccessPointObservation{}
+	}
+	o := svcapitypes.MultiRegionAccessPointObservation{
+		Alias:  r.Alias,
+		Status: r.Status,
+	}
+	if r.Alias != nil {
+		o.ARN = awsclient.String("arn:aws:s3::" + awsclient.StringValue(accountID) + ":accesspoint/" + *r.Alias)
+	}
+	for _, rr := range r.Regions {
+		o.Regions = append(o.Regions, svcapitypes.MultiRegionAccessPointRegionObservation{
+			Bucket: rr.Bucket,
+			Region: rr.Region,
+		})
+	}
+	return o
+}
+
+// currentPolicy returns the most recent policy of the supplied document. A
+// proposed policy is one that is not yet in effect in all Regions.
+func currentPolicy(doc *svcsdk.MultiRegionAccessPointPolicyDocument) *string {
+	if doc == nil {
+		return nil
+	}
+	if doc.Proposed != nil && doc.Proposed.Policy != nil {
+		return doc.Proposed.Policy
+	}
+	if doc.Established != nil {
+		return doc.Established.Policy
+	}
+	return nil
+}
+
+// isNotFound returns whether the given error is of type NotFound or not.
+func isNotFound(err error) bool {
+	awsErr, ok := err.(awserr.Error) //nolint:errorlint
+	return ok && awsErr.Code() == "NoSuchMultiRegionAccessPoint"
+}
+
+// isPolicyNotFound returns whether the given error reports a missing policy.
+func isPolicyNotFound(err error) bool {
+	awsErr, ok := err.(awserr.Error) //nolint:errorlint
+	return ok && awsErr.Code() == "NoSuchMultiRegionAccessPointPolicy"
+}
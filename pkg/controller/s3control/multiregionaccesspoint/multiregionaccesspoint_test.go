@@ -0,0 +1,568 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multiregionaccesspoint
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	svcsdk "github.com/aws/aws-sdk-go/service/s3control"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-aws/apis/s3/common"
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/s3control/v1alpha1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3control/fake"
+)
+
+var (
+	errBoom = errors.New("boom")
+
+	accountID = "123456789012"
+	testName  = "test-mrap"
+	alias     = "mfzwi23gnjvgw.mrap"
+	token     = "arn:aws:s3:us-west-2:123456789012:async-request/mrap/create/abc"
+
+	testPolicy = &common.BucketPolicyBody{
+		Version: "2012-10-17",
+		Statements: []common.BucketPolicyStatement{
+			{
+				Effect: "Allow",
+				Principal: &common.BucketPrincipal{
+					AWSPrincipals: []common.AWSPrincipal{
+						{IAMRoleARN: awsclient.String("arn:aws:iam::123456789012:role/reader")},
+					},
+				},
+				Action:   []string{"s3:GetObject"},
+				Resource: []string{"arn:aws:s3::123456789012:accesspoint/mfzwi23gnjvgw.mrap/object/*"},
+			},
+		},
+	}
+	testPolicyRaw  = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:role/reader"},"Action":"s3:GetObject","Resource":"arn:aws:s3::123456789012:accesspoint/mfzwi23gnjvgw.mrap/object/*"}]}`
+	otherPolicyRaw = `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Principal":{"AWS":"arn:aws:iam::123456789012:role/reader"},"Action":"s3:GetObject","Resource":"arn:aws:s3::123456789012:accesspoint/mfzwi23gnjvgw.mrap/object/*"}]}`
+)
+
+type mrapModifier func(*svcapitypes.MultiRegionAccessPoint)
+
+func withConditions(c ...xpv1.Condition) mrapModifier {
+	return func(r *svcapitypes.MultiRegionAccessPoint) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withPolicy(p *common.BucketPolicyBody) mrapModifier {
+	return func(r *svcapitypes.MultiRegionAccessPoint) { r.Spec.ForProvider.Policy = p }
+}
+
+func withRequestToken(t string) mrapModifier {
+	return func(r *svcapitypes.MultiRegionAccessPoint) {
+		meta.AddAnnotations(r, map[string]string{svcapitypes.AnnotationKeyRequestToken: t})
+		r.Status.AtProvider.RequestTokenARN = awsclient.String(t)
+	}
+}
+
+func withStatus(s string) mrapModifier {
+	return func(r *svcapitypes.MultiRegionAccessPoint) {
+		r.Status.AtProvider.Alias = awsclient.String(alias)
+		r.Status.AtProvider.ARN = awsclient.String("arn:aws:s3::" + accountID + ":accesspoint/" + alias)
+		r.Status.AtProvider.Status = awsclient.String(s)
+		r.Status.AtProvider.Regions = []svcapitypes.MultiRegionAccessPointRegionObservation{
+			{Bucket: awsclient.String("bucket"), Region: awsclient.String("eu-west-1")},
+		}
+	}
+}
+
+func mrap(m ...mrapModifier) *svcapitypes.MultiRegionAccessPoint {
+	cr := &svcapitypes.MultiRegionAccessPoint{
+		Spec: svcapitypes.MultiRegionAccessPointSpec{
+			ForProvider: svcapitypes.MultiRegionAccessPointParameters{
+				AccountID: awsclient.String(accountID),
+				Regions: []svcapitypes.MultiRegionAccessPointRegion{
+					{Bucket: awsclient.String("bucket")},
+				},
+			},
+		},
+	}
+	meta.SetExternalName(cr, testName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func report(status string) svcsdk.GetMultiRegionAccessPointOutput {
+	return svcsdk.GetMultiRegionAccessPointOutput{
+		AccessPoint: &svcsdk.MultiRegionAccessPointReport{
+			Alias:  awsclient.String(alias),
+			Name:   awsclient.String(testName),
+			Status: awsclient.String(status),
+			Regions: []*svcsdk.RegionReport{
+				{Bucket: awsclient.String("bucket"), Region: awsclient.String("eu-west-1")},
+			},
+		},
+	}
+}
+
+func operation(op, status string) svcsdk.DescribeMultiRegionAccessPointOperationOutput {
+	return svcsdk.DescribeMultiRegionAccessPointOperationOutput{
+		AsyncOperation: &svcsdk.AsyncOperation{
+			Operation:     awsclient.String(op),
+			RequestStatus: awsclient.String(status),
+		},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type args struct {
+		client *fake.MockS3ControlClient
+		cr     *svcapitypes.MultiRegionAccessPoint
+	}
+	type want struct {
+		cr     *svcapitypes.MultiRegionAccessPoint
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NotFound": {
+			args: args{
+				client: &fake.MockS3ControlClient{
+					GetMultiRegionAccessPointWithContextErr: awserr.New("NoSuchMultiRegionAccessPoint", "", nil),
+				},
+				cr: mrap(),
+			},
+			want: want{
+				cr: mrap(),
+			},
+		},
+		"DescribeFailed": {
+			args: args{
+				client: &fake.MockS3ControlClient{
+					GetMultiRegionAccessPointWithContextErr: errBoom,
+				},
+				cr: mrap(),
+			},
+			want: want{
+				cr:  mrap(),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+		"CreationPending": {
+			args: args{
+				client: &fake.MockS3ControlClient{
+					DescribeMultiRegionAccessPointOperationWithContextOutput: operation(svcsdk.AsyncOperationNameCreateMultiRegionAccessPoint, "IN_PROGRESS"),
+					GetMultiRegionAccessPointWithContextErr:                  awserr.New("NoSuchMultiRegionAccessPoint", "", nil),
+				},
+				cr: mrap(withRequestToken(token)),
+			},
+			want: want{
+				cr: mrap(withRequestToken(token), withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"OperationFailed": {
+			args: args{
+				client: &fake.MockS3ControlClient{
+					DescribeMultiRegionAccessPointOperationWithContextOutput: svcsdk.DescribeMultiRegionAccessPointOperationOutput{
+						AsyncOperation: &svcsdk.AsyncOperation{
+							Operation:     awsclient.String(svcsdk.AsyncOperationNameCreateMultiRegionAccessPoint),
+							RequestStatus: awsclient.String(operationFailed),
+							ResponseDetails: &svcsdk.AsyncResponseDetails{
+								ErrorDetails: &svcsdk.AsyncErrorDetails{
+									Code:    awsclient.String("BucketNotFound"),
+									Message: awsclient.String("bucket does not exist"),
+								},
+							},
+						},
+					},
+				},
+				cr: mrap(withRequestToken(token)),
+			},
+			want: want{
+				cr:  mrap(),
+				err: errors.Errorf(errOperationFailedFmt, svcsdk.AsyncOperationNameCreateMultiRegionAccessPoint, token, "BucketNotFound", "bucket does not exist"),
+			},
+		},
+		"OperationSucceeded": {
+			args: args{
+				client: &fake.MockS3ControlClient{
+					DescribeMultiRegionAccessPointOperationWithContextOutput: operation(svcsdk.AsyncOperationNameCreateMultiRegionAccessPoint, operationSucceeded),
+					GetMultiRegionAccessPointWithContextOutput:               report(svcsdk.MultiRegionAccessPointStatusReady),
+				},
+				cr: mrap(withRequestToken(token)),
+			},
+			want: want{
+				cr: mrap(withStatus(svcsdk.MultiRegionAccessPointStatusReady), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Creating": {
+			args: args{
+				client: &fake.MockS3ControlClient{
+					GetMultiRegionAccessPointWithContextOutput: report(svcsdk.MultiRegionAccessPointStatusCreating),
+				},
+				cr: mrap(withPolicy(testPolicy)),
+			},
+			want: want{
+				cr: mrap(withPolicy(testPolicy), withStatus(svcsdk.MultiRegionAccessPointStatusCreating), withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"PolicyUpToDate": {
+			args: args{
+				client: &fake.MockS3ControlClient{
+					GetMultiRegionAccessPointWithContextOutput: report(svcsdk.MultiRegionAccessPointStatusReady),
+					GetMultiRegionAccessPointPolicyWithContextOutput: svcsdk.GetMultiRegionAccessPointPolicyOutput{
+						Policy: &svcsdk.MultiRegionAccessPointPolicyDocument{
+							Established: &svcsdk.EstablishedMultiRegionAccessPointPolicy{Policy: awsclient.String(otherPolicyRaw)},
+							Proposed:    &svcsdk.ProposedMultiRegionAccessPointPolicy{Policy: awsclient.String(testPolicyRaw)},
+						},
+					},
+				},
+				cr: mrap(withPolicy(testPolicy)),
+			},
+			want: want{
+				cr: mrap(withPolicy(testPolicy), withStatus(svcsdk.MultiRegionAccessPointStatusReady), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"PolicyNotUpToDate": {
+			args: args{
+				client: &fake.MockS3ControlClient{
+					GetMultiRegionAccessPointWithContextOutput: report(svcsdk.MultiRegionAccessPointStatusReady),
+					GetMultiRegionAccessPointPolicyWithContextOutput: svcsdk.GetMultiRegionAccessPointPolicyOutput{
+						Policy: &svcsdk.MultiRegionAccessPointPolicyDocument{
+							Established: &svcsdk.EstablishedMultiRegionAccessPointPolicy{Policy: awsclient.String(otherPolicyRaw)},
+						},
+					},
+				},
+				cr: mrap(withPolicy(testPolicy)),
+			},
+			want: want{
+				cr: mrap(withPolicy(testPolicy), withStatus(svcsdk.MultiRegionAccessPointStatusReady), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"PolicyNotFound": {
+			args: args{
+				client: &fake.MockS3ControlClient{
+					GetMultiRegionAccessPointWithContextOutput:    report(svcsdk.MultiRegionAccessPointStatusReady),
+					GetMultiRegionAccessPointPolicyWithContextErr: awserr.New("NoSuchMultiRegionAccessPointPolicy", "", nil),
+				},
+				cr: mrap(withPolicy(testPolicy)),
+			},
+			want: want{
+				cr: mrap(withPolicy(testPolicy), withStatus(svcsdk.MultiRegionAccessPointStatusReady), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)}, client: tc.args.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type args struct {
+		client *fake.MockS3ControlClient
+		cr     *svcapitypes.MultiRegionAccessPoint
+	}
+	type want struct {
+		cr  *svcapitypes.MultiRegionAccessPoint
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockS3ControlClient{
+					CreateMultiRegionAccessPointWithContextOutput: svcsdk.CreateMultiRegionAccessPointOutput{
+						RequestTokenARN: awsclient.String(token),
+					},
+				},
+				cr: mrap(),
+			},
+			want: want{
+				cr: mrap(withRequestToken(token), withConditions(xpv1.Creating())),
+			},
+		},
+		"Failed": {
+			args: args{
+				client: &fake.MockS3ControlClient{
+					CreateMultiRegionAccessPointWithContextErr: errBoom,
+				},
+				cr: mrap(),
+			},
+			want: want{
+				cr:  mrap(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)}, client: tc.args.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreateThenObserve(t *testing.T) {
+	client := &fake.MockS3ControlClient{
+		CreateMultiRegionAccessPointWithContextOutput: svcsdk.CreateMultiRegionAccessPointOutput{
+			RequestTokenARN: awsclient.String(token),
+		},
+		DescribeMultiRegionAccessPointOperationWithContextOutput: operation(svcsdk.AsyncOperationNameCreateMultiRegionAccessPoint, "IN_PROGRESS"),
+		GetMultiRegionAccessPointWithContextErr:                  awserr.New("NoSuchMultiRegionAccessPoint", "", nil),
+	}
+	e := &external{kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)}, client: client}
+
+	cr := mrap()
+	if _, err := e.Create(context.Background(), cr); err != nil {
+		t.Fatalf("Create(...): %s", err)
+	}
+
+	// The managed reconciler persists the annotations, but not the status,
+	// set while creating.
+	saved := mrap()
+	saved.SetAnnotations(cr.GetAnnotations())
+
+	o, err := e.Observe(context.Background(), saved)
+	if err != nil {
+		t.Fatalf("Observe(...): %s", err)
+	}
+	want := managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}
+	if diff := cmp.Diff(want, o); diff != "" {
+		t.Errorf("Observe(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(mrap(withRequestToken(token), withConditions(xpv1.Creating())), saved, test.EquateConditions()); diff != "" {
+		t.Errorf("Observe(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type args struct {
+		client *fake.MockS3ControlClient
+		cr     *svcapitypes.MultiRegionAccessPoint
+	}
+	type want struct {
+		cr  *svcapitypes.MultiRegionAccessPoint
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"PutPolicy": {
+			args: args{
+				client: &fake.MockS3ControlClient{
+					PutMultiRegionAccessPointPolicyWithContextOutput: svcsdk.PutMultiRegionAccessPointPolicyOutput{
+						RequestTokenARN: awsclient.String(token),
+					},
+				},
+				cr: mrap(withPolicy(testPolicy)),
+			},
+			want: want{
+				cr: mrap(withPolicy(testPolicy), withRequestToken(token)),
+			},
+		},
+		"RequestPending": {
+			args: args{
+				client: &fake.MockS3ControlClient{
+					PutMultiRegionAccessPointPolicyWithContextErr: errBoom,
+				},
+				cr: mrap(withPolicy(testPolicy), withRequestToken(token)),
+			},
+			want: want{
+				cr: mrap(withPolicy(testPolicy), withRequestToken(token)),
+			},
+		},
+		"PutPolicyFailed": {
+			args: args{
+				client: &fake.MockS3ControlClient{
+					PutMultiRegionAccessPointPolicyWithContextErr: errBoom,
+				},
+				cr: mrap(withPolicy(testPolicy)),
+			},
+			want: want{
+				cr:  mrap(withPolicy(testPolicy)),
+				err: awsclient.Wrap(errBoom, errPutPolicy),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)}, client: tc.args.client}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type args struct {
+		client *fake.MockS3ControlClient
+		kube   *test.MockClient
+		cr     *svcapitypes.MultiRegionAccessPoint
+	}
+	type want struct {
+		cr  *svcapitypes.MultiRegionAccessPoint
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockS3ControlClient{
+					DeleteMultiRegionAccessPointWithContextOutput: svcsdk.DeleteMultiRegionAccessPointOutput{
+						RequestTokenARN: awsclient.String(token),
+					},
+				},
+				cr: mrap(),
+			},
+			want: want{
+				cr: mrap(withRequestToken(token), withConditions(xpv1.Deleting())),
+			},
+		},
+		"RequestPending": {
+			args: args{
+				client: &fake.MockS3ControlClient{
+					DeleteMultiRegionAccessPointWithContextErr: errBoom,
+				},
+				cr: mrap(withRequestToken(token)),
+			},
+			want: want{
+				cr: mrap(withRequestToken(token), withConditions(xpv1.Deleting())),
+			},
+		},
+		"RecordTokenFailed": {
+			args: args{
+				client: &fake.MockS3ControlClient{
+					DeleteMultiRegionAccessPointWithContextOutput: svcsdk.DeleteMultiRegionAccessPointOutput{
+						RequestTokenARN: awsclient.String(token),
+					},
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+				cr:   mrap(),
+			},
+			want: want{
+				cr: mrap(withConditions(xpv1.Deleting()), func(r *svcapitypes.MultiRegionAccessPoint) {
+					meta.AddAnnotations(r, map[string]string{svcapitypes.AnnotationKeyRequestToken: token})
+				}),
+				err: errors.Wrap(errBoom, errKubeUpdateFailed),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				client: &fake.MockS3ControlClient{
+					DeleteMultiRegionAccessPointWithContextErr: awserr.New("NoSuchMultiRegionAccessPoint", "", nil),
+				},
+				cr: mrap(),
+			},
+			want: want{
+				cr: mrap(withConditions(xpv1.Deleting())),
+			},
+		},
+		"Failed": {
+			args: args{
+				client: &fake.MockS3ControlClient{
+					DeleteMultiRegionAccessPointWithContextErr: errBoom,
+				},
+				cr: mrap(),
+			},
+			want: want{
+				cr:  mrap(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			kube := tc.args.kube
+			if kube == nil {
+				kube = &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)}
+			}
+			e := &external{kube: kube, client: tc.args.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
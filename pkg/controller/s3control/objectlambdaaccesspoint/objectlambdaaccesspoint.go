@@ -0,0 +1,352 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package objectlambdaaccesspoint
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	svcsdk "github.com/aws/aws-sdk-go/service/s3control"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/s3control/s3controliface"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/s3control/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not an ObjectLambdaAccessPoint resource"
+
+	errCreateSession         = "cannot create a new session"
+	errCreate                = "cannot create ObjectLambdaAccessPoint in AWS"
+	errDescribe              = "failed to describe ObjectLambdaAccessPoint"
+	errDelete                = "failed to delete ObjectLambdaAccessPoint"
+	errDescribeConfiguration = "failed to describe ObjectLambdaAccessPoint configuration"
+	errPutConfiguration      = "failed to put ObjectLambdaAccessPoint configuration"
+	errDescribePolicy        = "failed to describe ObjectLambdaAccessPoint policy"
+	errPutPolicy             = "failed to put ObjectLambdaAccessPoint policy"
+	errDeletePolicy          = "failed to delete ObjectLambdaAccessPoint policy"
+	errFormatPolicy          = "failed to format ObjectLambdaAccessPoint policy"
+)
+
+// SetupObjectLambdaAccessPoint adds a controller that reconciles
+// ObjectLambdaAccessPoints.
+func SetupObjectLambdaAccessPoint(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.ObjectLambdaAccessPointGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient()}),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(svcapitypes.ObjectLambdaAccessPointGroupVersionKind),
+		reconcilerOpts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&svcapitypes.ObjectLambdaAccessPoint{}).
+		Complete(r)
+}
+
+type connector struct {
+	kube client.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*svcapitypes.ObjectLambdaAccessPoint)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: svcsdk.New(sess)}, nil
+}
+
+type external struct {
+	client svcsdkapi.S3ControlAPI
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*svcapitypes.ObjectLambdaAccessPoint)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	resp, err := e.client.GetAccessPointForObjectLambdaWithContext(ctx, &svcsdk.GetAccessPointForObjectLambdaInput{
+		AccountId: cr.Spec.ForProvider.AccountID,
+		Name:      awsclient.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(isNotFound, err), errDescribe)
+	}
+	cr.Status.AtProvider = GenerateObservation(cr, resp)
+	cr.SetConditions(xpv1.Available())
+
+	conf, err := e.client.GetAccessPointConfigurationForObjectLambdaWithContext(ctx, &svcsdk.GetAccessPointConfigurationForObjectLambdaInput{
+		AccountId: cr.Spec.ForProvider.AccountID,
+		Name:      awsclient.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errDescribeConfiguration)
+	}
+	if !IsConfigurationUpToDate(cr.Spec.ForProvider.Configuration, conf.Configuration) {
+		return managed.ExternalObservation{ResourceExists: true}, nil
+	}
+
+	ps, err := e.observePolicy(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: ps == updated,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*svcapitypes.ObjectLambdaAccessPoint)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	resp, err := e.client.CreateAccessPointForObjectLambdaWithContext(ctx, &svcsdk.CreateAccessPointForObjectLambdaInput{
+		AccountId:     cr.Spec.ForProvider.AccountID,
+		Name:          awsclient.String(meta.GetExternalName(cr)),
+		Configuration: GenerateConfiguration(cr.Spec.ForProvider.Configuration),
+	})
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+	cr.Status.AtProvider.ARN = resp.ObjectLambdaAccessPointArn
+	return managed.ExternalCreation{}, e.putPolicy(ctx, cr)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*svcapitypes.ObjectLambdaAccessPoint)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	_, err := e.client.PutAccessPointConfigurationForObjectLambdaWithContext(ctx, &svcsdk.PutAccessPointConfigurationForObjectLambdaInput{
+		AccountId:     cr.Spec.ForProvider.AccountID,
+		Name:          awsclient.String(meta.GetExternalName(cr)),
+		Configuration: GenerateConfiguration(cr.Spec.ForProvider.Configuration),
+	})
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errPutConfiguration)
+	}
+
+	ps, err := e.observePolicy(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	switch ps {
+	case needsDeletion:
+		_, err = e.client.DeleteAccessPointPolicyForObjectLambdaWithContext(ctx, &svcsdk.DeleteAccessPointPolicyForObjectLambdaInput{
+			AccountId: cr.Spec.ForProvider.AccountID,
+			Name:      awsclient.String(meta.GetExternalName(cr)),
+		})
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errDeletePolicy)
+	case needsUpdate:
+		return managed.ExternalUpdate{}, e.putPolicy(ctx, cr)
+	case updated:
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*svcapitypes.ObjectLambdaAccessPoint)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.DeleteAccessPointForObjectLambdaWithContext(ctx, &svcsdk.DeleteAccessPointForObjectLambdaInput{
+		AccountId: cr.Spec.ForProvider.AccountID,
+		Name:      awsclient.String(meta.GetExternalName(cr)),
+	})
+	return awsclient.Wrap(resource.Ignore(isNotFound, err), errDelete)
+}
+
+// policyStatus represents the current status if the policy is updated.
+type policyStatus int
+
+const (
+	// updated is returned if the policy is updated.
+	updated policyStatus = iota
+	// needsUpdate is returned if the policy required updating.
+	needsUpdate
+	// needsDeletion is returned if the policy needs to be deleted.
+	needsDeletion
+)
+
+// observePolicy observes the current status of the Object Lambda Access
+// Point policy.
+func (e *external) observePolicy(ctx context.Context, cr *svcapitypes.ObjectLambdaAccessPoint) (policyStatus, error) {
+	resp, err := e.client.GetAccessPointPolicyForObjectLambdaWithContext(ctx, &svcsdk.GetAccessPointPolicyForObjectLambdaInput{
+		AccountId: cr.Spec.ForProvider.AccountID,
+		Name:      awsclient.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		if isPolicyNotFound(err) {
+			if cr.Spec.ForProvider.Policy == nil {
+				return updated, nil
+			}
+			return needsUpdate, nil
+		}
+		return needsUpdate, awsclient.Wrap(err, errDescribePolicy)
+	}
+
+	if cr.Spec.ForProvider.Policy == nil {
+		if resp.Policy != nil {
+			return needsDeletion, nil
+		}
+		return updated, nil
+	}
+
+	diff, err := s3.DiffParsedPolicies(cr.Spec.ForProvider.Policy, resp.Policy)
+	if diff != "" || err != nil {
+		return needsUpdate, err
+	}
+	return updated, nil
+}
+
+// putPolicy puts the Object Lambda Access Point policy if there is one.
+func (e *external) putPolicy(ctx context.Context, cr *svcapitypes.ObjectLambdaAccessPoint) error {
+	if cr.Spec.ForProvider.Policy == nil {
+		return nil
+	}
+	policy, err := s3.FormatPolicy(cr.Spec.ForProvider.Policy)
+	if err != nil {
+		return errors.Wrap(err, errFormatPolicy)
+	}
+	_, err = e.client.PutAccessPointPolicyForObjectLambdaWithContext(ctx, &svcsdk.PutAccessPointPolicyForObjectLambdaInput{
+		AccountId: cr.Spec.ForProvider.AccountID,
+		Name:      awsclient.String(meta.GetExternalName(cr)),
+		Policy:    policy,
+	})
+	return awsclient.Wrap(err, errPutPolicy)
+}
+
+// GenerateConfiguration returns the API configuration of the supplied
+// parameters.
+func GenerateConfiguration(p svcapitypes.ObjectLambdaConfiguration) *svcsdk.ObjectLambdaConfiguration {
+	c := &svcsdk.ObjectLambdaConfiguration{
+		AllowedFeatures:              awsclient.StringSliceToPtr(p.AllowedFeatures),
+		CloudWatchMetricsEnabled:     p.CloudWatchMetricsEnabled,
+		SupportingAccessPoint:        p.SupportingAccessPoint,
+		TransformationConfigurations: make([]*svcsdk.ObjectLambdaTransformationConfiguration, len(p.TransformationConfigurations)),
+	}
+	for i, tc := range p.TransformationConfigurations {
+		c.TransformationConfigurations[i] = &svcsdk.ObjectLambdaTransformationConfiguration{
+			Actions: awsclient.StringSliceToPtr(tc.Actions),
+			ContentTransformation: &svcsdk.ObjectLambdaContentTransformation{
+				AwsLambda: &svcsdk.AwsLambdaTransformation{
+					FunctionArn:     tc.ContentTransformation.AWSLambda.FunctionARN,
+					FunctionPayload: tc.ContentTransformation.AWSLambda.FunctionPayload,
+				},
+			},
+		}
+	}
+	return c
+}
+
+// IsConfigurationUpToDate returns whether the observed configuration matches
+// the supplied parameters.
+func IsConfigurationUpToDate(p svcapitypes.ObjectLambdaConfiguration, observed *svcsdk.ObjectLambdaConfiguration) bool {
+	desired := GenerateConfiguration(p)
+	if observed == nil {
+		observed = &svcsdk.ObjectLambdaConfiguration{}
+	}
+	// An unset CloudWatch metrics toggle leaves the observed one alone.
+	if desired.CloudWatchMetricsEnabled == nil {
+		desired.CloudWatchMetricsEnabled = observed.CloudWatchMetricsEnabled
+	}
+	return cmp.Equal(desired, observed,
+		cmpopts.EquateEmpty(),
+		cmpopts.IgnoreUnexported(svcsdk.ObjectLambdaConfiguration{}, svcsdk.ObjectLambdaTransformationConfiguration{},
+			svcsdk.ObjectLambdaContentTransformation{}, svcsdk.AwsLambdaTransformation{}),
+		cmpopts.SortSlices(func(a, b *string) bool { return awsclient.StringValue(a) < awsclient.StringValue(b) }),
+	)
+}
+
+// GenerateObservation returns the observation of the supplied Object Lambda
+// Access Point.
+func GenerateObservation(cr *svcapitypes.ObjectLambdaAccessPoint, resp *svcsdk.GetAccessPointForObjectLambdaOutput) svcapitypes.ObjectLambdaAccessPointObservation {
+	o := svcapitypes.ObjectLambdaAccessPointObservation{
+		ARN:          cr.Status.AtProvider.ARN,
+		CreationDate: awsclient.TimeToMetaTime(resp.CreationDate),
+	}
+	if o.ARN == nil {
+		partition := "aws"
+		if p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), cr.Spec.ForProvider.Region); ok {
+			partition = p.ID()
+		}
+		o.ARN = awsclient.String("arn:" + partition + ":s3-object-lambda:" + cr.Spec.ForProvider.Region + ":" +
+			awsclient.StringValue(cr.Spec.ForProvider.AccountID) + ":accesspoint/" + meta.GetExternalName(cr))
+	}
+	return o
+}
+
+// isNotFound returns whether the given error is of type NotFound or not.
+func isNotFound(err error) bool {
+	awsErr, ok := err.(awserr.Error) //nolint:errorlint
+	return ok && awsErr.Code() == "NoSuchAccessPoint"
+}
+
+// isPolicyNotFound returns whether the given error reports a missing policy.
+func isPolicyNotFound(err error) bool {
+	awsErr, ok := err.(awserr.Error) //nolint:errorlint
+	return ok && awsErr.Code() == "NoSuchAccessPointPolicy"
+}
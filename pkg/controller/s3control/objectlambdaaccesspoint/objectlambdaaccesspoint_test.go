@@ -0,0 +1,433 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package objectlambdaaccesspoint
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	svcsdk "github.com/aws/aws-sdk-go/service/s3control"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-aws/apis/s3/common"
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/s3control/v1alpha1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3control/fake"
+)
+
+var (
+	errBoom = errors.New("boom")
+
+	testName    = "test-olap"
+	arn         = "arn:aws:s3-object-lambda:us-east-1:123456789012:accesspoint/test-olap"
+	functionARN = "arn:aws:lambda:us-east-1:123456789012:function:transform"
+	created     = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testPolicy = &common.BucketPolicyBody{
+		Version: "2012-10-17",
+		Statements: []common.BucketPolicyStatement{
+			{
+				Effect: "Allow",
+				Principal: &common.BucketPrincipal{
+					AWSPrincipals: []common.AWSPrincipal{
+						{IAMRoleARN: awsclient.String("arn:aws:iam::123456789012:role/reader")},
+					},
+				},
+				Action:   []string{"s3-object-lambda:GetObject"},
+				Resource: []string{arn},
+			},
+		},
+	}
+	testPolicyRaw = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:role/reader"},"Action":"s3-object-lambda:GetObject","Resource":"arn:aws:s3-object-lambda:us-east-1:123456789012:accesspoint/test-olap"}]}`
+)
+
+type olapModifier func(*svcapitypes.ObjectLambdaAccessPoint)
+
+func withConditions(c ...xpv1.Condition) olapModifier {
+	return func(r *svcapitypes.ObjectLambdaAccessPoint) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withPolicy(p *common.BucketPolicyBody) olapModifier {
+	return func(r *svcapitypes.ObjectLambdaAccessPoint) { r.Spec.ForProvider.Policy = p }
+}
+
+func withObservation() olapModifier {
+	return func(r *svcapitypes.ObjectLambdaAccessPoint) {
+		r.Status.AtProvider.ARN = awsclient.String(arn)
+		r.Status.AtProvider.CreationDate = &metav1.Time{Time: created}
+	}
+}
+
+func olap(m ...olapModifier) *svcapitypes.ObjectLambdaAccessPoint {
+	cr := &svcapitypes.ObjectLambdaAccessPoint{
+		Spec: svcapitypes.ObjectLambdaAccessPointSpec{
+			ForProvider: svcapitypes.ObjectLambdaAccessPointParameters{
+				Region:    "us-east-1",
+				AccountID: awsclient.String("123456789012"),
+				Configuration: svcapitypes.ObjectLambdaConfiguration{
+					SupportingAccessPoint: awsclient.String("arn:aws:s3:us-east-1:123456789012:accesspoint/test-ap"),
+					TransformationConfigurations: []svcapitypes.ObjectLambdaTransformationConfiguration{
+						{
+							Actions: []string{"GetObject"},
+							ContentTransformation: svcapitypes.ObjectLambdaContentTransformation{
+								AWSLambda: svcapitypes.AWSLambdaTransformation{
+									FunctionARN: awsclient.String(functionARN),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	meta.SetExternalName(cr, testName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func observedConfiguration(cloudWatch bool, actions ...string) svcsdk.GetAccessPointConfigurationForObjectLambdaOutput {
+	return svcsdk.GetAccessPointConfigurationForObjectLambdaOutput{
+		Configuration: &svcsdk.ObjectLambdaConfiguration{
+			CloudWatchMetricsEnabled: &cloudWatch,
+			SupportingAccessPoint:    awsclient.String("arn:aws:s3:us-east-1:123456789012:accesspoint/test-ap"),
+			TransformationConfigurations: []*svcsdk.ObjectLambdaTransformationConfiguration{
+				{
+					Actions: awsclient.StringSliceToPtr(actions),
+					ContentTransformation: &svcsdk.ObjectLambdaContentTransformation{
+						AwsLambda: &svcsdk.AwsLambdaTransformation{
+							FunctionArn: awsclient.String(functionARN),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type args struct {
+		client *fake.MockS3ControlClient
+		cr     *svcapitypes.ObjectLambdaAccessPoint
+	}
+	type want struct {
+		cr     *svcapitypes.ObjectLambdaAccessPoint
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NotFound": {
+			args: args{
+				client: &fake.MockS3ControlClient{
+					GetAccessPointForObjectLambdaWithContextErr: awserr.New("NoSuchAccessPoint", "", nil),
+				},
+				cr: olap(),
+			},
+			want: want{
+				cr: olap(),
+			},
+		},
+		"DescribeFailed": {
+			args: args{
+				client: &fake.MockS3ControlClient{
+					GetAccessPointForObjectLambdaWithContextErr: errBoom,
+				},
+				cr: olap(),
+			},
+			want: want{
+				cr:  olap(),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				client: &fake.MockS3ControlClient{
+					GetAccessPointForObjectLambdaWithContextOutput: svcsdk.GetAccessPointForObjectLambdaOutput{
+						CreationDate: &created,
+					},
+					GetAccessPointConfigurationForObjectLambdaWithContextOutput: observedConfiguration(false, "GetObject"),
+					GetAccessPointPolicyForObjectLambdaWithContextOutput: svcsdk.GetAccessPointPolicyForObjectLambdaOutput{
+						Policy: awsclient.String(testPolicyRaw),
+					},
+				},
+				cr: olap(withPolicy(testPolicy)),
+			},
+			want: want{
+				cr: olap(withPolicy(testPolicy), withObservation(), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"ConfigurationNotUpToDate": {
+			args: args{
+				client: &fake.MockS3ControlClient{
+					GetAccessPointForObjectLambdaWithContextOutput: svcsdk.GetAccessPointForObjectLambdaOutput{
+						CreationDate: &created,
+					},
+					GetAccessPointConfigurationForObjectLambdaWithContextOutput: observedConfiguration(false, "GetObject", "HeadObject"),
+				},
+				cr: olap(),
+			},
+			want: want{
+				cr: olap(withObservation(), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"PolicyNeedsDeletion": {
+			args: args{
+				client: &fake.MockS3ControlClient{
+					GetAccessPointForObjectLambdaWithContextOutput: svcsdk.GetAccessPointForObjectLambdaOutput{
+						CreationDate: &created,
+					},
+					GetAccessPointConfigurationForObjectLambdaWithContextOutput: observedConfiguration(true, "GetObject"),
+					GetAccessPointPolicyForObjectLambdaWithContextOutput: svcsdk.GetAccessPointPolicyForObjectLambdaOutput{
+						Policy: awsclient.String(testPolicyRaw),
+					},
+				},
+				cr: olap(),
+			},
+			want: want{
+				cr: olap(withObservation(), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"NoPolicy": {
+			args: args{
+				client: &fake.MockS3ControlClient{
+					GetAccessPointForObjectLambdaWithContextOutput: svcsdk.GetAccessPointForObjectLambdaOutput{
+						CreationDate: &created,
+					},
+					GetAccessPointConfigurationForObjectLambdaWithContextOutput: observedConfiguration(false, "GetObject"),
+					GetAccessPointPolicyForObjectLambdaWithContextErr:           awserr.New("NoSuchAccessPointPolicy", "", nil),
+				},
+				cr: olap(),
+			},
+			want: want{
+				cr: olap(withObservation(), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.args.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type args struct {
+		client *fake.MockS3ControlClient
+		cr     *svcapitypes.ObjectLambdaAccessPoint
+	}
+	type want struct {
+		cr  *svcapitypes.ObjectLambdaAccessPoint
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockS3ControlClient{
+					CreateAccessPointForObjectLambdaWithContextOutput: svcsdk.CreateAccessPointForObjectLambdaOutput{
+						ObjectLambdaAccessPointArn: awsclient.String(arn),
+					},
+				},
+				cr: olap(withPolicy(testPolicy)),
+			},
+			want: want{
+				cr: olap(withPolicy(testPolicy), withConditions(xpv1.Creating()), func(r *svcapitypes.ObjectLambdaAccessPoint) {
+					r.Status.AtProvider.ARN = awsclient.String(arn)
+				}),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				client: &fake.MockS3ControlClient{
+					CreateAccessPointForObjectLambdaWithContextErr: errBoom,
+				},
+				cr: olap(),
+			},
+			want: want{
+				cr:  olap(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+		"PutPolicyFailed": {
+			args: args{
+				client: &fake.MockS3ControlClient{
+					CreateAccessPointForObjectLambdaWithContextOutput: svcsdk.CreateAccessPointForObjectLambdaOutput{
+						ObjectLambdaAccessPointArn: awsclient.String(arn),
+					},
+					PutAccessPointPolicyForObjectLambdaWithContextErr: errBoom,
+				},
+				cr: olap(withPolicy(testPolicy)),
+			},
+			want: want{
+				cr: olap(withPolicy(testPolicy), withConditions(xpv1.Creating()), func(r *svcapitypes.ObjectLambdaAccessPoint) {
+					r.Status.AtProvider.ARN = awsclient.String(arn)
+				}),
+				err: awsclient.Wrap(errBoom, errPutPolicy),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.args.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type args struct {
+		client *fake.MockS3ControlClient
+		cr     *svcapitypes.ObjectLambdaAccessPoint
+	}
+
+	cases := map[string]struct {
+		args
+		err error
+	}{
+		"PutConfigurationFailed": {
+			args: args{
+				client: &fake.MockS3ControlClient{
+					PutAccessPointConfigurationForObjectLambdaWithContextErr: errBoom,
+				},
+				cr: olap(),
+			},
+			err: awsclient.Wrap(errBoom, errPutConfiguration),
+		},
+		"DeletePolicyFailed": {
+			args: args{
+				client: &fake.MockS3ControlClient{
+					GetAccessPointPolicyForObjectLambdaWithContextOutput: svcsdk.GetAccessPointPolicyForObjectLambdaOutput{
+						Policy: awsclient.String(testPolicyRaw),
+					},
+					DeleteAccessPointPolicyForObjectLambdaWithContextErr: errBoom,
+				},
+				cr: olap(),
+			},
+			err: awsclient.Wrap(errBoom, errDeletePolicy),
+		},
+		"PutPolicyFailed": {
+			args: args{
+				client: &fake.MockS3ControlClient{
+					GetAccessPointPolicyForObjectLambdaWithContextErr: awserr.New("NoSuchAccessPointPolicy", "", nil),
+					PutAccessPointPolicyForObjectLambdaWithContextErr: errBoom,
+				},
+				cr: olap(withPolicy(testPolicy)),
+			},
+			err: awsclient.Wrap(errBoom, errPutPolicy),
+		},
+		"Successful": {
+			args: args{
+				client: &fake.MockS3ControlClient{
+					GetAccessPointPolicyForObjectLambdaWithContextOutput: svcsdk.GetAccessPointPolicyForObjectLambdaOutput{
+						Policy: awsclient.String(testPolicyRaw),
+					},
+				},
+				cr: olap(withPolicy(testPolicy)),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.args.client}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		client *fake.MockS3ControlClient
+		err    error
+	}{
+		"Successful": {
+			client: &fake.MockS3ControlClient{},
+		},
+		"AlreadyDeleted": {
+			client: &fake.MockS3ControlClient{
+				DeleteAccessPointForObjectLambdaWithContextErr: awserr.New("NoSuchAccessPoint", "", nil),
+			},
+		},
+		"Failed": {
+			client: &fake.MockS3ControlClient{
+				DeleteAccessPointForObjectLambdaWithContextErr: errBoom,
+			},
+			err: awsclient.Wrap(errBoom, errDelete),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), olap())
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -62,6 +62,7 @@ const (
 	errOnlyOneSecretRef     = "only one of binarySecretRef or stringSecretRef must be set"
 	errParseSpecPolicy      = "cannot parse spec policy"
 	errParseExternalPolicy  = "cannot parse external policy"
+	errInvalidPolicy        = "invalid resource policy"
 )
 
 // SetupSecret adds a controller that reconciles a Secret.
@@ -254,40 +255,45 @@ func (e *hooks) isUpToDate(ctx context.Context, cr *svcapitypes.Secret, resp *sv
 		return false, "", nil
 	}
 
-	isPolicyUpToDate, err := e.isPolicyUpToDate(ctx, cr)
+	isPolicyUpToDate, diff, err := e.isPolicyUpToDate(ctx, cr)
 	if err != nil {
 		return false, "", err
 	}
 	if !isPolicyUpToDate {
-		return false, "", nil
+		return false, diff, nil
 	}
 
 	isPayloadUpToDate, err := e.isPayloadUpToDate(ctx, cr)
 	return isPayloadUpToDate, "", err
 }
 
-func (e *hooks) isPolicyUpToDate(ctx context.Context, cr *svcapitypes.Secret) (bool, error) {
+func (e *hooks) isPolicyUpToDate(ctx context.Context, cr *svcapitypes.Secret) (bool, string, error) {
 	res, err := e.client.GetResourcePolicyWithContext(ctx, &svcsdk.GetResourcePolicyInput{
 		SecretId: awsclients.String(meta.GetExternalName(cr)),
 	})
 	if err != nil {
-		return false, errors.Wrap(err, errGetResourcePolicy)
+		return false, "", errors.Wrap(err, errGetResourcePolicy)
+	}
+
+	specPolicy, err := policyutils.ConvertResourcePolicyOrRaw(cr.Spec.ForProvider.PolicyDocument, cr.Spec.ForProvider.ResourcePolicy)
+	if err != nil {
+		return false, "", err
 	}
 
-	if res.ResourcePolicy == nil || cr.Spec.ForProvider.ResourcePolicy == nil {
-		return res.ResourcePolicy == cr.Spec.ForProvider.ResourcePolicy, nil
+	if res.ResourcePolicy == nil || specPolicy == nil {
+		return res.ResourcePolicy == specPolicy, "", nil
 	}
 
-	specPol, err := policyutils.ParsePolicyString(*cr.Spec.ForProvider.ResourcePolicy)
+	specPol, err := policyutils.ParsePolicyString(*specPolicy)
 	if err != nil {
-		return false, errors.Wrap(err, errParseSpecPolicy)
+		return false, "", errors.Wrap(err, errParseSpecPolicy)
 	}
 	curPol, err := policyutils.ParsePolicyString(*res.ResourcePolicy)
 	if err != nil {
-		return false, errors.Wrap(err, errParseExternalPolicy)
+		return false, "", errors.Wrap(err, errParseExternalPolicy)
 	}
-	areEqal, _ := policyutils.ArePoliciesEqal(&specPol, &curPol)
-	return areEqal, nil
+	areEqal, diff := policyutils.ArePoliciesEqal(&specPol, &curPol)
+	return areEqal, diff, nil
 }
 
 func (e *hooks) isPayloadUpToDate(ctx context.Context, cr *svcapitypes.Secret) (bool, error) {
@@ -381,10 +387,19 @@ func (e *hooks) preUpdate(ctx context.Context, cr *svcapitypes.Secret, obj *svcs
 	}
 
 	// Update resource policy
-	if cr.Spec.ForProvider.ResourcePolicy != nil {
+	resourcePolicy, err := policyutils.ConvertResourcePolicyOrRaw(cr.Spec.ForProvider.PolicyDocument, cr.Spec.ForProvider.ResourcePolicy)
+	if err != nil {
+		return errors.Wrap(err, errPutResourcePolicy)
+	}
+	if resourcePolicy != nil {
+		if err := policyutils.ValidatePolicyString(*resourcePolicy,
+			policyutils.WithResourcePolicy(),
+			policyutils.WithMaxSize(policyutils.MaxSecretPolicySize)); err != nil {
+			return errors.Wrap(err, errInvalidPolicy)
+		}
 		_, err := e.client.PutResourcePolicyWithContext(ctx, &svcsdk.PutResourcePolicyInput{
 			SecretId:       awsclients.String(meta.GetExternalName(cr)),
-			ResourcePolicy: cr.Spec.ForProvider.ResourcePolicy,
+			ResourcePolicy: resourcePolicy,
 		})
 		if err != nil {
 			return errors.Wrap(err, errPutResourcePolicy)
@@ -48,6 +48,7 @@ const (
 	errDelete           = "failed to delete the SNS Topic"
 	errUpdate           = "failed to update the SNS Topic"
 	errGetChangedAttr   = "failed to get changed topic attributes"
+	errInvalidPolicy    = "invalid SNS Topic policy"
 )
 
 // SetupSNSTopic adds a controller that reconciles Topic.
@@ -181,6 +182,11 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 	if err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, errGetChangedAttr)
 	}
+	if policy := attrs[string(snsclient.TopicPolicy)]; policy != "" {
+		if err := snsclient.ValidateTopicPolicy(policy); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errInvalidPolicy)
+		}
+	}
 	for k, v := range attrs {
 		_, err = e.client.SetTopicAttributes(ctx, &awssns.SetTopicAttributesInput{
 			AttributeName:  aws.String(k),
@@ -51,6 +51,7 @@ const (
 	errGetQueueURLFailed        = "cannot get Queue URL"
 	errListQueueTagsFailed      = "cannot list Queue tags"
 	errUpdateFailed             = "failed to update the Queue resource"
+	errInvalidPolicy            = "invalid Queue policy"
 )
 
 // SetupQueue adds a controller that reconciles Queue.
@@ -165,8 +166,18 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 
 	cr.SetConditions(xpv1.Creating())
 
+	attributes, err := sqs.GenerateCreateAttributes(&cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
+	}
+	if policy := attributes[v1beta1.AttributePolicy]; policy != "" {
+		if err := sqs.ValidateQueuePolicy(policy); err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, errInvalidPolicy)
+		}
+	}
+
 	resp, err := e.client.CreateQueue(ctx, &awssqs.CreateQueueInput{
-		Attributes: sqs.GenerateCreateAttributes(&cr.Spec.ForProvider),
+		Attributes: attributes,
 		QueueName:  aws.String(meta.GetExternalName(cr)),
 		Tags:       cr.Spec.ForProvider.Tags,
 	})
@@ -189,9 +200,19 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, nil
 	}
 
-	_, err := e.client.SetQueueAttributes(ctx, &awssqs.SetQueueAttributesInput{
+	attributes, err := sqs.GenerateQueueAttributes(&cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+	}
+	if policy := attributes[v1beta1.AttributePolicy]; policy != "" {
+		if err := sqs.ValidateQueuePolicy(policy); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errInvalidPolicy)
+		}
+	}
+
+	_, err = e.client.SetQueueAttributes(ctx, &awssqs.SetQueueAttributesInput{
 		QueueUrl:   aws.String(cr.Status.AtProvider.URL),
-		Attributes: sqs.GenerateQueueAttributes(&cr.Spec.ForProvider),
+		Attributes: attributes,
 	})
 	if err != nil {
 		return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdateFailed)
@@ -1,6 +1,9 @@
 package metrics
 
 import (
+	"strconv"
+	"time"
+
 	"github.com/prometheus/client_golang/prometheus"
 	k8smetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 )
@@ -10,14 +13,178 @@ var (
 		Name: "aws_api_calls_total",
 		Help: "Number of API calls to the AWS API",
 	}, []string{"service", "operation", "api_version"})
+
+	metricAWSAPIThrottles = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "aws_api_throttles_total",
+		Help: "Number of API calls to the AWS API that were throttled",
+	}, []string{"service", "operation", "api_version"})
+
+	metricCredentialsCacheHits = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "aws_credentials_cache_hits_total",
+		Help: "Number of times cached AWS credentials were reused for a ProviderConfig",
+	}, []string{"provider_config"})
+
+	metricCredentialsCacheMisses = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "aws_credentials_cache_misses_total",
+		Help: "Number of times AWS credentials had to be (re-)created for a ProviderConfig",
+	}, []string{"provider_config"})
 )
 
+// Labels of the request duration and error metrics.
+const (
+	labelProviderConfig = "provider_config"
+	labelRegion         = "region"
+)
+
+// requestMetrics are the metrics of individual AWS API requests. Their labels
+// depend on the options SetupMetrics is called with.
+type requestMetrics struct {
+	duration *prometheus.HistogramVec
+	errors   *prometheus.CounterVec
+
+	providerConfigLabel bool
+	regionLabel         bool
+}
+
+func newRequestMetrics(o options) *requestMetrics {
+	labels := []string{"service", "operation", "api_version"}
+	if o.providerConfigLabel {
+		labels = append(labels, labelProviderConfig)
+	}
+	if o.regionLabel {
+		labels = append(labels, labelRegion)
+	}
+	return &requestMetrics{
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "aws_api_request_duration_seconds",
+			Help:    "Duration of requests to the AWS API, per attempt",
+			Buckets: []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30},
+		}, labels),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "aws_api_errors_total",
+			Help: "Number of requests to the AWS API that failed, by AWS error code and HTTP status",
+		}, append(append([]string{}, labels...), "error_code", "http_status")),
+		providerConfigLabel: o.providerConfigLabel,
+		regionLabel:         o.regionLabel,
+	}
+}
+
+func (m *requestMetrics) labelValues(r AWSAPIRequest) []string {
+	lv := []string{r.Service, r.Operation, r.APIVersion}
+	if m.providerConfigLabel {
+		lv = append(lv, r.ProviderConfig)
+	}
+	if m.regionLabel {
+		lv = append(lv, r.Region)
+	}
+	return lv
+}
+
+func (m *requestMetrics) observe(r AWSAPIRequest) {
+	lv := m.labelValues(r)
+	m.duration.WithLabelValues(lv...).Observe(r.Duration.Seconds())
+	if r.ErrorCode == "" && r.HTTPStatus < 400 {
+		return
+	}
+	status := ""
+	if r.HTTPStatus != 0 {
+		status = strconv.Itoa(r.HTTPStatus)
+	}
+	m.errors.WithLabelValues(append(lv, r.ErrorCode, status)...).Inc()
+}
+
+var metricAWSAPIRequests = newRequestMetrics(options{})
+
+type options struct {
+	providerConfigLabel bool
+	regionLabel         bool
+}
+
+// An Option configures the metrics registered by SetupMetrics.
+type Option func(o *options)
+
+// WithProviderConfigLabel adds the name of the ProviderConfig used to make a
+// request to the AWS API as the provider_config label of the request
+// duration and error metrics.
+func WithProviderConfigLabel() Option {
+	return func(o *options) {
+		o.providerConfigLabel = true
+	}
+}
+
+// WithRegionLabel adds the AWS region a request was made to as the region
+// label of the request duration and error metrics.
+func WithRegionLabel() Option {
+	return func(o *options) {
+		o.regionLabel = true
+	}
+}
+
 // SetupMetrics will register the known Prometheus metrics with controller-runtime's metrics registry
-func SetupMetrics() error {
-	return k8smetrics.Registry.Register(metricAWSAPICalls)
+func SetupMetrics(opts ...Option) error {
+	o := options{}
+	for _, fn := range opts {
+		fn(&o)
+	}
+	metricAWSAPIRequests = newRequestMetrics(o)
+
+	for _, c := range []prometheus.Collector{
+		metricAWSAPICalls,
+		metricAWSAPIThrottles,
+		metricAWSAPIRequests.duration,
+		metricAWSAPIRequests.errors,
+		metricCredentialsCacheHits,
+		metricCredentialsCacheMisses,
+	} {
+		if err := k8smetrics.Registry.Register(c); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // IncAWSAPICall will increment the aws_api_calls_total metric for the specified service, operation, and apiVersion tuple
 func IncAWSAPICall(service, operation, apiVersion string) {
 	metricAWSAPICalls.WithLabelValues(service, operation, apiVersion).Inc()
 }
+
+// IncAWSAPIThrottle will increment the aws_api_throttles_total metric for the specified service, operation, and apiVersion tuple
+func IncAWSAPIThrottle(service, operation, apiVersion string) {
+	metricAWSAPIThrottles.WithLabelValues(service, operation, apiVersion).Inc()
+}
+
+// AWSAPIRequest describes a single attempt of a request to the AWS API.
+type AWSAPIRequest struct {
+	Service        string
+	Operation      string
+	APIVersion     string
+	ProviderConfig string
+	Region         string
+
+	// Duration of the attempt.
+	Duration time.Duration
+
+	// ErrorCode is the AWS error code of a failed attempt, if any.
+	ErrorCode string
+
+	// HTTPStatus is the HTTP status code of the response, or 0 if no
+	// response was received.
+	HTTPStatus int
+}
+
+// ObserveAWSAPIRequest will observe the duration of the supplied request in the
+// aws_api_request_duration_seconds metric, and increment the aws_api_errors_total
+// metric if it failed
+func ObserveAWSAPIRequest(r AWSAPIRequest) {
+	metricAWSAPIRequests.observe(r)
+}
+
+// IncCredentialsCacheHit will increment the aws_credentials_cache_hits_total metric for the specified ProviderConfig
+func IncCredentialsCacheHit(providerConfig string) {
+	metricCredentialsCacheHits.WithLabelValues(providerConfig).Inc()
+}
+
+// IncCredentialsCacheMiss will increment the aws_credentials_cache_misses_total metric for the specified ProviderConfig
+func IncCredentialsCacheMiss(providerConfig string) {
+	metricCredentialsCacheMisses.WithLabelValues(providerConfig).Inc()
+}
@@ -0,0 +1,57 @@
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestRequestMetricsObserve(t *testing.T) {
+	type want struct {
+		durations int
+		errors    float64
+	}
+	cases := map[string]struct {
+		opts   options
+		req    AWSAPIRequest
+		labels []string
+		want   want
+	}{
+		"Succeeded": {
+			req:    AWSAPIRequest{Service: "EC2", Operation: "DescribeVpcs", APIVersion: "2", Duration: time.Second, HTTPStatus: 200},
+			labels: []string{"EC2", "DescribeVpcs", "2", "", ""},
+			want:   want{durations: 1},
+		},
+		"Failed": {
+			req:    AWSAPIRequest{Service: "EC2", Operation: "DescribeVpcs", APIVersion: "2", ErrorCode: "InvalidVpcID.NotFound", HTTPStatus: 400},
+			labels: []string{"EC2", "DescribeVpcs", "2", "InvalidVpcID.NotFound", "400"},
+			want:   want{durations: 1, errors: 1},
+		},
+		"NoResponse": {
+			req:    AWSAPIRequest{Service: "EC2", Operation: "DescribeVpcs", APIVersion: "2", ErrorCode: "Unknown"},
+			labels: []string{"EC2", "DescribeVpcs", "2", "Unknown", ""},
+			want:   want{durations: 1, errors: 1},
+		},
+		"OptionalLabels": {
+			opts:   options{providerConfigLabel: true, regionLabel: true},
+			req:    AWSAPIRequest{Service: "IAM", Operation: "GetRole", APIVersion: "1", ProviderConfig: "default", Region: "us-east-1", ErrorCode: "NoSuchEntity", HTTPStatus: 404},
+			labels: []string{"IAM", "GetRole", "1", "default", "us-east-1", "NoSuchEntity", "404"},
+			want:   want{durations: 1, errors: 1},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			m := newRequestMetrics(tc.opts)
+			m.observe(tc.req)
+			got := want{
+				durations: testutil.CollectAndCount(m.duration),
+				errors:    testutil.ToFloat64(m.errors.WithLabelValues(tc.labels...)),
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -18,10 +18,29 @@ package policy
 
 import (
 	"encoding/json"
+	"slices"
+
+	"github.com/pkg/errors"
 
 	"github.com/crossplane-contrib/provider-aws/apis/common"
 )
 
+const (
+	errRawAndResourcePolicy = "only one of the raw and the structured policy may be specified"
+)
+
+// ConvertResourcePolicyOrRaw returns the JSON string representation of rp if
+// it is set and raw otherwise. It returns an error if both are set.
+func ConvertResourcePolicyOrRaw(rp *common.ResourcePolicy, raw *string) (*string, error) {
+	if rp == nil {
+		return raw, nil
+	}
+	if raw != nil {
+		return nil, errors.New(errRawAndResourcePolicy)
+	}
+	return ConvertResourcePolicyToPolicyString(rp)
+}
+
 // ConvertResourcePolicyToPolicyString converts a ResourcePolicy to its JSON
 // string representation that can be sent to AWS.
 func ConvertResourcePolicyToPolicyString(rp *common.ResourcePolicy) (*string, error) {
@@ -63,7 +82,7 @@ func ConvertResourcePolicyToPolicy(rp *common.ResourcePolicy) *Policy {
 			Effect:       StatementEffect(sm.Effect),
 			Action:       sm.Action,
 			NotAction:    sm.NotAction,
-			Resource:     sm.Resource,
+			Resource:     convertResourcePolicyResources(sm),
 			NotResource:  sm.NotResource,
 			Principal:    convertResourcePolicyPrincipal(sm.Principal),
 			NotPrincipal: convertResourcePolicyPrincipal(sm.NotPrincipal),
@@ -74,6 +93,22 @@ func ConvertResourcePolicyToPolicy(rp *common.ResourcePolicy) *Policy {
 	return &res
 }
 
+// convertResourcePolicyResources returns the resources of the statement
+// followed by the ARNs resolved from its references that are not among them.
+func convertResourcePolicyResources(sm common.ResourcePolicyStatement) []string {
+	if len(sm.ResourceARNs) == 0 {
+		return sm.Resource
+	}
+	res := make([]string, 0, len(sm.Resource)+len(sm.ResourceARNs))
+	res = append(res, sm.Resource...)
+	for _, arn := range sm.ResourceARNs {
+		if !slices.Contains(res, arn) {
+			res = append(res, arn)
+		}
+	}
+	return res
+}
+
 func convertResourcePolicyPrincipal(p *common.ResourcePrincipal) *Principal {
 	if p == nil {
 		return nil
@@ -0,0 +1,93 @@
+package policy
+
+import (
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-aws/apis/common"
+)
+
+func TestConvertResourcePolicyOrRaw(t *testing.T) {
+	raw := `{"Version":"2012-10-17"}`
+	converted := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["sqs:SendMessage"],"Resource":["*"]}]}`
+	resourcePolicy := &common.ResourcePolicy{
+		Version: "2012-10-17",
+		Statements: []common.ResourcePolicyStatement{{
+			Effect:   "Allow",
+			Action:   []string{"sqs:SendMessage"},
+			Resource: []string{"*"},
+		}},
+	}
+
+	type args struct {
+		rp  *common.ResourcePolicy
+		raw *string
+	}
+	type want struct {
+		policy *string
+		err    error
+	}
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Nothing": {},
+		"Raw": {
+			args: args{
+				raw: &raw,
+			},
+			want: want{
+				policy: &raw,
+			},
+		},
+		"ResourcePolicy": {
+			args: args{
+				rp: resourcePolicy,
+			},
+			want: want{
+				policy: &converted,
+			},
+		},
+		"ResolvedResourceARNs": {
+			args: args{
+				rp: &common.ResourcePolicy{
+					Version: "2012-10-17",
+					Statements: []common.ResourcePolicyStatement{{
+						Effect:       "Allow",
+						Action:       []string{"sqs:SendMessage"},
+						Resource:     []string{"arn:aws:sqs:us-east-1:123456789012:a"},
+						ResourceARNs: []string{"arn:aws:sqs:us-east-1:123456789012:a", "arn:aws:sqs:us-east-1:123456789012:b"},
+					}},
+				},
+			},
+			want: want{
+				policy: ptr.To(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["sqs:SendMessage"],"Resource":["arn:aws:sqs:us-east-1:123456789012:a","arn:aws:sqs:us-east-1:123456789012:b"]}]}`),
+			},
+		},
+		"Both": {
+			args: args{
+				rp:  resourcePolicy,
+				raw: &raw,
+			},
+			want: want{
+				err: errors.New(errRawAndResourcePolicy),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			policy, err := ConvertResourcePolicyOrRaw(tc.args.rp, tc.args.raw)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("err: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.policy, policy); diff != "" {
+				t.Errorf("policy: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,312 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policy
+
+import (
+	"bytes"
+	"encoding/json"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Size limits of policy documents. IAM counts the characters of a document
+// without whitespace, which is what ValidatePolicyString checks against.
+const (
+	// MaxManagedPolicySize is the maximum size of an IAM managed policy.
+	MaxManagedPolicySize = 6144
+	// MaxBucketPolicySize is the maximum size of an S3 bucket policy.
+	MaxBucketPolicySize = 20480
+	// MaxRepositoryPolicySize is the maximum size of an ECR repository
+	// policy.
+	MaxRepositoryPolicySize = 10240
+	// MaxTopicPolicySize is the maximum size of an SNS topic policy.
+	MaxTopicPolicySize = 30720
+	// MaxSecretPolicySize is the maximum size of a Secrets Manager resource
+	// policy.
+	MaxSecretPolicySize = 20480
+)
+
+const (
+	errParsePolicy          = "cannot parse policy document"
+	errFmtPolicySize        = "policy document has %d characters without whitespace, the maximum is %d"
+	errFmtVersion           = "invalid version %q, must be 2012-10-17 or 2008-10-17"
+	errNoStatements         = "policy has no statements"
+	errFmtStatement         = "statement %d"
+	errFmtStatementSID      = "statement %d (sid %q)"
+	errFmtEffect            = "invalid effect %q, must be Allow or Deny"
+	errActionAndNotAction   = "only one of Action and NotAction may be specified"
+	errNoAction             = "one of Action and NotAction must be specified"
+	errFmtAction            = "invalid action %q, must be * or of the form <service>:<action>"
+	errResourceAndNot       = "only one of Resource and NotResource may be specified"
+	errNoResource           = "one of Resource and NotResource must be specified in identity-based policies"
+	errFmtResource          = "invalid resource %q, must be * or an ARN"
+	errPrincipalAndNot      = "only one of Principal and NotPrincipal may be specified"
+	errNoPrincipal          = "one of Principal and NotPrincipal must be specified in resource-based policies"
+	errPrincipalNotAllowed  = "Principal and NotPrincipal must not be specified in identity-based policies"
+	errFmtAWSPrincipal      = "invalid AWS principal %q, must be *, an account ID or an ARN"
+	errFmtServicePrincipal  = "invalid service principal %q, must be of the form <service>.amazonaws.com"
+	errEmptyFederated       = "federated principal must not be empty"
+	errFmtConditionOperator = "unknown condition operator %q"
+	errFmtConditionNoKeys   = "condition operator %q has no keys"
+)
+
+var (
+	actionRegex     = regexp.MustCompile(`^[a-zA-Z0-9-]+:[a-zA-Z0-9*?]+$`)
+	accountIDRegex  = regexp.MustCompile(`^[0-9]{12}$`)
+	servicePrinRegx = regexp.MustCompile(`^[a-z0-9.*-]+\.amazonaws\.com(\.cn)?$`)
+
+	conditionOperators = map[string]bool{
+		"StringEquals":              true,
+		"StringNotEquals":           true,
+		"StringEqualsIgnoreCase":    true,
+		"StringNotEqualsIgnoreCase": true,
+		"StringLike":                true,
+		"StringNotLike":             true,
+		"NumericEquals":             true,
+		"NumericNotEquals":          true,
+		"NumericLessThan":           true,
+		"NumericLessThanEquals":     true,
+		"NumericGreaterThan":        true,
+		"NumericGreaterThanEquals":  true,
+		"DateEquals":                true,
+		"DateNotEquals":             true,
+		"DateLessThan":              true,
+		"DateLessThanEquals":        true,
+		"DateGreaterThan":           true,
+		"DateGreaterThanEquals":     true,
+		"Bool":                      true,
+		"BinaryEquals":              true,
+		"IpAddress":                 true,
+		"NotIpAddress":              true,
+		"ArnEquals":                 true,
+		"ArnLike":                   true,
+		"ArnNotEquals":              true,
+		"ArnNotLike":                true,
+		"Null":                      true,
+	}
+)
+
+// A ValidationOption configures the validation of a policy.
+type ValidationOption func(*validation)
+
+type validation struct {
+	maxSize   int
+	identity  bool
+	principal bool
+}
+
+// WithMaxSize makes the validation fail for documents that have more than n
+// characters without whitespace.
+func WithMaxSize(n int) ValidationOption {
+	return func(v *validation) {
+		v.maxSize = n
+	}
+}
+
+// WithIdentityPolicy validates the policy as identity-based policy, i.e. every
+// statement must specify the resources it applies to but no principal.
+func WithIdentityPolicy() ValidationOption {
+	return func(v *validation) {
+		v.identity = true
+	}
+}
+
+// WithResourcePolicy validates the policy as resource-based or trust policy,
+// i.e. every statement must specify a principal.
+func WithResourcePolicy() ValidationOption {
+	return func(v *validation) {
+		v.principal = true
+	}
+}
+
+// ValidatePolicyString parses the given policy document and validates it.
+// See ValidatePolicy for details.
+func ValidatePolicyString(raw string, opts ...ValidationOption) error {
+	v := newValidation(opts)
+	if v.maxSize > 0 {
+		compact := &bytes.Buffer{}
+		if err := json.Compact(compact, []byte(raw)); err != nil {
+			return errors.Wrap(err, errParsePolicy)
+		}
+		if size := len(compact.String()); size > v.maxSize {
+			return errors.Errorf(errFmtPolicySize, size, v.maxSize)
+		}
+	}
+	p, err := ParsePolicyString(raw)
+	if err != nil {
+		return errors.Wrap(err, errParsePolicy)
+	}
+	return v.validate(&p)
+}
+
+// ValidatePolicy checks the given policy for mistakes that would make AWS
+// reject it, such as invalid effects, malformed actions, resources and
+// principals, or unknown condition operators. The returned error points at
+// the offending statement.
+func ValidatePolicy(p *Policy, opts ...ValidationOption) error {
+	return newValidation(opts).validate(p)
+}
+
+func newValidation(opts []ValidationOption) *validation {
+	v := &validation{}
+	for _, o := range opts {
+		o(v)
+	}
+	return v
+}
+
+func (v *validation) validate(p *Policy) error {
+	if p.Version != "" && p.Version != "2012-10-17" && p.Version != "2008-10-17" {
+		return errors.Errorf(errFmtVersion, p.Version)
+	}
+	if len(p.Statements) == 0 {
+		return errors.New(errNoStatements)
+	}
+	for i := range p.Statements {
+		s := &p.Statements[i]
+		if err := v.validateStatement(s); err != nil {
+			if s.SID != nil {
+				return errors.Wrapf(err, errFmtStatementSID, i, *s.SID)
+			}
+			return errors.Wrapf(err, errFmtStatement, i)
+		}
+	}
+	return nil
+}
+
+func (v *validation) validateStatement(s *Statement) error { //nolint:gocyclo
+	if s.Effect != StatementEffectAllow && s.Effect != StatementEffectDeny {
+		return errors.Errorf(errFmtEffect, s.Effect)
+	}
+
+	switch {
+	case len(s.Action) > 0 && len(s.NotAction) > 0:
+		return errors.New(errActionAndNotAction)
+	case len(s.Action) == 0 && len(s.NotAction) == 0:
+		return errors.New(errNoAction)
+	}
+	for _, a := range append(s.Action, s.NotAction...) {
+		if a != "*" && !actionRegex.MatchString(a) {
+			return errors.Errorf(errFmtAction, a)
+		}
+	}
+
+	switch {
+	case len(s.Resource) > 0 && len(s.NotResource) > 0:
+		return errors.New(errResourceAndNot)
+	case v.identity && len(s.Resource) == 0 && len(s.NotResource) == 0:
+		return errors.New(errNoResource)
+	}
+	for _, r := range append(s.Resource, s.NotResource...) {
+		if r != "*" && !isARN(r) {
+			return errors.Errorf(errFmtResource, r)
+		}
+	}
+
+	switch {
+	case s.Principal != nil && s.NotPrincipal != nil:
+		return errors.New(errPrincipalAndNot)
+	case v.identity && (s.Principal != nil || s.NotPrincipal != nil):
+		return errors.New(errPrincipalNotAllowed)
+	case v.principal && s.Principal == nil && s.NotPrincipal == nil:
+		return errors.New(errNoPrincipal)
+	}
+	if err := validatePrincipal(s.Principal); err != nil {
+		return err
+	}
+	if err := validatePrincipal(s.NotPrincipal); err != nil {
+		return err
+	}
+
+	return validateConditions(s.Condition)
+}
+
+func validatePrincipal(p *Principal) error {
+	if p == nil {
+		return nil
+	}
+	for _, a := range p.AWSPrincipals {
+		if a != "*" && !accountIDRegex.MatchString(a) && !isARN(a) {
+			return errors.Errorf(errFmtAWSPrincipal, a)
+		}
+	}
+	for _, s := range p.Service {
+		if !servicePrinRegx.MatchString(s) {
+			return errors.Errorf(errFmtServicePrincipal, s)
+		}
+	}
+	if p.Federated != nil && *p.Federated == "" {
+		return errors.New(errEmptyFederated)
+	}
+	return nil
+}
+
+func validateConditions(c ConditionMap) error {
+	operators := make([]string, 0, len(c))
+	for op := range c {
+		operators = append(operators, op)
+	}
+	sort.Strings(operators)
+	for _, op := range operators {
+		if !isConditionOperator(op) {
+			return errors.Errorf(errFmtConditionOperator, op)
+		}
+		if len(c[op]) == 0 {
+			return errors.Errorf(errFmtConditionNoKeys, op)
+		}
+	}
+	return nil
+}
+
+// isConditionOperator returns true if op is a condition operator, optionally
+// prefixed with a set operator and suffixed with IfExists.
+func isConditionOperator(op string) bool {
+	if i := strings.Index(op, ":"); i != -1 {
+		if set := op[:i]; set != "ForAllValues" && set != "ForAnyValue" {
+			return false
+		}
+		op = op[i+1:]
+	}
+	base := strings.TrimSuffix(op, "IfExists")
+	if base == "Null" && base != op {
+		return false
+	}
+	return conditionOperators[base]
+}
+
+// isARN returns true if s looks like an ARN, which may contain wildcards.
+func isARN(s string) bool {
+	return strings.HasPrefix(s, "arn:") && strings.Count(s, ":") >= 5
+}
+
+// DiffPolicyStrings returns a semantic diff between the two given policy
+// documents, or an empty string if they are equal or cannot be parsed.
+func DiffPolicyStrings(desired, observed string) string {
+	a, err := ParsePolicyString(desired)
+	if err != nil {
+		return ""
+	}
+	b, err := ParsePolicyString(observed)
+	if err != nil {
+		return ""
+	}
+	_, diff := ArePoliciesEqal(&a, &b)
+	return diff
+}
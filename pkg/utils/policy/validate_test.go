@@ -0,0 +1,192 @@
+package policy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+)
+
+func TestValidatePolicyString(t *testing.T) {
+	type args struct {
+		raw  string
+		opts []ValidationOption
+	}
+	cases := map[string]struct {
+		args args
+		want error
+	}{
+		"ValidIdentityPolicy": {
+			args: args{
+				raw: `{
+					"Version": "2012-10-17",
+					"Statement": [{
+						"Effect": "Allow",
+						"Action": ["s3:Get*", "s3:List*"],
+						"Resource": "arn:aws:s3:::bucket/*",
+						"Condition": {"ForAnyValue:StringLikeIfExists": {"aws:PrincipalTag/team": "a*"}}
+					}]
+				}`,
+				opts: []ValidationOption{WithIdentityPolicy(), WithMaxSize(MaxManagedPolicySize)},
+			},
+		},
+		"ValidTrustPolicy": {
+			args: args{
+				raw: `{
+					"Version": "2012-10-17",
+					"Statement": [{
+						"Effect": "Allow",
+						"Principal": {"AWS": ["123456789012", "arn:aws:iam::123456789012:root"], "Service": "ec2.amazonaws.com"},
+						"Action": "sts:AssumeRole"
+					}]
+				}`,
+				opts: []ValidationOption{WithResourcePolicy()},
+			},
+		},
+		"ParseError": {
+			args: args{
+				raw: `{"Statement": [`,
+			},
+			want: errors.Wrap(errors.New("unexpected end of JSON input"), errParsePolicy),
+		},
+		"TooLarge": {
+			args: args{
+				raw:  `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "*", "Resource": "*"}]}`,
+				opts: []ValidationOption{WithMaxSize(10)},
+			},
+			want: errors.Errorf(errFmtPolicySize, 85, 10),
+		},
+		"InvalidVersion": {
+			args: args{
+				raw: `{"Version": "2021-01-01", "Statement": [{"Effect": "Allow", "Action": "*", "Resource": "*"}]}`,
+			},
+			want: errors.Errorf(errFmtVersion, "2021-01-01"),
+		},
+		"NoStatements": {
+			args: args{
+				raw: `{"Version": "2012-10-17", "Statement": []}`,
+			},
+			want: errors.New(errNoStatements),
+		},
+		"InvalidEffect": {
+			args: args{
+				raw: `{"Statement": [{"Sid": "first", "Effect": "allow", "Action": "*", "Resource": "*"}]}`,
+			},
+			want: errors.Wrap(errors.Errorf(errFmtEffect, "allow"), fmt.Sprintf(errFmtStatementSID, 0, "first")),
+		},
+		"NoAction": {
+			args: args{
+				raw: `{"Statement": [{"Effect": "Allow", "Resource": "*"}]}`,
+			},
+			want: errors.Wrap(errors.New(errNoAction), fmt.Sprintf(errFmtStatement, 0)),
+		},
+		"ActionAndNotAction": {
+			args: args{
+				raw: `{"Statement": [{"Effect": "Allow", "Action": "s3:*", "NotAction": "iam:*", "Resource": "*"}]}`,
+			},
+			want: errors.Wrap(errors.New(errActionAndNotAction), fmt.Sprintf(errFmtStatement, 0)),
+		},
+		"InvalidAction": {
+			args: args{
+				raw: `{"Statement": [
+					{"Effect": "Allow", "Action": "s3:GetObject", "Resource": "*"},
+					{"Effect": "Allow", "Action": "s3 GetObject", "Resource": "*"}
+				]}`,
+			},
+			want: errors.Wrap(errors.Errorf(errFmtAction, "s3 GetObject"), fmt.Sprintf(errFmtStatement, 1)),
+		},
+		"InvalidResource": {
+			args: args{
+				raw: `{"Statement": [{"Effect": "Allow", "Action": "s3:*", "Resource": "my-bucket"}]}`,
+			},
+			want: errors.Wrap(errors.Errorf(errFmtResource, "my-bucket"), fmt.Sprintf(errFmtStatement, 0)),
+		},
+		"IdentityPolicyWithoutResource": {
+			args: args{
+				raw:  `{"Statement": [{"Effect": "Allow", "Action": "s3:*"}]}`,
+				opts: []ValidationOption{WithIdentityPolicy()},
+			},
+			want: errors.Wrap(errors.New(errNoResource), fmt.Sprintf(errFmtStatement, 0)),
+		},
+		"IdentityPolicyWithPrincipal": {
+			args: args{
+				raw:  `{"Statement": [{"Effect": "Allow", "Principal": {"AWS": "*"}, "Action": "s3:*", "Resource": "*"}]}`,
+				opts: []ValidationOption{WithIdentityPolicy()},
+			},
+			want: errors.Wrap(errors.New(errPrincipalNotAllowed), fmt.Sprintf(errFmtStatement, 0)),
+		},
+		"ResourcePolicyWithoutPrincipal": {
+			args: args{
+				raw:  `{"Statement": [{"Effect": "Allow", "Action": "s3:*", "Resource": "*"}]}`,
+				opts: []ValidationOption{WithResourcePolicy()},
+			},
+			want: errors.Wrap(errors.New(errNoPrincipal), fmt.Sprintf(errFmtStatement, 0)),
+		},
+		"InvalidAWSPrincipal": {
+			args: args{
+				raw: `{"Statement": [{"Effect": "Allow", "Principal": {"AWS": "12345"}, "Action": "s3:*", "Resource": "*"}]}`,
+			},
+			want: errors.Wrap(errors.Errorf(errFmtAWSPrincipal, "12345"), fmt.Sprintf(errFmtStatement, 0)),
+		},
+		"InvalidServicePrincipal": {
+			args: args{
+				raw: `{"Statement": [{"Effect": "Allow", "Principal": {"Service": "lambda"}, "Action": "sts:AssumeRole"}]}`,
+			},
+			want: errors.Wrap(errors.Errorf(errFmtServicePrincipal, "lambda"), fmt.Sprintf(errFmtStatement, 0)),
+		},
+		"UnknownConditionOperator": {
+			args: args{
+				raw: `{"Statement": [{"Effect": "Allow", "Action": "s3:*", "Resource": "*", "Condition": {"StringEqual": {"aws:username": "a"}}}]}`,
+			},
+			want: errors.Wrap(errors.Errorf(errFmtConditionOperator, "StringEqual"), fmt.Sprintf(errFmtStatement, 0)),
+		},
+		"NullIfExists": {
+			args: args{
+				raw: `{"Statement": [{"Effect": "Allow", "Action": "s3:*", "Resource": "*", "Condition": {"NullIfExists": {"aws:TokenIssueTime": "true"}}}]}`,
+			},
+			want: errors.Wrap(errors.Errorf(errFmtConditionOperator, "NullIfExists"), fmt.Sprintf(errFmtStatement, 0)),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := ValidatePolicyString(tc.args.raw, tc.args.opts...)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("-want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffPolicyStrings(t *testing.T) {
+	cases := map[string]struct {
+		desired  string
+		observed string
+		wantDiff bool
+	}{
+		"Equal": {
+			desired:  `{"Statement": [{"Effect": "Allow", "Action": "s3:*", "Resource": "*"}]}`,
+			observed: `{"Statement":[{"Effect":"Allow","Action":["s3:*"],"Resource":["*"]}]}`,
+		},
+		"Different": {
+			desired:  `{"Statement": [{"Effect": "Allow", "Action": "s3:*", "Resource": "*"}]}`,
+			observed: `{"Statement": [{"Effect": "Deny", "Action": "s3:*", "Resource": "*"}]}`,
+			wantDiff: true,
+		},
+		"Unparsable": {
+			desired:  `{`,
+			observed: `{"Statement": [{"Effect": "Deny", "Action": "s3:*", "Resource": "*"}]}`,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			diff := DiffPolicyStrings(tc.desired, tc.observed)
+			if got := diff != ""; got != tc.wantDiff {
+				t.Errorf("DiffPolicyStrings(...): want diff %t, got %q", tc.wantDiff, diff)
+			}
+		})
+	}
+}